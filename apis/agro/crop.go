@@ -0,0 +1,55 @@
+package agro
+
+import "time"
+
+const (
+	PlantingStatusPlanned   = "planned"
+	PlantingStatusSown      = "sown"
+	PlantingStatusHarvested = "harvested"
+	PlantingStatusCancelled = "cancelled"
+)
+
+// Crop is a catalogue entry for a variety of a species.
+type Crop struct {
+	ID             string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Species        string `gorm:"not null;size:100;uniqueIndex:idx_crops_species_variety"`
+	Variety        string `gorm:"not null;size:100;default:'';uniqueIndex:idx_crops_species_variety"`
+	DaysToMaturity int    `gorm:"not null"`
+	RowSpacingCm   float64
+	PlantSpacingCm float64
+	// WaterRequirementMm is the water needed over the whole season.
+	WaterRequirementMm float64
+	// BaseTemperatureC is the temperature below which the crop does not
+	// develop, used for growing degree days.
+	BaseTemperatureC float64
+	// DegreeDaysToMaturity is the thermal time above BaseTemperatureC the
+	// crop needs from sowing to maturity; 0 when unknown.
+	DegreeDaysToMaturity float64 `gorm:"not null;default:0"`
+	// KcInitial, KcMid and KcEnd are the FAO-56 crop coefficients scaling
+	// reference evapotranspiration in the initial, mid-season and late
+	// stages.
+	KcInitial float64 `gorm:"not null;default:0.4"`
+	KcMid     float64 `gorm:"not null;default:1.1"`
+	KcEnd     float64 `gorm:"not null;default:0.6"`
+	// RootDepthM is the depth of soil the crop draws water from.
+	RootDepthM float64   `gorm:"not null;default:0.6"`
+	CreatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time
+}
+
+// PlantingPlan schedules a crop on a field for a season. HarvestDate is
+// derived from SowingDate and the crop's days to maturity. Plans of a field
+// that are not cancelled may not overlap.
+type PlantingPlan struct {
+	ID          string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FieldID     string    `gorm:"not null;type:uuid"`
+	CropID      string    `gorm:"not null;type:uuid;index"`
+	Crop        Crop      `gorm:"constraint:OnDelete:RESTRICT"`
+	Season      string    `gorm:"not null;size:50"`
+	SowingDate  time.Time `gorm:"not null;type:date"`
+	HarvestDate time.Time `gorm:"not null;type:date"`
+	Status      string    `gorm:"not null;size:20;default:'planned'"`
+	Notes       string    `gorm:"not null;size:1000;default:''"`
+	CreatedAt   time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time
+}
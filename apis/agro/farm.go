@@ -0,0 +1,30 @@
+package agro
+
+import "time"
+
+// Farm is a holding managed by its owner. Boundary is an optional GeoJSON
+// Polygon or MultiPolygon; AreaHectares is derived from it.
+type Farm struct {
+	ID             string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	OwnerID        string    `gorm:"not null;type:uuid;index"`
+	OrganisationID string    `gorm:"not null;size:64;default:''"`
+	Name           string    `gorm:"not null;size:100"`
+	Address        string    `gorm:"not null;size:255;default:''"`
+	Boundary       *string   `gorm:"type:jsonb"`
+	AreaHectares   float64   `gorm:"not null;default:0"`
+	CreatedAt      time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time
+}
+
+// Field is a plot of a farm. AreaHectares is derived from Boundary, a
+// GeoJSON Polygon or MultiPolygon.
+type Field struct {
+	ID           string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FarmID       string    `gorm:"not null;type:uuid;uniqueIndex:idx_fields_farm_name"`
+	Name         string    `gorm:"not null;size:100;uniqueIndex:idx_fields_farm_name"`
+	SoilType     string    `gorm:"not null;size:20;default:''"`
+	Boundary     string    `gorm:"not null;type:jsonb"`
+	AreaHectares float64   `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,85 @@
+package agro
+
+import "time"
+
+const (
+	SKUCategorySeed       = "seed"
+	SKUCategoryFertiliser = "fertiliser"
+	SKUCategoryChemical   = "chemical"
+	SKUCategoryOther      = "other"
+)
+
+const (
+	MovementReceipt    = "receipt"
+	MovementIssue      = "issue"
+	MovementTransfer   = "transfer"
+	MovementAdjustment = "adjustment"
+)
+
+// Warehouse is a store of farm inputs.
+type Warehouse struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FarmID    string    `gorm:"not null;type:uuid;uniqueIndex:idx_warehouses_farm_name"`
+	Name      string    `gorm:"not null;size:100;uniqueIndex:idx_warehouses_farm_name"`
+	Location  string    `gorm:"not null;size:255;default:''"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time
+}
+
+// SKU is an input a farm keeps in stock, counted in Unit, e.g. "kg" or "l".
+type SKU struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FarmID    string    `gorm:"not null;type:uuid;uniqueIndex:idx_skus_farm_code"`
+	Code      string    `gorm:"not null;size:50;uniqueIndex:idx_skus_farm_code"`
+	Name      string    `gorm:"not null;size:100"`
+	Category  string    `gorm:"not null;size:20"`
+	Unit      string    `gorm:"not null;size:20"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time
+}
+
+// StockLot is a batch of a SKU. Lots are created by their first receipt.
+type StockLot struct {
+	ID         string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	SKUID      string     `gorm:"column:sku_id;not null;type:uuid;uniqueIndex:idx_stock_lots_sku_lot_number"`
+	LotNumber  string     `gorm:"not null;size:50;uniqueIndex:idx_stock_lots_sku_lot_number"`
+	ExpiryDate *time.Time `gorm:"type:date"`
+	CreatedAt  time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// StockMovement is an entry of the inventory ledger. Quantity is the change
+// of the stock of the lot in WarehouseID, in the unit of the SKU: positive
+// for receipts, negative for issues and transfers, which move the amount on
+// to ToWarehouseID, and either for adjustments. Movements are never updated
+// or deleted.
+type StockMovement struct {
+	ID            string  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	FarmID        string  `gorm:"not null;type:uuid"`
+	Kind          string  `gorm:"not null;size:20"`
+	WarehouseID   string  `gorm:"not null;type:uuid"`
+	ToWarehouseID *string `gorm:"type:uuid"`
+	SKUID         string  `gorm:"column:sku_id;not null;type:uuid"`
+	LotID         string  `gorm:"not null;type:uuid"`
+	Lot           StockLot
+	Quantity      float64 `gorm:"not null;type:numeric(14,3)"`
+	// ActivityID is the field activity of FieldID stock is issued to.
+	ActivityID *string `gorm:"type:uuid"`
+	FieldID    *string `gorm:"type:uuid"`
+	// Reference is an external document, e.g. a delivery note.
+	Reference  string    `gorm:"not null;size:100;default:''"`
+	Reason     string    `gorm:"not null;size:500;default:''"`
+	ActorID    string    `gorm:"not null;type:uuid"`
+	OccurredAt time.Time `gorm:"not null"`
+	RecordedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// StockBalance is the quantity of a lot in a warehouse, the sum of the
+// movements of the lot in and out of it. It never drops below zero.
+type StockBalance struct {
+	WarehouseID string `gorm:"primaryKey;type:uuid"`
+	LotID       string `gorm:"primaryKey;type:uuid"`
+	Lot         StockLot
+	SKUID       string    `gorm:"column:sku_id;not null;type:uuid"`
+	Quantity    float64   `gorm:"not null;type:numeric(14,3)"`
+	UpdatedAt   time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
@@ -0,0 +1,24 @@
+package agro
+
+import "time"
+
+// PesticideProduct is a registered plant protection product and the
+// conditions of its use. Zero limits are not enforced.
+type PesticideProduct struct {
+	ID                 string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Name               string `gorm:"not null;size:100"`
+	RegistrationNumber string `gorm:"not null;size:50;uniqueIndex:idx_pesticide_products_registration_number"`
+	ActiveIngredient   string `gorm:"not null;size:200;default:''"`
+	// PreHarvestIntervalDays is the number of days from a spraying before
+	// the crop may be harvested.
+	PreHarvestIntervalDays int `gorm:"not null;default:0"`
+	// ReEntryIntervalHours is the number of hours after a spraying before
+	// the field may be entered.
+	ReEntryIntervalHours     int `gorm:"not null;default:0"`
+	MaxApplicationsPerSeason int `gorm:"not null;default:0"`
+	// MaxDosePerHectare is in DoseUnit, e.g. "l" or "kg", per hectare.
+	MaxDosePerHectare float64   `gorm:"not null;default:0"`
+	DoseUnit          string    `gorm:"not null;size:20;default:''"`
+	CreatedAt         time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,34 @@
+package agro
+
+import "time"
+
+const (
+	ScopeGlobal       = "global"
+	ScopeOrganisation = "organisation"
+	ScopeFarm         = "farm"
+)
+
+// Permission names an action, e.g. "field.write".
+type Permission struct {
+	ID   string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Name string `gorm:"unique;not null;size:100"`
+}
+
+type Role struct {
+	ID          string       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Name        string       `gorm:"unique;not null;size:50"`
+	Description string       `gorm:"size:255"`
+	Permissions []Permission `gorm:"many2many:role_permissions;constraint:OnDelete:CASCADE"`
+}
+
+// RoleBinding grants a role to a user within a scope. ScopeID is empty for
+// the global scope and holds the organisation or farm id otherwise.
+type RoleBinding struct {
+	ID        string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	UserID    string `gorm:"not null;type:uuid;uniqueIndex:idx_role_binding"`
+	RoleID    string `gorm:"not null;type:uuid;uniqueIndex:idx_role_binding"`
+	Role      Role   `gorm:"constraint:OnDelete:CASCADE"`
+	ScopeType string `gorm:"not null;size:20;uniqueIndex:idx_role_binding"`
+	ScopeID   string `gorm:"not null;size:64;default:'';uniqueIndex:idx_role_binding"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package agro
+
+import "time"
+
+// RefreshToken is a single link in a rotation chain. Every refresh replaces
+// the presented token with a new one in the same family; presenting a token
+// that was already used revokes the whole family.
+type RefreshToken struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	UserID    string    `gorm:"not null;type:uuid;index"`
+	FamilyID  string    `gorm:"not null;type:uuid;index"`
+	TokenHash string    `gorm:"unique;not null;size:64"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
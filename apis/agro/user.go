@@ -1,8 +1,13 @@
 package agro
 
+import "time"
+
 type User struct {
-	ID       string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	Username string `gorm:"unique;not null;size:50"`
-	Email    string `gorm:"unique;not null;size:100"`
-	Password string `gorm:"not null;size:255"`
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Username  string    `gorm:"unique;not null;size:50"`
+	Email     string    `gorm:"unique;not null;size:100"`
+	Password  string    `gorm:"not null;size:255"`
+	Admin     bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
+	UpdatedAt time.Time
 }
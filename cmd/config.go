@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/aburifat/go-agro/pkg/backend/config"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// configCmd groups the configuration commands
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the server configuration",
+}
+
+// configPrintCmd prints the effective configuration
+var configPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the effective configuration with secrets redacted",
+	Long: `Print the configuration the start command would run with, as YAML.
+
+Settings are resolved from built-in defaults, then the file given by --config
+(or AGRO_CONFIG), then AGRO_* environment variables, then flags. Passwords and
+credentials embedded in URIs are redacted.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(os.Stdout)
+		enc.SetIndent(2)
+		defer enc.Close()
+		return enc.Encode(cfg.Redacted())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configPrintCmd)
+
+	config.BindFlags(configPrintCmd.Flags())
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/aburifat/go-agro/pkg/backend/config"
+	"github.com/aburifat/go-agro/pkg/backend/migrations"
+
+	"github.com/spf13/cobra"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// migrateCmd groups the database migration commands
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage database schema migrations",
+	Long: `Apply, revert and inspect the versioned SQL migrations embedded in the binary.
+
+Migrations live in ` + migrations.Dir + ` as NNNN_name.up.sql and
+NNNN_name.down.sql pairs. Concurrent runs are serialised with a Postgres
+advisory lock.`,
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:          "up",
+	Short:        "Apply pending migrations",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps, _ := cmd.Flags().GetInt("steps")
+		m, err := newMigrator(cmd)
+		if err != nil {
+			return err
+		}
+		applied, err := m.Up(context.Background(), steps)
+		for _, v := range applied {
+			fmt.Printf("applied %d\n", v)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:          "down",
+	Short:        "Revert the most recently applied migrations",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps, _ := cmd.Flags().GetInt("steps")
+		m, err := newMigrator(cmd)
+		if err != nil {
+			return err
+		}
+		reverted, err := m.Down(context.Background(), steps)
+		for _, v := range reverted {
+			fmt.Printf("reverted %d\n", v)
+		}
+		return err
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:          "status",
+	Short:        "Show applied and pending migrations",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, err := newMigrator(cmd)
+		if err != nil {
+			return err
+		}
+		statuses, err := m.Status(context.Background())
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED\tNOTE")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			note := ""
+			switch {
+			case s.Missing:
+				note = "missing from source"
+			case s.Modified:
+				note = "modified since applied"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, applied, note)
+		}
+		return w.Flush()
+	},
+}
+
+var migrateCreateCmd = &cobra.Command{
+	Use:          "create NAME",
+	Short:        "Create an empty up and down migration",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, _ := cmd.Flags().GetString("dir")
+		up, down, err := migrations.Create(dir, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(up)
+		fmt.Println(down)
+		return nil
+	},
+}
+
+func newMigrator(cmd *cobra.Command) (*migrations.Migrator, error) {
+	cfg, err := config.Load(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(postgres.Open(cfg.Database.DSN()), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	return migrations.New(sqlDB)
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateCreateCmd)
+
+	config.BindFlags(migrateCmd.PersistentFlags())
+	migrateUpCmd.Flags().Int("steps", 0, "number of migrations to apply, 0 applies all")
+	migrateDownCmd.Flags().Int("steps", 1, "number of migrations to revert")
+	migrateCreateCmd.Flags().String("dir", migrations.Dir, "directory to create the migration in")
+}
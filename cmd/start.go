@@ -4,8 +4,7 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
-	"fmt"
-
+	"github.com/aburifat/go-agro/pkg/backend/config"
 	handle "github.com/aburifat/go-agro/pkg/backend/services/user_service"
 
 	"github.com/spf13/cobra"
@@ -14,22 +13,33 @@ import (
 // startCmd represents the start command
 var startCmd = &cobra.Command{
 	Use:   "start",
-	Short: "A brief description of your command",
-	Long: `A longer description that spans multiple lines and likely contains examples
-and usage of using your command. For example:
-
-Cobra is a CLI library for Go that empowers applications.
-This application is a tool to generate the needed files
-to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("start called")
-		handle.Server()
+	Short: "Run the go-agro server",
+	Long: `Run the gRPC server, and the HTTP/JSON gateway and Prometheus metrics
+when their addresses are set, until SIGINT or SIGTERM.
+
+Settings are resolved from built-in defaults, then the --config file, then
+AGRO_* environment variables, then the flags below. Pending migrations are
+applied on start unless --database-migrate-on-start=false. Spatial queries,
+activities, telemetry, irrigation, weather, growth and compliance reports
+need MongoDB and are only available with --mongo-uri.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		handle.Server(cfg)
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(startCmd)
 
+	// Settings are resolved from defaults, then the --config file, then
+	// AGRO_* environment variables, then these flags.
+	config.BindFlags(startCmd.Flags())
+
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
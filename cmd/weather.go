@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aburifat/go-agro/pkg/backend/config"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/spatial"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+
+	"github.com/spf13/cobra"
+)
+
+// weatherCmd groups the weather data commands
+var weatherCmd = &cobra.Command{
+	Use:   "weather",
+	Short: "Manage weather station data",
+}
+
+var weatherImportCmd = &cobra.Command{
+	Use:   "import FILE...",
+	Short: "Import weather station exports",
+	Long: `Import the observations of weather stations from CSV files and JSON dumps
+of NetCDF files into MongoDB.
+
+CSV files start with a header naming their columns, e.g.
+
+  station,time,tmin,tmax,rainfall,latitude,longitude
+
+Rows dated YYYY-MM-DD are daily observations, rows with a time of day hourly
+ones. JSON files are written by xarray's Dataset.to_dict or in CF-JSON and
+hold the time series of one station. The format is taken from the file
+extension unless --format is given.
+
+Stations with a location are linked to the farms within --link-radius of it.
+Importing a file again replaces its observations.`,
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		station, _ := cmd.Flags().GetString("station")
+		radius, _ := cmd.Flags().GetFloat64("link-radius")
+		switch format {
+		case "", "csv", "json":
+		default:
+			return fmt.Errorf("--format must be csv or json, got %q", format)
+		}
+
+		cfg, err := config.Load(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if cfg.Mongo.URI == "" {
+			return errors.New("mongo.uri is required to import weather data")
+		}
+		store, err := storage.NewStorage(cfg.Mongo.URI, cfg.Mongo.Database)
+		if err != nil {
+			return err
+		}
+		defer store.Close(context.Background())
+		weatherStore := weather.NewStore(store, spatial.NewIndex(store))
+		ctx := cmd.Context()
+		if err := weatherStore.EnsureIndexes(ctx); err != nil {
+			return err
+		}
+
+		for _, path := range args {
+			data, err := parseWeatherFile(path, format, station)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			result, err := weatherStore.Import(ctx, data, radius)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			fmt.Printf("%s: %d observations inserted, %d updated, stations %s linked to %d farms\n",
+				path, result.Inserted, result.Updated, strings.Join(result.Stations, ", "), result.LinkedFarms)
+		}
+		return nil
+	},
+}
+
+func parseWeatherFile(path, format, station string) (*weather.Dataset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch format {
+	case "csv":
+		return weather.ParseCSV(f, station)
+	case "json":
+		return weather.ParseNetCDFJSON(f, station)
+	}
+	return nil, errors.New("cannot tell the format from the file extension, use --format")
+}
+
+func init() {
+	rootCmd.AddCommand(weatherCmd)
+	weatherCmd.AddCommand(weatherImportCmd)
+
+	config.BindFlags(weatherCmd.PersistentFlags())
+	weatherImportCmd.Flags().String("format", "", "file format, csv or json; taken from the extension by default")
+	weatherImportCmd.Flags().String("station", "", "station of files that do not name it")
+	weatherImportCmd.Flags().Float64("link-radius", weather.DefaultLinkRadius, "distance in metres up to which stations are linked to farms, 0 disables linking")
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator turns a bearer token into a principal.
+type Authenticator func(ctx context.Context, token string) (*Principal, error)
+
+// UnaryServerInterceptor authenticates the bearer token from the
+// "authorization" metadata, stores the principal in the context and enforces
+// policy before calling the handler.
+func UnaryServerInterceptor(authenticate Authenticator, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, authenticate, policy, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor. Rules see a nil request, as the stream is
+// authorized before the first message arrives.
+func StreamServerInterceptor(authenticate Authenticator, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), authenticate, policy, info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authorize(ctx context.Context, authenticate Authenticator, policy Policy, method string, req any) (context.Context, error) {
+	rule, ok := policy[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "no access policy for %s", method)
+	}
+	var principal *Principal
+	raw, present, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if present {
+		p, err := authenticate(ctx, raw)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
+		}
+		principal = p
+		ctx = NewContext(ctx, principal)
+	}
+	if err := rule(ctx, principal, req); err != nil {
+		switch {
+		case errors.Is(err, ErrUnauthenticated):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, grpcerr.FromError(err, "failed to authorize")
+	}
+	return ctx, nil
+}
+
+func bearerToken(ctx context.Context) (string, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false, nil
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false, nil
+	}
+	scheme, raw, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || raw == "" {
+		return "", true, errors.New("authorization metadata must be of the form \"Bearer <token>\"")
+	}
+	return raw, true, nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
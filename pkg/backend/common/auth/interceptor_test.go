@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	authenticate := func(ctx context.Context, token string) (*Principal, error) {
+		switch token {
+		case "user":
+			return &Principal{UserID: "u1"}, nil
+		case "admin":
+			return &Principal{UserID: "a1", Roles: []string{RoleAdmin}}, nil
+		}
+		return nil, errors.New("unknown token")
+	}
+	policy := Policy{
+		"/test.Service/Public": Public,
+		"/test.Service/Admin":  Admin,
+		"/test.Service/Failing": func(ctx context.Context, p *Principal, req any) error {
+			return errors.New("store is down")
+		},
+	}
+	interceptor := UnaryServerInterceptor(authenticate, policy)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		want          codes.Code
+		wantUser      string
+	}{
+		{"method without policy is denied", "/test.Service/Unknown", "Bearer admin", codes.PermissionDenied, ""},
+		{"method without policy is denied anonymously", "/test.Service/Unknown", "", codes.PermissionDenied, ""},
+		{"public anonymous", "/test.Service/Public", "", codes.OK, ""},
+		{"public with token", "/test.Service/Public", "Bearer user", codes.OK, "u1"},
+		{"public with invalid token", "/test.Service/Public", "Bearer nobody", codes.Unauthenticated, ""},
+		{"malformed authorization", "/test.Service/Public", "Basic dXNlcg==", codes.Unauthenticated, ""},
+		{"empty bearer", "/test.Service/Public", "Bearer ", codes.Unauthenticated, ""},
+		{"admin anonymous", "/test.Service/Admin", "", codes.Unauthenticated, ""},
+		{"admin as user", "/test.Service/Admin", "Bearer user", codes.PermissionDenied, ""},
+		{"admin as admin", "/test.Service/Admin", "bearer admin", codes.OK, "a1"},
+		{"rule failure", "/test.Service/Failing", "Bearer user", codes.Internal, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+			var called bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				var userID string
+				if p := FromContext(ctx); p != nil {
+					userID = p.UserID
+				}
+				if userID != tt.wantUser {
+					t.Errorf("handler principal = %q, want %q", userID, tt.wantUser)
+				}
+				return nil, nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("interceptor() code = %v, want %v (%v)", got, tt.want, err)
+			}
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v", called)
+			}
+		})
+	}
+}
+
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	authenticate := func(ctx context.Context, token string) (*Principal, error) {
+		if token == "user" {
+			return &Principal{UserID: "u1"}, nil
+		}
+		return nil, errors.New("unknown token")
+	}
+	policy := Policy{
+		"/test.Service/Authenticated": Authenticated,
+		"/test.Service/Self":          Self,
+		// asserts the request type unchecked, as most policies do
+		"/test.Service/Owner": SelfUser(func(req any) string {
+			return req.(*idRequest).GetId()
+		}),
+		"/test.Service/AdminOrSelf": AnyOf(Admin, Self),
+	}
+	interceptor := StreamServerInterceptor(authenticate, policy)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		want          codes.Code
+	}{
+		{"method without policy is denied", "/test.Service/Unknown", "Bearer user", codes.PermissionDenied},
+		{"authenticated anonymous", "/test.Service/Authenticated", "", codes.Unauthenticated},
+		{"authenticated user", "/test.Service/Authenticated", "Bearer user", codes.OK},
+		{"self is denied", "/test.Service/Self", "Bearer user", codes.PermissionDenied},
+		{"owner is denied without looking at the request", "/test.Service/Owner", "Bearer user", codes.PermissionDenied},
+		{"any of admin or self is denied", "/test.Service/AdminOrSelf", "Bearer user", codes.PermissionDenied},
+		{"self anonymous", "/test.Service/Self", "", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+			var called bool
+			handler := func(srv any, ss grpc.ServerStream) error {
+				called = true
+				if p := FromContext(ss.Context()); p == nil || p.UserID != "u1" {
+					t.Errorf("handler principal = %v, want u1", p)
+				}
+				return nil
+			}
+			err := interceptor(nil, &stream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("interceptor() code = %v, want %v (%v)", got, tt.want, err)
+			}
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v", called)
+			}
+		})
+	}
+}
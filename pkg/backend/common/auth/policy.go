@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("permission denied")
+	// ErrNoRequest denies streaming RPCs guarded by rules that decide on the
+	// request, since the interceptor runs before any message is received.
+	ErrNoRequest = fmt.Errorf("%w: the access rule needs a request, which streaming RPCs do not carry", ErrForbidden)
+)
+
+// Rule decides whether p may call a method with req. p is nil for anonymous
+// callers. req is nil for streaming RPCs, so rules guarding them can only
+// decide on the caller; rules that need the request deny streams with
+// ErrNoRequest, and the handler has to check every message itself.
+type Rule func(ctx context.Context, p *Principal, req any) error
+
+// Policy maps full gRPC method names to the rule guarding them. Methods
+// without an entry are denied.
+type Policy map[string]Rule
+
+// Merge returns a policy containing the rules of every given policy.
+func Merge(policies ...Policy) Policy {
+	merged := Policy{}
+	for _, policy := range policies {
+		for method, rule := range policy {
+			merged[method] = rule
+		}
+	}
+	return merged
+}
+
+// Public allows anonymous callers.
+func Public(ctx context.Context, p *Principal, req any) error {
+	return nil
+}
+
+// Authenticated allows any authenticated caller.
+func Authenticated(ctx context.Context, p *Principal, req any) error {
+	if p == nil {
+		return ErrUnauthenticated
+	}
+	return nil
+}
+
+func RequireRole(role string) Rule {
+	return func(ctx context.Context, p *Principal, req any) error {
+		if p == nil {
+			return ErrUnauthenticated
+		}
+		if !p.HasRole(role) {
+			return ErrForbidden
+		}
+		return nil
+	}
+}
+
+// Admin allows callers holding the admin role.
+var Admin = RequireRole(RoleAdmin)
+
+// Self allows callers acting on their own user, identified by the request's
+// id field. An empty id is taken to mean the caller.
+var Self = SelfUser(func(req any) string {
+	if r, ok := req.(interface{ GetId() string }); ok {
+		return r.GetId()
+	}
+	return ""
+})
+
+// SelfUser allows callers acting on their own user, identified by the user
+// id that userID extracts from the request. An empty id is taken to mean the
+// caller. Streams are denied.
+func SelfUser(userID func(req any) string) Rule {
+	return func(ctx context.Context, p *Principal, req any) error {
+		if p == nil {
+			return ErrUnauthenticated
+		}
+		if req == nil {
+			return ErrNoRequest
+		}
+		if id := userID(req); id != "" && id != p.UserID {
+			return ErrForbidden
+		}
+		return nil
+	}
+}
+
+// AnyOf allows the call when at least one of rules does.
+func AnyOf(rules ...Rule) Rule {
+	return func(ctx context.Context, p *Principal, req any) error {
+		err := ErrForbidden
+		for _, rule := range rules {
+			if err = rule(ctx, p, req); err == nil {
+				return nil
+			}
+		}
+		return err
+	}
+}
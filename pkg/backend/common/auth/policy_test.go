@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type idRequest struct{ id string }
+
+func (r *idRequest) GetId() string { return r.id }
+
+func TestRules(t *testing.T) {
+	user := &Principal{UserID: "u1"}
+	admin := &Principal{UserID: "a1", Roles: []string{RoleAdmin}}
+
+	tests := []struct {
+		name      string
+		rule      Rule
+		principal *Principal
+		req       any
+		want      error
+	}{
+		{"public anonymous", Public, nil, nil, nil},
+		{"authenticated anonymous", Authenticated, nil, nil, ErrUnauthenticated},
+		{"authenticated user", Authenticated, user, nil, nil},
+		{"admin anonymous", Admin, nil, nil, ErrUnauthenticated},
+		{"admin as user", Admin, user, nil, ErrForbidden},
+		{"admin as admin", Admin, admin, nil, nil},
+		{"self anonymous", Self, nil, &idRequest{id: "u1"}, ErrUnauthenticated},
+		{"self own id", Self, user, &idRequest{id: "u1"}, nil},
+		{"self empty id", Self, user, &idRequest{}, nil},
+		{"self other id", Self, user, &idRequest{id: "u2"}, ErrForbidden},
+		{"self stream", Self, user, nil, ErrNoRequest},
+		{"self admin other id", Self, admin, &idRequest{id: "u1"}, ErrForbidden},
+		{"any of admin or self as admin", AnyOf(Admin, Self), admin, &idRequest{id: "u1"}, nil},
+		{"any of admin or self as owner", AnyOf(Admin, Self), user, &idRequest{id: "u1"}, nil},
+		{"any of admin or self as other", AnyOf(Admin, Self), user, &idRequest{id: "u2"}, ErrForbidden},
+		{"any of admin or self anonymous", AnyOf(Admin, Self), nil, &idRequest{id: "u1"}, ErrUnauthenticated},
+		{"any of nothing", AnyOf(), user, nil, ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule(context.Background(), tt.principal, tt.req); !errors.Is(err, tt.want) {
+				t.Errorf("rule() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge(Policy{"/a.A/One": Public}, Policy{"/a.A/Two": Admin}, nil)
+	if len(merged) != 2 || merged["/a.A/One"] == nil || merged["/a.A/Two"] == nil {
+		t.Errorf("Merge() = %v, want both methods", merged)
+	}
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const RoleAdmin = "admin"
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserID string
+	Roles  []string
+}
+
+func (p *Principal) HasRole(role string) bool {
+	return p != nil && slices.Contains(p.Roles, role)
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal attached by the auth interceptor, or nil
+// for anonymous calls.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
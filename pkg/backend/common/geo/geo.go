@@ -0,0 +1,259 @@
+// Package geo parses and measures the GeoJSON geometries used for farm and
+// field boundaries.
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// EarthRadius is the WGS 84 equatorial radius in metres.
+	EarthRadius = 6378137.0
+
+	// MaxPositions bounds the size of a geometry so that validation, which
+	// is quadratic in the ring length, stays cheap.
+	MaxPositions = 5000
+
+	squareMetresPerHectare = 10000
+)
+
+// Position is a longitude, latitude pair in WGS 84 degrees.
+type Position [2]float64
+
+func (p Position) Lon() float64 { return p[0] }
+func (p Position) Lat() float64 { return p[1] }
+
+// Ring is a closed line string; the first and last positions are equal.
+type Ring []Position
+
+// Polygon is an exterior ring followed by any number of holes.
+type Polygon []Ring
+
+// MultiPolygon is the normalised form of every boundary. A GeoJSON Polygon
+// parses into a MultiPolygon with a single element.
+type MultiPolygon []Polygon
+
+type geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Parse parses and validates a GeoJSON Polygon or MultiPolygon geometry.
+func Parse(raw string) (MultiPolygon, error) {
+	var g geometry
+	if err := json.Unmarshal([]byte(raw), &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	var m MultiPolygon
+	switch g.Type {
+	case "Polygon":
+		var p Polygon
+		if err := unmarshalCoordinates(g.Coordinates, &p); err != nil {
+			return nil, err
+		}
+		m = MultiPolygon{p}
+	case "MultiPolygon":
+		if err := unmarshalCoordinates(g.Coordinates, &m); err != nil {
+			return nil, err
+		}
+	case "":
+		return nil, errors.New("invalid GeoJSON: missing geometry type")
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q, want Polygon or MultiPolygon", g.Type)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func unmarshalCoordinates(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return errors.New("invalid GeoJSON: missing coordinates")
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("invalid GeoJSON coordinates: %w", err)
+	}
+	return nil
+}
+
+// Validate checks that every ring is closed, has at least four positions
+// with valid coordinates, does not cross itself and that every polygon
+// encloses a non-zero area.
+func (m MultiPolygon) Validate() error {
+	if len(m) == 0 {
+		return errors.New("geometry has no polygons")
+	}
+	total := 0
+	for i, polygon := range m {
+		if len(polygon) == 0 {
+			return fmt.Errorf("polygon %d has no rings", i)
+		}
+		for j, ring := range polygon {
+			total += len(ring)
+			if total > MaxPositions {
+				return fmt.Errorf("geometry has more than %d positions", MaxPositions)
+			}
+			if err := ring.validate(); err != nil {
+				return fmt.Errorf("polygon %d ring %d: %w", i, j, err)
+			}
+		}
+		if polygon.Area() <= 0 {
+			return fmt.Errorf("polygon %d has no area", i)
+		}
+	}
+	return nil
+}
+
+func (r Ring) validate() error {
+	if len(r) < 4 {
+		return fmt.Errorf("has %d positions, want at least 4", len(r))
+	}
+	for k, p := range r {
+		if math.IsNaN(p.Lon()) || p.Lon() < -180 || p.Lon() > 180 {
+			return fmt.Errorf("position %d has invalid longitude %v", k, p.Lon())
+		}
+		if math.IsNaN(p.Lat()) || p.Lat() < -90 || p.Lat() > 90 {
+			return fmt.Errorf("position %d has invalid latitude %v", k, p.Lat())
+		}
+	}
+	if r[0] != r[len(r)-1] {
+		return errors.New("is not closed")
+	}
+	n := len(r) - 1
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			// neighbouring segments share an end point
+			if b == a+1 || (a == 0 && b == n-1) {
+				continue
+			}
+			if segmentsIntersect(r[a], r[a+1], r[b], r[b+1]) {
+				return fmt.Errorf("intersects itself between positions %d and %d", a, b)
+			}
+		}
+	}
+	return nil
+}
+
+// segmentsIntersect reports whether the segments pq and rs touch, treating
+// coordinates as planar.
+func segmentsIntersect(p, q, r, s Position) bool {
+	d1 := orientation(r, s, p)
+	d2 := orientation(r, s, q)
+	d3 := orientation(p, q, r)
+	d4 := orientation(p, q, s)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(r, s, p)) || (d2 == 0 && onSegment(r, s, q)) ||
+		(d3 == 0 && onSegment(p, q, r)) || (d4 == 0 && onSegment(p, q, s))
+}
+
+func orientation(a, b, c Position) float64 {
+	return (b.Lon()-a.Lon())*(c.Lat()-a.Lat()) - (b.Lat()-a.Lat())*(c.Lon()-a.Lon())
+}
+
+// onSegment reports whether c, known to be collinear with ab, lies on ab.
+func onSegment(a, b, c Position) bool {
+	return math.Min(a.Lon(), b.Lon()) <= c.Lon() && c.Lon() <= math.Max(a.Lon(), b.Lon()) &&
+		math.Min(a.Lat(), b.Lat()) <= c.Lat() && c.Lat() <= math.Max(a.Lat(), b.Lat())
+}
+
+// Area returns the geodesic area of r on a sphere in square metres. The sign
+// depends on the winding order.
+//
+// See Chamberlain and Duquette, "Some Algorithms for Polygons on a Sphere",
+// JPL Publication 07-03.
+func (r Ring) Area() float64 {
+	n := len(r)
+	if n < 4 {
+		return 0
+	}
+	// the closing position repeats the first one
+	n--
+	total := 0.0
+	for i := 0; i < n; i++ {
+		lower, middle, upper := r[i], r[(i+1)%n], r[(i+2)%n]
+		total += (radians(upper.Lon()) - radians(lower.Lon())) * math.Sin(radians(middle.Lat()))
+	}
+	return total * EarthRadius * EarthRadius / 2
+}
+
+// Area returns the area of p's exterior ring minus its holes in square
+// metres.
+func (p Polygon) Area() float64 {
+	if len(p) == 0 {
+		return 0
+	}
+	area := math.Abs(p[0].Area())
+	for _, hole := range p[1:] {
+		area -= math.Abs(hole.Area())
+	}
+	return math.Max(area, 0)
+}
+
+// Area returns the total area of m in square metres.
+func (m MultiPolygon) Area() float64 {
+	area := 0.0
+	for _, p := range m {
+		area += p.Area()
+	}
+	return area
+}
+
+// Hectares returns the total area of m in hectares.
+func (m MultiPolygon) Hectares() float64 {
+	return m.Area() / squareMetresPerHectare
+}
+
+// Center returns the middle of the bounding box of m's exterior rings,
+// which is close enough to the centre of a farm or field for looking up its
+// latitude or the nearest weather station.
+func (m MultiPolygon) Center() Position {
+	minLon, minLat := math.Inf(1), math.Inf(1)
+	maxLon, maxLat := math.Inf(-1), math.Inf(-1)
+	for _, p := range m {
+		if len(p) == 0 {
+			continue
+		}
+		for _, pos := range p[0] {
+			minLon, maxLon = math.Min(minLon, pos.Lon()), math.Max(maxLon, pos.Lon())
+			minLat, maxLat = math.Min(minLat, pos.Lat()), math.Max(maxLat, pos.Lat())
+		}
+	}
+	if math.IsInf(minLon, 1) {
+		return Position{}
+	}
+	return Position{(minLon + maxLon) / 2, (minLat + maxLat) / 2}
+}
+
+// Distance returns the great circle distance between p and q in metres.
+func Distance(p, q Position) float64 {
+	dLat := radians(q.Lat() - p.Lat())
+	dLon := radians(q.Lon() - p.Lon())
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(radians(p.Lat()))*math.Cos(radians(q.Lat()))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// GeoJSON renders m as a GeoJSON geometry, using a Polygon when m has a
+// single element.
+func (m MultiPolygon) GeoJSON() string {
+	g := struct {
+		Type        string `json:"type"`
+		Coordinates any    `json:"coordinates"`
+	}{Type: "MultiPolygon", Coordinates: m}
+	if len(m) == 1 {
+		g.Type, g.Coordinates = "Polygon", m[0]
+	}
+	// cannot fail, validated geometries hold finite numbers only
+	raw, _ := json.Marshal(g)
+	return string(raw)
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,191 @@
+package geo
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// square returns the counter-clockwise ring of the size by size degree
+// square whose south-west corner is at lon, lat.
+func square(lon, lat, size float64) Ring {
+	return Ring{{lon, lat}, {lon + size, lat}, {lon + size, lat + size}, {lon, lat + size}, {lon, lat}}
+}
+
+// squareArea is the area of square(lon, lat, size) on the sphere.
+func squareArea(lat, size float64) float64 {
+	return EarthRadius * EarthRadius * radians(size) * (math.Sin(radians(lat+size)) - math.Sin(radians(lat)))
+}
+
+func reversed(r Ring) Ring {
+	out := make(Ring, len(r))
+	for i, p := range r {
+		out[len(r)-1-i] = p
+	}
+	return out
+}
+
+func TestArea(t *testing.T) {
+	tests := []struct {
+		name string
+		m    MultiPolygon
+		want float64
+	}{
+		{"equator square", MultiPolygon{{square(0, 0, 0.01)}}, squareArea(0, 0.01)},
+		{"clockwise square", MultiPolygon{{reversed(square(0, 0, 0.01))}}, squareArea(0, 0.01)},
+		{"mid latitude square", MultiPolygon{{square(10, 45, 0.01)}}, squareArea(45, 0.01)},
+		{"southern square", MultiPolygon{{square(-47.9, -15.8, 0.01)}}, squareArea(-15.8, 0.01)},
+		{"square with a hole", MultiPolygon{{square(0, 0, 0.01), reversed(square(0.004, 0.004, 0.002))}},
+			squareArea(0, 0.01) - squareArea(0.004, 0.002)},
+		{"hole wound like the exterior", MultiPolygon{{square(0, 0, 0.01), square(0.004, 0.004, 0.002)}},
+			squareArea(0, 0.01) - squareArea(0.004, 0.002)},
+		{"two squares", MultiPolygon{{square(0, 0, 0.01)}, {square(1, 0, 0.01)}}, 2 * squareArea(0, 0.01)},
+		{"empty", MultiPolygon{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.Area()
+			if math.Abs(got-tt.want) > 1e-6*tt.want {
+				t.Errorf("Area() = %v, want %v", got, tt.want)
+			}
+			if hectares := tt.m.Hectares(); math.Abs(hectares-got/10000) > 1e-9 {
+				t.Errorf("Hectares() = %v, want %v", hectares, got/10000)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    float64
+		wantErr string
+	}{
+		{
+			name: "polygon",
+			raw:  `{"type":"Polygon","coordinates":[[[0,0],[0.01,0],[0.01,0.01],[0,0.01],[0,0]]]}`,
+			want: squareArea(0, 0.01),
+		},
+		{
+			name: "multipolygon",
+			raw:  `{"type":"MultiPolygon","coordinates":[[[[0,0],[0.01,0],[0.01,0.01],[0,0.01],[0,0]]],[[[1,0],[1.01,0],[1.01,0.01],[1,0.01],[1,0]]]]}`,
+			want: 2 * squareArea(0, 0.01),
+		},
+		{name: "not JSON", raw: `{`, wantErr: "invalid GeoJSON"},
+		{name: "missing type", raw: `{"coordinates":[]}`, wantErr: "missing geometry type"},
+		{name: "point", raw: `{"type":"Point","coordinates":[0,0]}`, wantErr: `unsupported geometry type "Point"`},
+		{name: "missing coordinates", raw: `{"type":"Polygon"}`, wantErr: "missing coordinates"},
+		{name: "no polygons", raw: `{"type":"MultiPolygon","coordinates":[]}`, wantErr: "geometry has no polygons"},
+		{name: "no rings", raw: `{"type":"Polygon","coordinates":[]}`, wantErr: "polygon 0 has no rings"},
+		{
+			name:    "too few positions",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`,
+			wantErr: "polygon 0 ring 0: has 3 positions, want at least 4",
+		},
+		{
+			name:    "not closed",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`,
+			wantErr: "polygon 0 ring 0: is not closed",
+		},
+		{
+			name:    "invalid longitude",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[181,0],[1,1],[0,0]]]}`,
+			wantErr: "position 1 has invalid longitude 181",
+		},
+		{
+			name:    "invalid latitude",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,91],[0,0]]]}`,
+			wantErr: "position 2 has invalid latitude 91",
+		},
+		{
+			name:    "bow tie",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,1],[0,0]]]}`,
+			wantErr: "polygon 0 ring 0: intersects itself between positions 0 and 2",
+		},
+		{
+			name:    "no area",
+			raw:     `{"type":"Polygon","coordinates":[[[0,0],[0.01,0],[0.01,0.01],[0,0.01],[0,0]],[[0,0.01],[0.01,0.01],[0.01,0],[0,0],[0,0.01]]]}`,
+			wantErr: "polygon 0 has no area",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := m.Area(); math.Abs(got-tt.want) > 1e-6*tt.want {
+				t.Errorf("Area() = %v, want %v", got, tt.want)
+			}
+			again, err := Parse(m.GeoJSON())
+			if err != nil || again.GeoJSON() != m.GeoJSON() {
+				t.Errorf("GeoJSON() = %s does not parse back: %v", m.GeoJSON(), err)
+			}
+		})
+	}
+}
+
+func TestParseMaxPositions(t *testing.T) {
+	var ring strings.Builder
+	ring.WriteString(`{"type":"Polygon","coordinates":[[`)
+	for i := 0; i < MaxPositions; i++ {
+		a := 2 * math.Pi * float64(i) / MaxPositions
+		ring.WriteString("[" + strconv.FormatFloat(math.Cos(a), 'f', -1, 64) + "," + strconv.FormatFloat(math.Sin(a), 'f', -1, 64) + "],")
+	}
+	ring.WriteString("[1,0]]]}")
+	if _, err := Parse(ring.String()); err == nil || !strings.Contains(err.Error(), "more than 5000 positions") {
+		t.Errorf("Parse() error = %v, want too many positions", err)
+	}
+}
+
+func TestCenter(t *testing.T) {
+	tests := []struct {
+		name string
+		m    MultiPolygon
+		want Position
+	}{
+		{"square", MultiPolygon{{square(10, 45, 0.02)}}, Position{10.01, 45.01}},
+		{"holes are ignored", MultiPolygon{{square(0, 0, 1), square(5, 5, 1)}}, Position{0.5, 0.5}},
+		{"bounding box of all polygons", MultiPolygon{{square(0, 0, 1)}, {square(3, -2, 1)}}, Position{2, -0.5}},
+		{"empty", MultiPolygon{}, Position{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.Center()
+			if math.Abs(got.Lon()-tt.want.Lon()) > 1e-9 || math.Abs(got.Lat()-tt.want.Lat()) > 1e-9 {
+				t.Errorf("Center() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		p, q Position
+		want float64
+	}{
+		{"same point", Position{10, 45}, Position{10, 45}, 0},
+		{"one degree along the equator", Position{0, 0}, Position{1, 0}, 111319.49},
+		{"antipodes", Position{0, 0}, Position{180, 0}, math.Pi * EarthRadius},
+		{"London to Paris", Position{-0.1278, 51.5074}, Position{2.3522, 48.8566}, 343940.92},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.p, tt.q); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("Distance() = %v, want %v", got, tt.want)
+			}
+			if got, back := Distance(tt.p, tt.q), Distance(tt.q, tt.p); math.Abs(got-back) > 1e-6 {
+				t.Errorf("Distance() is not symmetric: %v and %v", got, back)
+			}
+		})
+	}
+}
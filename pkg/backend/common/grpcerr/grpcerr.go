@@ -0,0 +1,141 @@
+package grpcerr
+
+import (
+	"errors"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FromError converts err into a gRPC status error, prefixing the message with
+// msg. Repository errors are mapped onto the matching code and carry an
+// errdetails payload naming the offending field, a PreconditionFailure for
+// failed preconditions; errors that already are statuses pass through
+// unchanged. Driver errors never reach the caller: classified ones are
+// described by the message they were wrapped with, and Internal and
+// Unavailable statuses carry only msg; Cause returns err for the server log.
+func FromError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var code codes.Code
+	switch repoerr.KindOf(err) {
+	case repoerr.KindNotFound:
+		code = codes.NotFound
+	case repoerr.KindConflict:
+		code = codes.AlreadyExists
+	case repoerr.KindInvalidArgument:
+		code = codes.InvalidArgument
+	case repoerr.KindUnavailable:
+		code = codes.Unavailable
+	case repoerr.KindFailedPrecondition:
+		code = codes.FailedPrecondition
+	default:
+		code = codes.Internal
+	}
+	if code == codes.Internal || code == codes.Unavailable {
+		return &causeError{status: status.New(code, msg), cause: err}
+	}
+
+	// only classified errors get here
+	var e *repoerr.Error
+	errors.As(err, &e)
+	detail := err.Error()
+	if e.Err != nil {
+		// classified driver error, its text stays in the server
+		detail = e.Msg
+	}
+	st := status.New(code, msg+": "+detail)
+	field := e.Field
+	if field == "" {
+		return st.Err()
+	}
+
+	if e.Kind == repoerr.KindFailedPrecondition {
+		detailed, derr := st.WithDetails(&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Subject:     field,
+				Description: e.Msg,
+			}},
+		})
+		if derr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+	description := e.Msg
+	if e.Err != nil {
+		// classified driver error, the message describes the operation
+		description = e.Kind.String()
+		if e.Kind == repoerr.KindConflict {
+			description = "already exists"
+		}
+	}
+	detailed, derr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: description,
+		}},
+	})
+	if derr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// causeError is a status error that keeps the error it was converted from
+// out of its message.
+type causeError struct {
+	status *status.Status
+	cause  error
+}
+
+func (e *causeError) Error() string {
+	return e.status.Err().Error()
+}
+
+func (e *causeError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Cause returns the error FromError left out of the message of err, or nil.
+func Cause(err error) error {
+	var e *causeError
+	if errors.As(err, &e) {
+		return e.cause
+	}
+	return nil
+}
+
+// InvalidArgument returns an InvalidArgument status with a field violation
+// for field.
+func InvalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: description,
+		}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// FailedPrecondition returns a FailedPrecondition status carrying violations
+// as a PreconditionFailure.
+func FailedPrecondition(msg string, violations ...*errdetails.PreconditionFailure_Violation) error {
+	st := status.New(codes.FailedPrecondition, msg)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
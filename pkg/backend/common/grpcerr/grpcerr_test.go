@@ -0,0 +1,164 @@
+package grpcerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func badRequest(field, description string) proto.Message {
+	return &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}}}
+}
+
+func TestFromError(t *testing.T) {
+	// driver errors, whose text must stay in the server
+	unique := &pgconn.PgError{Code: "23505", Message: "duplicate key value violates unique constraint \"uni_users_email\"", Detail: "Key (email)=(a@example.com) already exists."}
+	foreignKey := &pgconn.PgError{Code: "23503", Message: "insert or update violates foreign key constraint", Detail: "Key (farm_id)=(f1) is not present in table \"farms\"."}
+	shutdown := &pgconn.PgError{Code: "57P01", Message: "terminating connection due to administrator command"}
+	undefined := &pgconn.PgError{Code: "42P01", Message: "relation \"users\" does not exist"}
+	denied := status.Error(codes.PermissionDenied, "not yours")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMsg     string
+		wantDetails []proto.Message
+		wantCause   bool
+	}{
+		{
+			name:        "not found",
+			err:         repoerr.NotFound("id", "no user found with ID: u1"),
+			wantCode:    codes.NotFound,
+			wantMsg:     "failed: no user found with ID: u1",
+			wantDetails: []proto.Message{badRequest("id", "no user found with ID: u1")},
+		},
+		{
+			name:     "not found by the driver",
+			err:      repoerr.FromGorm(gorm.ErrRecordNotFound, "failed to fetch user"),
+			wantCode: codes.NotFound,
+			wantMsg:  "failed: failed to fetch user",
+		},
+		{
+			name:        "conflict",
+			err:         repoerr.Conflict("name", "role %q exists", "owner"),
+			wantCode:    codes.AlreadyExists,
+			wantMsg:     `failed: role "owner" exists`,
+			wantDetails: []proto.Message{badRequest("name", `role "owner" exists`)},
+		},
+		{
+			name:        "conflict by the driver",
+			err:         repoerr.FromGorm(fmt.Errorf("ERROR: %w", unique), "failed to insert user"),
+			wantCode:    codes.AlreadyExists,
+			wantMsg:     "failed: failed to insert user",
+			wantDetails: []proto.Message{badRequest("email", "already exists")},
+		},
+		{
+			name:        "invalid argument",
+			err:         repoerr.InvalidArgument("pageToken", "malformed page token"),
+			wantCode:    codes.InvalidArgument,
+			wantMsg:     "failed: malformed page token",
+			wantDetails: []proto.Message{badRequest("pageToken", "malformed page token")},
+		},
+		{
+			name:        "invalid argument by the driver",
+			err:         repoerr.FromGorm(foreignKey, "failed to insert field"),
+			wantCode:    codes.InvalidArgument,
+			wantMsg:     "failed: failed to insert field",
+			wantDetails: []proto.Message{badRequest("farm_id", "invalid argument")},
+		},
+		{
+			name:     "failed precondition",
+			err:      repoerr.FailedPrecondition("weather", "no observations for field %s", "f1"),
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "failed: no observations for field f1",
+			wantDetails: []proto.Message{&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{
+				Subject:     "weather",
+				Description: "no observations for field f1",
+			}}}},
+		},
+		{
+			name:     "failed precondition without subject",
+			err:      repoerr.FailedPrecondition("", "lot is empty"),
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "failed: lot is empty",
+		},
+		{
+			name:        "wrapped",
+			err:         fmt.Errorf("loading plan: %w", repoerr.NotFound("id", "no plan p1")),
+			wantCode:    codes.NotFound,
+			wantMsg:     "failed: loading plan: no plan p1",
+			wantDetails: []proto.Message{badRequest("id", "no plan p1")},
+		},
+		{
+			name:      "unavailable by the driver",
+			err:       repoerr.FromGorm(shutdown, "failed to list users"),
+			wantCode:  codes.Unavailable,
+			wantMsg:   "failed",
+			wantCause: true,
+		},
+		{
+			name:      "internal by the driver",
+			err:       repoerr.FromGorm(undefined, "failed to list users"),
+			wantCode:  codes.Internal,
+			wantMsg:   "failed",
+			wantCause: true,
+		},
+		{
+			name:      "unclassified",
+			err:       errors.New("disk on fire"),
+			wantCode:  codes.Internal,
+			wantMsg:   "failed",
+			wantCause: true,
+		},
+		{
+			name:     "status",
+			err:      denied,
+			wantCode: codes.PermissionDenied,
+			wantMsg:  "not yours",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromError(tt.err, "failed")
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("FromError() = %v, not a status", err)
+			}
+			if st.Code() != tt.wantCode {
+				t.Errorf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if st.Message() != tt.wantMsg {
+				t.Errorf("message = %q, want %q", st.Message(), tt.wantMsg)
+			}
+			details := st.Details()
+			if len(details) != len(tt.wantDetails) {
+				t.Fatalf("details = %v, want %v", details, tt.wantDetails)
+			}
+			for i, d := range details {
+				if m, ok := d.(proto.Message); !ok || !proto.Equal(m, tt.wantDetails[i]) {
+					t.Errorf("details[%d] = %v, want %v", i, d, tt.wantDetails[i])
+				}
+			}
+			var wantCause error
+			if tt.wantCause {
+				wantCause = tt.err
+			}
+			if cause := Cause(err); cause != wantCause {
+				t.Errorf("Cause() = %v, want %v", cause, wantCause)
+			}
+		})
+	}
+
+	if err := FromError(nil, "failed"); err != nil {
+		t.Errorf("FromError(nil) = %v, want nil", err)
+	}
+}
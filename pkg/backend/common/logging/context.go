@@ -0,0 +1,51 @@
+// Package logging provides request scoped structured logging for the gRPC
+// server, its HTTP gateway and the repositories behind them.
+package logging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// RequestIDKey is the metadata key, and HTTP header, carrying the request ID.
+// Incoming IDs are kept so that a request can be followed across services.
+const RequestIDKey = "x-request-id"
+
+type contextKey struct{}
+
+type requestInfo struct {
+	requestID string
+	method    string
+	traceID   string
+}
+
+func newContext(ctx context.Context, requestID, method string) context.Context {
+	info := requestInfo{requestID: requestID, method: method}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		info.traceID = sc.TraceID().String()
+	}
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// RequestID returns the ID of the request ctx belongs to, if any.
+func RequestID(ctx context.Context) string {
+	info, _ := ctx.Value(contextKey{}).(requestInfo)
+	return info.requestID
+}
+
+// WithContext returns logger annotated with the request ID, method and trace
+// ID of the request ctx belongs to. logger is returned as is outside of
+// requests.
+func WithContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	info, ok := ctx.Value(contextKey{}).(requestInfo)
+	if !ok {
+		return logger
+	}
+	fields := []zap.Field{zap.String("request_id", info.requestID), zap.String("method", info.method)}
+	if info.traceID != "" {
+		fields = append(fields, zap.String("trace_id", info.traceID))
+	}
+	return logger.With(fields...)
+}
@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger logs GORM statements through zap with the request ID of the
+// context the statement ran with, so handlers should pass
+// db.WithContext(ctx) to the repositories. Statements are logged with
+// placeholders only; bound values may hold password hashes or tokens.
+type GormLogger struct {
+	logger        *zap.Logger
+	level         gormlogger.LogLevel
+	slowThreshold time.Duration
+}
+
+// NewGormLogger logs failed statements and statements slower than
+// slowThreshold as warnings and every other statement at debug level.
+func NewGormLogger(logger *zap.Logger, slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{
+		logger:        logger,
+		level:         gormlogger.Info,
+		slowThreshold: slowThreshold,
+	}
+}
+
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Info {
+		WithContext(ctx, l.logger).Info(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Warn {
+		WithContext(ctx, l.logger).Warn(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Error {
+		WithContext(ctx, l.logger).Error(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	logger := WithContext(ctx, l.logger)
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gormlogger.Error:
+		sql, rows := fc()
+		logger.Warn("Query failed", zap.String("sql", sql), zap.Int64("rows", rows), zap.Duration("elapsed", elapsed), zap.Error(err))
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		logger.Warn("Slow query", zap.String("sql", sql), zap.Int64("rows", rows), zap.Duration("elapsed", elapsed))
+	case l.level >= gormlogger.Info && logger.Core().Enabled(zap.DebugLevel):
+		sql, rows := fc()
+		logger.Debug("Query", zap.String("sql", sql), zap.Int64("rows", rows), zap.Duration("elapsed", elapsed))
+	}
+}
+
+// ParamsFilter drops the bound values from logged statements.
+func (l *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...any) (string, []any) {
+	return sql, nil
+}
@@ -0,0 +1,135 @@
+package logging
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor assigns every call a request ID, returned to the
+// caller in the response header, and logs one line per call. Requests are
+// logged at debug level with sensitive fields redacted. It should be the
+// outermost interceptor so that calls rejected by the others are logged too.
+//
+// The x-forwarded-for metadata is only logged for calls from trustedPeers,
+// such as the HTTP gateway, as any other client can set it to anything.
+func UnaryServerInterceptor(logger *zap.Logger, trustedPeers []netip.Prefix) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, requestID := startRequest(ctx, info.FullMethod)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, requestID))
+		logger := WithContext(ctx, logger)
+		if ce := logger.Check(zapcore.DebugLevel, "Request"); ce != nil {
+			ce.Write(zap.String("request", redactedJSON(req)))
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, start, err, trustedPeers)
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor(logger *zap.Logger, trustedPeers []netip.Prefix) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := startRequest(ss.Context(), info.FullMethod)
+		ss.SetHeader(metadata.Pairs(RequestIDKey, requestID))
+		logger := WithContext(ctx, logger)
+
+		start := time.Now()
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		logCall(ctx, logger, info.FullMethod, start, err, trustedPeers)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func startRequest(ctx context.Context, method string) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var requestID string
+	if ids := md.Get(RequestIDKey); len(ids) > 0 && ids[0] != "" && len(ids[0]) <= 128 {
+		requestID = ids[0]
+	} else {
+		requestID = uuid.NewString()
+	}
+	return newContext(ctx, requestID, method), requestID
+}
+
+func logCall(ctx context.Context, logger *zap.Logger, method string, start time.Time, err error, trustedPeers []netip.Prefix) {
+	code := status.Code(err)
+	level := codeLevel(code)
+	// orchestrators probe health every few seconds
+	if code == codes.OK && strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+		level = zapcore.DebugLevel
+	}
+	ce := logger.Check(level, "Finished call")
+	if ce == nil {
+		return
+	}
+	fields := []zap.Field{
+		zap.String("code", code.String()),
+		zap.Duration("latency", time.Since(start)),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.Stringer("peer", p.Addr))
+		if md, ok := metadata.FromIncomingContext(ctx); ok && trusted(p.Addr, trustedPeers) {
+			if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+				fields = append(fields, zap.String("forwarded_for", forwardedFor[0]))
+			}
+		}
+	}
+	if err != nil {
+		fields = append(fields, zap.String("error", status.Convert(err).Message()))
+		if cause := grpcerr.Cause(err); cause != nil {
+			fields = append(fields, zap.NamedError("cause", cause))
+		}
+	}
+	ce.Write(fields...)
+}
+
+func trusted(addr net.Addr, prefixes []netip.Prefix) bool {
+	addrPort, err := netip.ParseAddrPort(addr.String())
+	if err != nil {
+		return false
+	}
+	ip := addrPort.Addr().Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeLevel logs failures caused by the caller at info level and failures of
+// the server at warn or error level.
+func codeLevel(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound,
+		codes.AlreadyExists, codes.Unauthenticated:
+		return zapcore.InfoLevel
+	case codes.Unknown, codes.Unimplemented, codes.Internal, codes.DataLoss:
+		return zapcore.ErrorLevel
+	}
+	return zapcore.WarnLevel
+}
@@ -0,0 +1,135 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/logging/internal/testpb"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	session := &testpb.Session{
+		Id:    "s1",
+		Token: "session-token",
+		Pin:   1234,
+		Credentials: &testpb.Credentials{
+			Username: "ada",
+			Password: "hunter22",
+			Tokens:   []string{"t1", "t2"},
+			Previous: &testpb.Credentials{
+				Username: "ada",
+				Password: "hunter11",
+				Previous: &testpb.Credentials{Password: "hunter00"},
+			},
+		},
+		History: []*testpb.Credentials{
+			{Username: "ada", Password: "old1"},
+			{Username: "eve"},
+			{Previous: &testpb.Credentials{Password: "old2"}},
+		},
+		Devices: map[string]*testpb.Credentials{
+			"phone": {Username: "ada", Password: "phone-secret", Tokens: []string{"t3"}},
+		},
+		Impersonator: &testpb.Credentials{Username: "root", Password: "root-secret"},
+	}
+	original := proto.Clone(session)
+
+	want := &testpb.Session{
+		Id:    "s1",
+		Token: redacted,
+		Credentials: &testpb.Credentials{
+			Username: "ada",
+			Password: redacted,
+			Previous: &testpb.Credentials{
+				Username: "ada",
+				Password: redacted,
+				Previous: &testpb.Credentials{Password: redacted},
+			},
+		},
+		History: []*testpb.Credentials{
+			{Username: "ada", Password: redacted},
+			// unset fields stay unset
+			{Username: "eve"},
+			{Previous: &testpb.Credentials{Password: redacted}},
+		},
+		Devices: map[string]*testpb.Credentials{
+			"phone": {Username: "ada", Password: redacted},
+		},
+	}
+	if got := Redact(session); !proto.Equal(got, want) {
+		t.Errorf("Redact() = %v, want %v", got, want)
+	}
+	if !proto.Equal(session, original) {
+		t.Error("Redact() modified the original")
+	}
+
+	out := redactedJSON(session)
+	for _, secret := range []string{"session-token", "1234", "hunter", "old1", "old2", "phone-secret", "root-secret", `"t1"`, `"t3"`} {
+		if strings.Contains(out, secret) {
+			t.Errorf("redactedJSON() = %s, contains %s", out, secret)
+		}
+	}
+	if got := redactedJSON("not a message"); got != "" {
+		t.Errorf("redactedJSON(string) = %q, want empty", got)
+	}
+}
+
+func TestForwardedFor(t *testing.T) {
+	trustedPeers := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+	tests := []struct {
+		name string
+		peer net.Addr
+		want string
+	}{
+		{"trusted peer", &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 4000}, "203.0.113.7"},
+		{"trusted ipv6 peer", &net.TCPAddr{IP: net.IPv6loopback, Port: 4000}, "203.0.113.7"},
+		{"ipv4 mapped peer", &net.TCPAddr{IP: net.ParseIP("::ffff:10.0.0.2"), Port: 4000}, "203.0.113.7"},
+		{"untrusted peer", &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 4000}, ""},
+		{"unix socket", &net.UnixAddr{Name: "/run/agro.sock", Net: "unix"}, ""},
+		{"no peer", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&buf), zapcore.InfoLevel)
+			interceptor := UnaryServerInterceptor(zap.New(core), trustedPeers)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "203.0.113.7"))
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUserById"}
+			handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+			if _, err := interceptor(ctx, nil, info, handler); err != nil {
+				t.Fatal(err)
+			}
+
+			var line map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+				t.Fatalf("log %q: %v", buf.String(), err)
+			}
+			if line["msg"] != "Finished call" {
+				t.Fatalf("logged %v", line)
+			}
+			got, _ := line["forwarded_for"].(string)
+			if got != tt.want {
+				t.Errorf("forwarded_for = %q, want %q", got, tt.want)
+			}
+			if tt.peer != nil && line["peer"] != tt.peer.String() {
+				t.Errorf("peer = %v, want %v", line["peer"], tt.peer)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package logging
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const redacted = "[REDACTED]"
+
+// Redact returns a copy of m in which every field marked with
+// [debug_redact = true] in the proto, at any depth, is masked.
+func Redact(m proto.Message) proto.Message {
+	clone := proto.Clone(m)
+	redact(clone.ProtoReflect())
+	return clone
+}
+
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isRedacted(fd) {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+			return true
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redact(v.Message())
+		}
+		return true
+	})
+}
+
+func isRedacted(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+// redactedJSON renders m for the logs with sensitive fields masked.
+func redactedJSON(m any) string {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return ""
+	}
+	b, err := protojson.Marshal(Redact(msg))
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
@@ -0,0 +1,142 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Sort orders a listing by Column, with the primary key as tie breaker so
+// that the order is total and pages are stable.
+type Sort struct {
+	Column string
+	Desc   bool
+}
+
+// Query selects a page of a listing. Offset is only honoured when no
+// PageToken is given and exists for clients of the older page number API.
+type Query struct {
+	PageSize     int
+	PageToken    string
+	Offset       int
+	Sort         Sort
+	IncludeTotal bool
+}
+
+type Page[T any] struct {
+	Items         []*T
+	NextPageToken string
+	// TotalCount is only set when the query asked for it.
+	TotalCount int64
+}
+
+// KeyFunc returns the sort column value and the primary key of item. The
+// value is stored in the page token and compared against the column, so it
+// must be a textual representation Postgres can cast to the column type.
+type KeyFunc[T any] func(item *T) (value string, id string)
+
+type cursor struct {
+	Column string `json:"c"`
+	Desc   bool   `json:"d,omitempty"`
+	Value  string `json:"v"`
+	ID     string `json:"i"`
+}
+
+// List returns a page of T using keyset pagination on (q.Sort.Column, id).
+// db may carry filters; q.Sort.Column must come from a whitelist since it is
+// interpolated into the query.
+func List[T any](db *gorm.DB, q Query, key KeyFunc[T]) (*Page[T], error) {
+	size := q.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	page := &Page[T]{}
+	if q.IncludeTotal {
+		if err := db.Session(&gorm.Session{}).Model(new(T)).Count(&page.TotalCount).Error; err != nil {
+			return nil, repoerr.FromGorm(err, "failed to count records")
+		}
+	}
+
+	dir := "ASC"
+	op := ">"
+	if q.Sort.Desc {
+		dir, op = "DESC", "<"
+	}
+
+	tx := db.Session(&gorm.Session{})
+	if q.PageToken != "" {
+		c, err := decode(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if c.Column != q.Sort.Column || c.Desc != q.Sort.Desc {
+			return nil, repoerr.InvalidArgument("pageToken", "page token was issued for a different sort order")
+		}
+		tx = tx.Where(fmt.Sprintf("(%s, id) %s (?, ?)", q.Sort.Column, op), c.Value, c.ID)
+	} else if q.Offset > 0 {
+		tx = tx.Offset(q.Offset)
+	}
+
+	var items []*T
+	result := tx.Order(fmt.Sprintf("%s %s, id %s", q.Sort.Column, dir, dir)).Limit(size + 1).Find(&items)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to list records")
+	}
+
+	if len(items) > size {
+		items = items[:size]
+		value, id := key(items[size-1])
+		token, err := encode(cursor{Column: q.Sort.Column, Desc: q.Sort.Desc, Value: value, ID: id})
+		if err != nil {
+			return nil, err
+		}
+		page.NextPageToken = token
+	}
+	page.Items = items
+	return page, nil
+}
+
+func encode(c cursor) (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decode(token string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, repoerr.InvalidArgument("pageToken", "malformed page token")
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, repoerr.InvalidArgument("pageToken", "malformed page token")
+	}
+	return &c, nil
+}
+
+// EscapeLike escapes the LIKE wildcards in s so that it can be used as a
+// literal prefix.
+func EscapeLike(s string) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r == '%' || r == '_' || r == '\\' {
+			out = append(out, '\\')
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,252 @@
+package pagination
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type item struct {
+	ID   string
+	Name string
+}
+
+// store is a database/sql connector answering every query with rows and
+// recording the queries run.
+type store struct {
+	rows    [][]driver.Value
+	queries []string
+	args    [][]driver.Value
+}
+
+func (s *store) Connect(context.Context) (driver.Conn, error) { return &conn{s}, nil }
+func (s *store) Driver() driver.Driver                        { return nil }
+
+type conn struct{ s *store }
+
+func (c *conn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *conn) Close() error                        { return nil }
+func (c *conn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.s.queries = append(c.s.queries, query)
+	var values []driver.Value
+	for _, a := range args {
+		values = append(values, a.Value)
+	}
+	c.s.args = append(c.s.args, values)
+	if strings.HasPrefix(query, "SELECT count(*)") {
+		return &rows{columns: []string{"count"}, values: [][]driver.Value{{int64(len(c.s.rows))}}}, nil
+	}
+	return &rows{columns: []string{"id", "name"}, values: c.s.rows}, nil
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func open(t *testing.T, s *store) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(s)}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.Model(&item{})
+}
+
+func token(t *testing.T, c cursor) string {
+	t.Helper()
+	raw, err := encode(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestList(t *testing.T) {
+	three := [][]driver.Value{{"1", "a"}, {"2", "b"}, {"3", "c"}}
+
+	tests := []struct {
+		name      string
+		rows      [][]driver.Value
+		q         Query
+		wantSQL   string
+		wantArgs  []driver.Value
+		wantIDs   []string
+		wantToken *cursor
+		wantTotal int64
+	}{
+		{
+			name:     "last page",
+			rows:     three[:2],
+			q:        Query{PageSize: 2, Sort: Sort{Column: "name"}},
+			wantSQL:  `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1`,
+			wantArgs: []driver.Value{int64(3)},
+			wantIDs:  []string{"1", "2"},
+		},
+		{
+			name:      "more pages",
+			rows:      three,
+			q:         Query{PageSize: 2, Sort: Sort{Column: "name"}},
+			wantSQL:   `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1`,
+			wantArgs:  []driver.Value{int64(3)},
+			wantIDs:   []string{"1", "2"},
+			wantToken: &cursor{Column: "name", Value: "b", ID: "2"},
+		},
+		{
+			name:     "after a token",
+			rows:     three[2:],
+			q:        Query{PageSize: 2, Sort: Sort{Column: "name"}, PageToken: token(t, cursor{Column: "name", Value: "b", ID: "2"})},
+			wantSQL:  `SELECT * FROM "items" WHERE (name, id) > ($1, $2) ORDER BY name ASC, id ASC LIMIT $3`,
+			wantArgs: []driver.Value{"b", "2", int64(3)},
+			wantIDs:  []string{"3"},
+		},
+		{
+			name:      "descending",
+			rows:      three,
+			q:         Query{PageSize: 2, Sort: Sort{Column: "created_at", Desc: true}, PageToken: token(t, cursor{Column: "created_at", Desc: true, Value: "2024-05-01", ID: "9"})},
+			wantSQL:   `SELECT * FROM "items" WHERE (created_at, id) < ($1, $2) ORDER BY created_at DESC, id DESC LIMIT $3`,
+			wantArgs:  []driver.Value{"2024-05-01", "9", int64(3)},
+			wantIDs:   []string{"1", "2"},
+			wantToken: &cursor{Column: "created_at", Desc: true, Value: "b", ID: "2"},
+		},
+		{
+			name:     "default page size",
+			q:        Query{Sort: Sort{Column: "name"}},
+			wantSQL:  `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1`,
+			wantArgs: []driver.Value{int64(21)},
+		},
+		{
+			name:     "page size capped",
+			q:        Query{PageSize: 1000, Sort: Sort{Column: "name"}},
+			wantSQL:  `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1`,
+			wantArgs: []driver.Value{int64(101)},
+		},
+		{
+			name:     "offset",
+			q:        Query{PageSize: 2, Offset: 4, Sort: Sort{Column: "name"}},
+			wantSQL:  `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1 OFFSET $2`,
+			wantArgs: []driver.Value{int64(3), int64(4)},
+		},
+		{
+			name:     "token wins over offset",
+			q:        Query{PageSize: 2, Offset: 4, Sort: Sort{Column: "name"}, PageToken: token(t, cursor{Column: "name", Value: "b", ID: "2"})},
+			wantSQL:  `SELECT * FROM "items" WHERE (name, id) > ($1, $2) ORDER BY name ASC, id ASC LIMIT $3`,
+			wantArgs: []driver.Value{"b", "2", int64(3)},
+		},
+		{
+			name:      "total",
+			rows:      three[:1],
+			q:         Query{PageSize: 2, Sort: Sort{Column: "name"}, IncludeTotal: true},
+			wantSQL:   `SELECT * FROM "items" ORDER BY name ASC, id ASC LIMIT $1`,
+			wantArgs:  []driver.Value{int64(3)},
+			wantIDs:   []string{"1"},
+			wantTotal: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &store{rows: tt.rows}
+			page, err := List(open(t, s), tt.q, func(i *item) (string, string) { return i.Name, i.ID })
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+
+			if got := s.queries[len(s.queries)-1]; got != tt.wantSQL {
+				t.Errorf("query = %s, want %s", got, tt.wantSQL)
+			}
+			if got := s.args[len(s.args)-1]; !reflect.DeepEqual(got, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", got, tt.wantArgs)
+			}
+			var ids []string
+			for _, i := range page.Items {
+				ids = append(ids, i.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("items = %v, want %v", ids, tt.wantIDs)
+			}
+			if page.TotalCount != tt.wantTotal {
+				t.Errorf("total = %d, want %d", page.TotalCount, tt.wantTotal)
+			}
+
+			if tt.wantToken == nil {
+				if page.NextPageToken != "" {
+					t.Errorf("next page token = %q, want none", page.NextPageToken)
+				}
+				return
+			}
+			c, err := decode(page.NextPageToken)
+			if err != nil {
+				t.Fatalf("next page token: %v", err)
+			}
+			if *c != *tt.wantToken {
+				t.Errorf("next page token = %+v, want %+v", *c, *tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestListRejectsTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		sort  Sort
+	}{
+		{"not base64", "%%%", Sort{Column: "name"}},
+		{"not JSON", "bm90IGpzb24", Sort{Column: "name"}},
+		{"other column", token(t, cursor{Column: "email", Value: "a", ID: "1"}), Sort{Column: "name"}},
+		{"other direction", token(t, cursor{Column: "name", Value: "a", ID: "1"}), Sort{Column: "name", Desc: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &store{}
+			_, err := List(open(t, s), Query{PageToken: tt.token, Sort: tt.sort}, func(i *item) (string, string) { return i.Name, i.ID })
+			if repoerr.KindOf(err) != repoerr.KindInvalidArgument || repoerr.FieldOf(err) != "pageToken" {
+				t.Errorf("List() error = %v, want an invalid pageToken", err)
+			}
+			if len(s.queries) != 0 {
+				t.Errorf("queries run = %v", s.queries)
+			}
+		})
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"north", "north"},
+		{"50%", `50\%`},
+		{"a_b", `a\_b`},
+		{`c:\fields`, `c:\\fields`},
+		{"çã_%", `çã\_\%`},
+	}
+	for _, tt := range tests {
+		if got := EscapeLike(tt.in); got != tt.want {
+			t.Errorf("EscapeLike(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package password
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	current, err := Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cheap := Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 16}
+	outdated, err := HashWithParams("correct horse", cheap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		plain      string
+		encoded    string
+		wantMatch  bool
+		wantRehash bool
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{name: "current hash", plain: "correct horse", encoded: current, wantMatch: true},
+		{name: "wrong password", plain: "battery staple", encoded: current},
+		{name: "outdated parameters", plain: "correct horse", encoded: outdated, wantMatch: true, wantRehash: true},
+		{name: "wrong password for outdated parameters", plain: "battery staple", encoded: outdated},
+		{name: "plain text", plain: "correct horse", encoded: "correct horse", wantMatch: true, wantRehash: true},
+		{name: "wrong plain text", plain: "correct horse!", encoded: "correct horse", wantRehash: true},
+		{name: "empty plain text", plain: "", encoded: "", wantMatch: true, wantRehash: true},
+		{name: "other algorithm", plain: "x", encoded: "$2a$10$abcdefghijklmnopqrstuv", wantErr: ErrInvalidHash},
+		{name: "bad salt", plain: "x", encoded: "$argon2id$v=19$m=1024,t=1,p=1$!!!$AAAA", wantErr: ErrInvalidHash},
+		{name: "bad parameters", plain: "x", encoded: "$argon2id$v=19$m=x$AAAA$AAAA", wantErr: ErrInvalidHash},
+		{name: "other version", plain: "x", encoded: "$argon2id$v=16$m=1024,t=1,p=1$AAAA$AAAA", wantAnyErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash, err := Verify(tt.plain, tt.encoded)
+			switch {
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			case tt.wantAnyErr && err == nil:
+				t.Fatal("Verify() error = nil")
+			case tt.wantErr == nil && !tt.wantAnyErr && err != nil:
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if match != tt.wantMatch || rehash != tt.wantRehash {
+				t.Errorf("Verify() = %v, %v, want %v, %v", match, rehash, tt.wantMatch, tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestHashIsSalted(t *testing.T) {
+	a, err := Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Error("Hash() returned the same hash twice")
+	}
+}
@@ -0,0 +1,171 @@
+package repoerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// Kind classifies repository failures independently of the storage engine.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindConflict
+	KindInvalidArgument
+	KindUnavailable
+	KindFailedPrecondition
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindConflict:
+		return "conflict"
+	case KindInvalidArgument:
+		return "invalid argument"
+	case KindUnavailable:
+		return "unavailable"
+	case KindFailedPrecondition:
+		return "failed precondition"
+	}
+	return "internal"
+}
+
+// Error is a classified repository error. Field names the offending request
+// field, if any.
+type Error struct {
+	Kind  Kind
+	Field string
+	Msg   string
+	Err   error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Msg, e.Err)
+	}
+	return e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrNotFound) and friends match any Error of the
+// same kind.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Msg == "" && t.Kind == e.Kind
+}
+
+// Sentinels for errors.Is checks.
+var (
+	ErrNotFound           = &Error{Kind: KindNotFound}
+	ErrConflict           = &Error{Kind: KindConflict}
+	ErrInvalidArgument    = &Error{Kind: KindInvalidArgument}
+	ErrUnavailable        = &Error{Kind: KindUnavailable}
+	ErrFailedPrecondition = &Error{Kind: KindFailedPrecondition}
+)
+
+func NotFound(field, format string, args ...any) error {
+	return &Error{Kind: KindNotFound, Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(field, format string, args ...any) error {
+	return &Error{Kind: KindConflict, Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+
+func InvalidArgument(field, format string, args ...any) error {
+	return &Error{Kind: KindInvalidArgument, Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+
+// FailedPrecondition reports that the system is not in the state the
+// operation requires, e.g. missing data. subject names what is lacking.
+func FailedPrecondition(subject, format string, args ...any) error {
+	return &Error{Kind: KindFailedPrecondition, Field: subject, Msg: fmt.Sprintf(format, args...)}
+}
+
+// KindOf returns the kind of err, KindInternal if it is not classified.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
+
+// FieldOf returns the offending field of err, if any.
+func FieldOf(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Field
+	}
+	return ""
+}
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation     = "23505"
+	pgExclusionViolation  = "23P01"
+	pgForeignKeyViolation = "23503"
+	pgNotNullViolation    = "23502"
+	pgCheckViolation      = "23514"
+	pgInvalidText         = "22P02"
+	pgStringTooLong       = "22001"
+)
+
+var keyDetail = regexp.MustCompile(`Key \(([^)]+)\)=`)
+
+// FromGorm classifies an error returned by GORM or the Postgres driver and
+// wraps it with msg. Already classified errors are returned unchanged.
+func FromGorm(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	kind, field := classify(err)
+	return &Error{Kind: kind, Field: field, Msg: msg, Err: err}
+}
+
+func classify(err error) (Kind, string) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return KindNotFound, ""
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		field := pgErr.ColumnName
+		if m := keyDetail.FindStringSubmatch(pgErr.Detail); m != nil {
+			field = m[1]
+		}
+		switch pgErr.Code {
+		case pgUniqueViolation, pgExclusionViolation:
+			return KindConflict, field
+		case pgForeignKeyViolation, pgNotNullViolation, pgCheckViolation, pgInvalidText, pgStringTooLong:
+			return KindInvalidArgument, field
+		}
+		// class 08 is connection exceptions, 57P is operator intervention
+		if strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "57P") {
+			return KindUnavailable, ""
+		}
+		return KindInternal, ""
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) || pgconn.SafeToRetry(err) {
+		return KindUnavailable, ""
+	}
+	return KindInternal, ""
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor rejects requests that break their declared rules
+// with InvalidArgument and a BadRequest detail listing every violation.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := check(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor validates every message received on the stream.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return check(m)
+}
+
+func check(req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	violations := Message(msg)
+	if len(violations) == 0 {
+		return nil
+	}
+	detail := &errdetails.BadRequest{}
+	for _, v := range violations {
+		detail.FieldViolations = append(detail.FieldViolations, v.proto())
+	}
+	st := status.New(codes.InvalidArgument, "invalid "+string(msg.ProtoReflect().Descriptor().Name())+": "+violations[0].Field+" "+violations[0].Description)
+	if detailed, err := st.WithDetails(detail); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
@@ -0,0 +1,173 @@
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"sync"
+	"unicode/utf8"
+
+	validatepb "github.com/aburifat/go-agro/pkg/backend/common/validate/proto"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation describes a field that broke one of its rules. Field is the
+// dotted path of the field within the validated message.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+func (v Violation) proto() *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       v.Field,
+		Description: v.Description,
+	}
+}
+
+// Message checks msg, and every message nested in it, against the
+// (validate.rules) options declared in its proto definition.
+func Message(msg proto.Message) []Violation {
+	var violations []Violation
+	message(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+func message(m protoreflect.Message, prefix string, violations *[]Violation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + fd.JSONName()
+		rules := rulesOf(fd)
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if rules != nil {
+				if rules.Required && list.Len() == 0 {
+					*violations = append(*violations, Violation{path, "is required"})
+					continue
+				}
+				if rules.MinItems != nil && uint32(list.Len()) < rules.GetMinItems() {
+					*violations = append(*violations, Violation{path, fmt.Sprintf("must contain at least %d items", rules.GetMinItems())})
+				}
+				if rules.MaxItems != nil && uint32(list.Len()) > rules.GetMaxItems() {
+					*violations = append(*violations, Violation{path, fmt.Sprintf("must contain at most %d items", rules.GetMaxItems())})
+				}
+			}
+			for j := 0; j < list.Len(); j++ {
+				itemPath := fmt.Sprintf("%s[%d]", path, j)
+				if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+					message(list.Get(j).Message(), itemPath+".", violations)
+				} else if rules != nil {
+					value(fd, list.Get(j), rules, itemPath, violations)
+				}
+			}
+		case fd.IsMap():
+			// maps carry no rules
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if !m.Has(fd) {
+				if rules != nil && rules.Required {
+					*violations = append(*violations, Violation{path, "is required"})
+				}
+				continue
+			}
+			message(m.Get(fd).Message(), path+".", violations)
+		default:
+			if rules == nil {
+				continue
+			}
+			v := m.Get(fd)
+			if !m.Has(fd) {
+				if rules.Required {
+					*violations = append(*violations, Violation{path, "is required"})
+				}
+				if rules.Required || rules.IgnoreEmpty {
+					continue
+				}
+			}
+			value(fd, v, rules, path, violations)
+		}
+	}
+}
+
+func value(fd protoreflect.FieldDescriptor, v protoreflect.Value, rules *validatepb.FieldRules, path string, violations *[]Violation) {
+	add := func(format string, args ...any) {
+		*violations = append(*violations, Violation{path, fmt.Sprintf(format, args...)})
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		s := v.String()
+		n := uint32(utf8.RuneCountInString(s))
+		if rules.MinLen != nil && n < rules.GetMinLen() {
+			add("must be at least %d characters long", rules.GetMinLen())
+		}
+		if rules.MaxLen != nil && n > rules.GetMaxLen() {
+			add("must be at most %d characters long", rules.GetMaxLen())
+		}
+		if rules.GetPattern() != "" && !pattern(rules.GetPattern()).MatchString(s) {
+			add("must match the pattern %s", rules.GetPattern())
+		}
+		if rules.GetEmail() && !isEmail(s) {
+			add("must be a valid email address")
+		}
+		if rules.GetUuid() {
+			if _, err := uuid.Parse(s); err != nil {
+				add("must be a valid UUID")
+			}
+		}
+		if len(rules.GetIn()) > 0 && !slices.Contains(rules.GetIn(), s) {
+			add("must be one of %v", rules.GetIn())
+		}
+	case protoreflect.EnumKind:
+		n := v.Enum()
+		if rules.GetDefinedOnly() && (n == 0 || fd.Enum().Values().ByNumber(n) == nil) {
+			add("must be a defined value other than %s", fd.Enum().Values().ByNumber(0).Name())
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		bounds(float64(v.Int()), rules, add)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		bounds(float64(v.Uint()), rules, add)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		bounds(v.Float(), rules, add)
+	}
+}
+
+func bounds(n float64, rules *validatepb.FieldRules, add func(string, ...any)) {
+	if rules.Gte != nil && n < rules.GetGte() {
+		add("must be greater than or equal to %v", rules.GetGte())
+	}
+	if rules.Lte != nil && n > rules.GetLte() {
+		add("must be less than or equal to %v", rules.GetLte())
+	}
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func rulesOf(fd protoreflect.FieldDescriptor) *validatepb.FieldRules {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, validatepb.E_Rules) {
+		return nil
+	}
+	return proto.GetExtension(opts, validatepb.E_Rules).(*validatepb.FieldRules)
+}
+
+var patterns sync.Map
+
+func pattern(expr string) *regexp.Regexp {
+	if re, ok := patterns.Load(expr); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(expr)
+	patterns.Store(expr, re)
+	return re
+}
@@ -0,0 +1,167 @@
+package validate
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	activitypb "github.com/aburifat/go-agro/pkg/backend/services/activity_service/proto"
+	userpb "github.com/aburifat/go-agro/pkg/backend/services/user_service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const validID = "5f0c2a4e-8b1d-4c3a-9e2f-1a2b3c4d5e6f"
+
+func TestMessage(t *testing.T) {
+	tooMany := make([]*activitypb.Input, 51)
+	for i := range tooMany {
+		tooMany[i] = &activitypb.Input{Name: "Urea", Unit: "kg"}
+	}
+
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want []Violation
+	}{
+		{
+			name: "valid user",
+			msg:  &userpb.CreateUserRequest{Username: "ana.silva", Email: "ana@example.com", Password: "correct horse"},
+		},
+		{
+			name: "missing required fields",
+			msg:  &userpb.CreateUserRequest{},
+			want: []Violation{
+				{"username", "is required"},
+				{"email", "is required"},
+				{"password", "is required"},
+			},
+		},
+		{
+			name: "string rules",
+			msg:  &userpb.CreateUserRequest{Username: "a!", Email: "Ana <ana@example.com>", Password: "short"},
+			want: []Violation{
+				{"username", "must be at least 3 characters long"},
+				{"username", "must match the pattern ^[A-Za-z0-9_.-]+$"},
+				{"email", "must be a valid email address"},
+				{"password", "must be at least 8 characters long"},
+			},
+		},
+		{
+			name: "lengths count characters",
+			msg:  &userpb.CreateUserRequest{Username: "ana", Email: "ana@example.com", Password: "çççççççç"},
+		},
+		{
+			name: "max length",
+			msg:  &userpb.CreateUserRequest{Username: strings.Repeat("a", 51), Email: "ana@example.com", Password: "correct horse"},
+			want: []Violation{{"username", "must be at most 50 characters long"}},
+		},
+		{
+			name: "ignore empty",
+			msg:  &userpb.UpdateUserRequest{},
+		},
+		{
+			name: "ignore empty checks set values",
+			msg:  &userpb.UpdateUserRequest{Id: "42", Email: "ana"},
+			want: []Violation{
+				{"id", "must be a valid UUID"},
+				{"email", "must be a valid email address"},
+			},
+		},
+		{
+			name: "uuid without ignore empty",
+			msg:  &userpb.GetUserByIdRequest{},
+			want: []Violation{{"id", "must be a valid UUID"}},
+		},
+		{
+			name: "bounds",
+			msg:  &userpb.GetUsersRequest{PageSize: 101, CreatedAfter: -1},
+			want: []Violation{
+				{"pageSize", "must be less than or equal to 100"},
+				{"createdAfter", "must be greater than or equal to 0"},
+			},
+		},
+		{
+			name: "valid activity",
+			msg: &activitypb.RecordActivityRequest{
+				FieldId:     validID,
+				Kind:        activitypb.ActivityKind_ACTIVITY_KIND_SPRAYING,
+				PerformedAt: "2024-05-01T08:00:00Z",
+				Inputs:      []*activitypb.Input{{Name: "Copper", Quantity: 2, Unit: "l", ProductId: validID}},
+			},
+		},
+		{
+			name: "enum and nested messages",
+			msg: &activitypb.RecordActivityRequest{
+				FieldId:     validID,
+				Kind:        activitypb.ActivityKind(42),
+				PerformedAt: "2024-05-01T08:00:00Z",
+				Inputs:      []*activitypb.Input{{Name: "Copper", Unit: "l"}, {Quantity: -1, ProductId: "copper"}},
+			},
+			want: []Violation{
+				{"kind", "must be a defined value other than ACTIVITY_KIND_UNSPECIFIED"},
+				{"inputs[1].name", "is required"},
+				{"inputs[1].quantity", "must be greater than or equal to 0"},
+				{"inputs[1].unit", "is required"},
+				{"inputs[1].productId", "must be a valid UUID"},
+			},
+		},
+		{
+			name: "unspecified enum",
+			msg:  &activitypb.RecordActivityRequest{FieldId: validID, PerformedAt: "2024-05-01T08:00:00Z"},
+			want: []Violation{{"kind", "must be a defined value other than ACTIVITY_KIND_UNSPECIFIED"}},
+		},
+		{
+			name: "max items",
+			msg: &activitypb.RecordActivityRequest{
+				FieldId:     validID,
+				Kind:        activitypb.ActivityKind_ACTIVITY_KIND_FERTILISING,
+				PerformedAt: "2024-05-01T08:00:00Z",
+				Inputs:      tooMany,
+			},
+			want: []Violation{{"inputs", "must contain at most 50 items"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Message(tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Message() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/CreateUser"}
+
+	_, err := interceptor(context.Background(), &userpb.CreateUserRequest{Username: "ana"}, info, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	if want := "invalid CreateUserRequest: email is required"; st.Message() != want {
+		t.Errorf("message = %q, want %q", st.Message(), want)
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	if want := []string{"email", "password"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("field violations = %v, want %v", fields, want)
+	}
+
+	valid := &userpb.CreateUserRequest{Username: "ana", Email: "ana@example.com", Password: "correct horse"}
+	if _, err := interceptor(context.Background(), valid, info, handler); err != nil {
+		t.Errorf("valid request rejected: %v", err)
+	}
+}
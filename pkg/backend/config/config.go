@@ -0,0 +1,310 @@
+// Package config loads the server configuration.
+//
+// Values are resolved in the following order, later sources overriding
+// earlier ones:
+//
+//  1. built-in defaults
+//  2. the YAML file given by --config or AGRO_CONFIG
+//  3. environment variables
+//  4. command line flags
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigEnv names the environment variable holding the config file path.
+const ConfigEnv = "AGRO_CONFIG"
+
+type Config struct {
+	Database   DatabaseConfig   `yaml:"database"`
+	Mongo      MongoConfig      `yaml:"mongo"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	HTTP       HTTPConfig       `yaml:"http"`
+	Metrics    MetricsConfig    `yaml:"metrics"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Health     HealthConfig     `yaml:"health"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Irrigation IrrigationConfig `yaml:"irrigation"`
+	Compliance ComplianceConfig `yaml:"compliance"`
+	Log        LogConfig        `yaml:"log"`
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host" env:"AGRO_DATABASE_HOST" flag:"database-host" usage:"Postgres host"`
+	Port     int    `yaml:"port" env:"AGRO_DATABASE_PORT" flag:"database-port" usage:"Postgres port"`
+	User     string `yaml:"user" env:"AGRO_DATABASE_USER,POSTGRES_USER" flag:"database-user" usage:"Postgres user"`
+	Password string `yaml:"password" env:"AGRO_DATABASE_PASSWORD,POSTGRES_PASSWORD" secret:"true"`
+	Name     string `yaml:"name" env:"AGRO_DATABASE_NAME" flag:"database-name" usage:"Postgres database name"`
+	SSLMode  string `yaml:"sslMode" env:"AGRO_DATABASE_SSLMODE" flag:"database-sslmode" usage:"Postgres sslmode (disable, require, verify-ca, verify-full)"`
+	TimeZone string `yaml:"timeZone" env:"AGRO_DATABASE_TIMEZONE" flag:"database-timezone" usage:"Postgres session time zone"`
+	// MigrateOnStart applies pending migrations before serving.
+	MigrateOnStart bool `yaml:"migrateOnStart" env:"AGRO_DATABASE_MIGRATE_ON_START" flag:"database-migrate-on-start" usage:"apply pending migrations on start"`
+	// SlowQueryThreshold logs statements taking longer as warnings; zero
+	// disables the warning.
+	SlowQueryThreshold time.Duration `yaml:"slowQueryThreshold" env:"AGRO_DATABASE_SLOW_QUERY_THRESHOLD" flag:"database-slow-query-threshold" usage:"log statements slower than this as warnings, 0 disables"`
+}
+
+// DSN returns the libpq connection string for the database.
+func (c DatabaseConfig) DSN() string {
+	quote := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace
+	// TimeZone stays unquoted, the GORM driver extracts it with a regexp
+	return fmt.Sprintf("host='%s' user='%s' password='%s' dbname='%s' port=%d sslmode='%s' TimeZone=%s",
+		quote(c.Host), quote(c.User), quote(c.Password), quote(c.Name), c.Port, quote(c.SSLMode), c.TimeZone)
+}
+
+type MongoConfig struct {
+	// URI may be empty, in which case MongoDB is not used.
+	URI      string `yaml:"uri" env:"AGRO_MONGO_URI" flag:"mongo-uri" usage:"MongoDB connection URI, empty disables MongoDB" secret:"uri"`
+	Database string `yaml:"database" env:"AGRO_MONGO_DATABASE" flag:"mongo-database" usage:"MongoDB database name"`
+}
+
+type GRPCConfig struct {
+	Addr string `yaml:"addr" env:"AGRO_GRPC_ADDR" flag:"grpc-addr" usage:"address the gRPC server listens on"`
+	// ShutdownTimeout bounds how long in-flight RPCs are drained on shutdown
+	// before the remaining connections are closed. Every other component is
+	// given as long to stop.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" env:"AGRO_GRPC_SHUTDOWN_TIMEOUT" flag:"grpc-shutdown-timeout" usage:"time allowed for in-flight RPCs to finish on shutdown"`
+}
+
+type HTTPConfig struct {
+	// Addr may be empty, in which case the HTTP/JSON gateway is not served.
+	Addr string `yaml:"addr" env:"AGRO_HTTP_ADDR" flag:"http-addr" usage:"address the HTTP/JSON gateway listens on, empty disables it"`
+	// TrustedProxies are the addresses and CIDR ranges of the proxies whose
+	// X-Forwarded-For header is passed on. It is replaced by the peer
+	// address for every other client.
+	TrustedProxies []string `yaml:"trustedProxies" env:"AGRO_HTTP_TRUSTED_PROXIES" flag:"http-trusted-proxies" usage:"comma separated addresses and CIDR ranges of proxies whose X-Forwarded-For is trusted"`
+}
+
+// TrustedProxyPrefixes parses TrustedProxies, addresses becoming single
+// address prefixes.
+func (c HTTPConfig) TrustedProxyPrefixes() ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, proxy := range c.TrustedProxies {
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%q is neither an address nor a CIDR range", proxy)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+type MetricsConfig struct {
+	// Addr may be empty, in which case metrics are not served.
+	Addr string `yaml:"addr" env:"AGRO_METRICS_ADDR" flag:"metrics-addr" usage:"address serving Prometheus metrics on /metrics, empty disables it"`
+}
+
+type AuthConfig struct {
+	// SigningKeyFile may be empty, in which case an ephemeral key is used.
+	SigningKeyFile string `yaml:"signingKeyFile" env:"AGRO_AUTH_SIGNING_KEY_FILE,AUTH_SIGNING_KEY_FILE" flag:"auth-signing-key-file" usage:"PKCS#8 PEM ed25519 key signing access tokens"`
+	// VerificationKeyFiles are the public keys of retired signing keys,
+	// accepted until the access tokens they signed have expired.
+	VerificationKeyFiles []string      `yaml:"verificationKeyFiles" env:"AGRO_AUTH_VERIFICATION_KEY_FILES" flag:"auth-verification-key-files" usage:"comma separated PKIX PEM ed25519 public keys of retired signing keys"`
+	AccessTokenTTL       time.Duration `yaml:"accessTokenTTL" env:"AGRO_AUTH_ACCESS_TOKEN_TTL" flag:"auth-access-token-ttl" usage:"lifetime of access tokens"`
+	RefreshTokenTTL      time.Duration `yaml:"refreshTokenTTL" env:"AGRO_AUTH_REFRESH_TOKEN_TTL" flag:"auth-refresh-token-ttl" usage:"lifetime of refresh tokens"`
+}
+
+type HealthConfig struct {
+	ProbeInterval time.Duration `yaml:"probeInterval" env:"AGRO_HEALTH_PROBE_INTERVAL" flag:"health-probe-interval" usage:"interval between dependency probes"`
+	ProbeTimeout  time.Duration `yaml:"probeTimeout" env:"AGRO_HEALTH_PROBE_TIMEOUT" flag:"health-probe-timeout" usage:"time a dependency has to answer a probe"`
+}
+
+type TracingConfig struct {
+	// Exporter is one of none, stdout and otlp.
+	Exporter string `yaml:"exporter" env:"AGRO_TRACING_EXPORTER" flag:"tracing-exporter" usage:"span exporter: none, stdout or otlp"`
+	// Endpoint is the host:port of an OTLP gRPC collector.
+	Endpoint    string  `yaml:"endpoint" env:"AGRO_TRACING_ENDPOINT" flag:"tracing-endpoint" usage:"OTLP gRPC collector address"`
+	Insecure    bool    `yaml:"insecure" env:"AGRO_TRACING_INSECURE" flag:"tracing-insecure" usage:"connect to the OTLP collector without TLS"`
+	SampleRatio float64 `yaml:"sampleRatio" env:"AGRO_TRACING_SAMPLE_RATIO" flag:"tracing-sample-ratio" usage:"fraction of new traces sampled, parents' decisions are kept"`
+	ServiceName string  `yaml:"serviceName" env:"AGRO_TRACING_SERVICE_NAME,OTEL_SERVICE_NAME" flag:"tracing-service-name" usage:"service.name resource attribute"`
+}
+
+type IrrigationConfig struct {
+	// Interval between recomputations of the irrigation schedules of all
+	// planted fields; zero disables the worker.
+	Interval time.Duration `yaml:"interval" env:"AGRO_IRRIGATION_INTERVAL" flag:"irrigation-interval" usage:"interval between irrigation schedule recomputations, 0 disables them"`
+}
+
+type ComplianceConfig struct {
+	// Enforcement is block, rejecting sprayings and other field activities
+	// that break the conditions of use of pesticide products, or warn,
+	// recording them with the violations as warnings.
+	Enforcement string `yaml:"enforcement" env:"AGRO_COMPLIANCE_ENFORCEMENT" flag:"compliance-enforcement" usage:"pesticide rule enforcement: block or warn"`
+}
+
+type LogConfig struct {
+	Level       string `yaml:"level" env:"AGRO_LOG_LEVEL" flag:"log-level" usage:"log level (debug, info, warn, error)"`
+	Development bool   `yaml:"development" env:"AGRO_LOG_DEVELOPMENT" flag:"log-development" usage:"human friendly log output"`
+}
+
+func Default() *Config {
+	return &Config{
+		Database: DatabaseConfig{
+			Host:               "localhost",
+			Port:               5432,
+			Name:               "users",
+			SSLMode:            "disable",
+			TimeZone:           "UTC",
+			MigrateOnStart:     true,
+			SlowQueryThreshold: 200 * time.Millisecond,
+		},
+		Mongo: MongoConfig{
+			Database: "agro",
+		},
+		GRPC: GRPCConfig{
+			Addr:            ":50051",
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Auth: AuthConfig{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 30 * 24 * time.Hour,
+		},
+		Health: HealthConfig{
+			ProbeInterval: 10 * time.Second,
+			ProbeTimeout:  2 * time.Second,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			Endpoint:    "localhost:4317",
+			SampleRatio: 1,
+			ServiceName: "go-agro",
+		},
+		Irrigation: IrrigationConfig{
+			Interval: time.Hour,
+		},
+		Compliance: ComplianceConfig{
+			Enforcement: "block",
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+	}
+}
+
+// LoadFile overlays the YAML file at path onto c. Unknown keys are rejected
+// so that typos do not go unnoticed.
+func (c *Config) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Database.Host == "" {
+		add("database.host is required")
+	}
+	if c.Database.Port < 1 || c.Database.Port > 65535 {
+		add("database.port must be between 1 and 65535, got %d", c.Database.Port)
+	}
+	if c.Database.Name == "" {
+		add("database.name is required")
+	}
+	switch c.Database.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		add("database.sslMode %q is not a valid sslmode", c.Database.SSLMode)
+	}
+	if _, err := time.LoadLocation(c.Database.TimeZone); err != nil {
+		add("database.timeZone: %v", err)
+	}
+	if c.Database.SlowQueryThreshold < 0 {
+		add("database.slowQueryThreshold must not be negative")
+	}
+	if c.Mongo.URI != "" && c.Mongo.Database == "" {
+		add("mongo.database is required when mongo.uri is set")
+	}
+	if _, _, err := net.SplitHostPort(c.GRPC.Addr); err != nil {
+		add("grpc.addr: %v", err)
+	}
+	if c.GRPC.ShutdownTimeout <= 0 {
+		add("grpc.shutdownTimeout must be positive")
+	}
+	if c.HTTP.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.HTTP.Addr); err != nil {
+			add("http.addr: %v", err)
+		}
+	}
+	if _, err := c.HTTP.TrustedProxyPrefixes(); err != nil {
+		add("http.trustedProxies: %v", err)
+	}
+	if c.Metrics.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.Addr); err != nil {
+			add("metrics.addr: %v", err)
+		}
+	}
+	if c.Auth.SigningKeyFile != "" {
+		if _, err := os.Stat(c.Auth.SigningKeyFile); err != nil {
+			add("auth.signingKeyFile: %v", err)
+		}
+	}
+	for _, path := range c.Auth.VerificationKeyFiles {
+		if _, err := os.Stat(path); err != nil {
+			add("auth.verificationKeyFiles: %v", err)
+		}
+	}
+	if c.Auth.AccessTokenTTL <= 0 {
+		add("auth.accessTokenTTL must be positive")
+	}
+	if c.Auth.RefreshTokenTTL <= c.Auth.AccessTokenTTL {
+		add("auth.refreshTokenTTL must be longer than auth.accessTokenTTL")
+	}
+	if c.Health.ProbeTimeout <= 0 {
+		add("health.probeTimeout must be positive")
+	}
+	if c.Health.ProbeInterval < c.Health.ProbeTimeout {
+		add("health.probeInterval must not be shorter than health.probeTimeout")
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.Endpoint == "" {
+			add("tracing.endpoint is required for the otlp exporter")
+		}
+	default:
+		add("tracing.exporter must be one of none, stdout and otlp")
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		add("tracing.sampleRatio must be between 0 and 1")
+	}
+	if c.Irrigation.Interval < 0 {
+		add("irrigation.interval must not be negative")
+	}
+	switch c.Compliance.Enforcement {
+	case "block", "warn":
+	default:
+		add("compliance.enforcement must be block or warn")
+	}
+	if _, err := zapcore.ParseLevel(c.Log.Level); err != nil {
+		add("log.level: %v", err)
+	}
+	return errors.Join(errs...)
+}
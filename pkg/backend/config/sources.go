@@ -0,0 +1,213 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const redacted = "REDACTED"
+
+// ConfigFlag names the flag holding the config file path.
+const ConfigFlag = "config"
+
+// Load resolves the configuration from defaults, the config file, the
+// environment and the flags in fs, then validates it. fs may be nil.
+func Load(fs *pflag.FlagSet) (*Config, error) {
+	c := Default()
+
+	path := os.Getenv(ConfigEnv)
+	if fs != nil {
+		if f := fs.Lookup(ConfigFlag); f != nil && f.Changed {
+			path = f.Value.String()
+		}
+	}
+	if path != "" {
+		if err := c.LoadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.LoadEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	if fs != nil {
+		if err := c.LoadFlags(fs); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return c, nil
+}
+
+// BindFlags registers the --config flag and a flag for every setting that
+// declares one. Secrets deliberately have no flag, as command lines are
+// visible to every user of the host.
+func BindFlags(fs *pflag.FlagSet) {
+	fs.String(ConfigFlag, "", "path to a YAML config file (env "+ConfigEnv+")")
+	for _, f := range fields(Default()) {
+		name := f.tag.Get("flag")
+		if name == "" {
+			continue
+		}
+		usage := f.tag.Get("usage")
+		if env := f.tag.Get("env"); env != "" {
+			usage += " (env " + strings.Split(env, ",")[0] + ")"
+		}
+		switch v := f.value.Addr().Interface().(type) {
+		case *string:
+			fs.String(name, *v, usage)
+		case *int:
+			fs.Int(name, *v, usage)
+		case *bool:
+			fs.Bool(name, *v, usage)
+		case *float64:
+			fs.Float64(name, *v, usage)
+		case *time.Duration:
+			fs.Duration(name, *v, usage)
+		case *[]string:
+			fs.StringSlice(name, *v, usage)
+		}
+	}
+}
+
+// LoadEnv overlays the environment variables named by the env tags. When a
+// tag lists several variables the first one that is set wins.
+func (c *Config) LoadEnv(lookup func(string) (string, bool)) error {
+	for _, f := range fields(c) {
+		for _, name := range strings.Split(f.tag.Get("env"), ",") {
+			if name == "" {
+				continue
+			}
+			raw, ok := lookup(name)
+			if !ok {
+				continue
+			}
+			if err := set(f.value, raw); err != nil {
+				return fmt.Errorf("invalid value for %s: %w", name, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// LoadFlags overlays the flags of fs that were set on the command line.
+func (c *Config) LoadFlags(fs *pflag.FlagSet) error {
+	for _, f := range fields(c) {
+		name := f.tag.Get("flag")
+		if name == "" {
+			continue
+		}
+		flag := fs.Lookup(name)
+		if flag == nil || !flag.Changed {
+			continue
+		}
+		raw := flag.Value.String()
+		if list, ok := flag.Value.(pflag.SliceValue); ok {
+			raw = strings.Join(list.GetSlice(), ",")
+		}
+		if err := set(f.value, raw); err != nil {
+			return fmt.Errorf("invalid value for --%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Redacted returns a copy of c with secrets replaced, suitable for printing.
+func (c *Config) Redacted() *Config {
+	out := *c
+	for _, f := range fields(&out) {
+		switch f.tag.Get("secret") {
+		case "true":
+			if f.value.String() != "" {
+				f.value.SetString(redacted)
+			}
+		case "uri":
+			f.value.SetString(redactURI(f.value.String()))
+		}
+	}
+	return &out
+}
+
+func redactURI(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		if raw == "" {
+			return ""
+		}
+		return redacted
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	return u.String()
+}
+
+type field struct {
+	value reflect.Value
+	tag   reflect.StructTag
+}
+
+// fields returns the settings of c, which are the fields of its sections.
+func fields(c *Config) []field {
+	var out []field
+	sections := reflect.ValueOf(c).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		for j := 0; j < section.NumField(); j++ {
+			out = append(out, field{value: section.Field(j), tag: section.Type().Field(j).Tag})
+		}
+	}
+	return out
+}
+
+func set(v reflect.Value, raw string) error {
+	switch v.Interface().(type) {
+	case string:
+		v.SetString(raw)
+	case int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case []string:
+		// comma separated, empty elements dropped
+		var list []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		v.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("unsupported setting type %s", v.Type())
+	}
+	return nil
+}
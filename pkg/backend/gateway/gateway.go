@@ -0,0 +1,468 @@
+// Package gateway serves gRPC services as HTTP/JSON APIs, routing requests by
+// the google.api.http annotations of their methods.
+//
+// Requests are decoded with protojson and forwarded to the gRPC server
+// through a client connection, so that they pass the same interceptors as
+// native gRPC calls.
+package gateway
+
+import (
+	"cmp"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// registers the error detail types so that they can be rendered as JSON
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// MetadataHeaderPrefix marks HTTP headers forwarded as gRPC metadata, in
+// addition to Authorization.
+const MetadataHeaderPrefix = "Grpc-Metadata-"
+
+const maxBodySize = 4 << 20
+
+// requestIDKey is forwarded both ways so that HTTP clients can correlate
+// their requests with the server logs.
+const requestIDKey = logging.RequestIDKey
+
+var (
+	unmarshalOptions = protojson.UnmarshalOptions{}
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+)
+
+type Gateway struct {
+	conn           grpc.ClientConnInterface
+	routes         []*route
+	trustedProxies []netip.Prefix
+}
+
+type route struct {
+	httpMethod   string
+	template     *template
+	method       protoreflect.MethodDescriptor
+	fullMethod   string
+	body         string
+	responseBody string
+	// binding is 0 for the rule itself and n for its nth additional binding
+	binding int
+}
+
+// New returns a gateway forwarding to conn the annotated methods of the
+// named services. Services and methods without annotations are skipped.
+func New(conn grpc.ClientConnInterface, services ...string) (*Gateway, error) {
+	g := &Gateway{conn: conn}
+	for _, name := range services {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("unknown service %s: %w", name, err)
+		}
+		service, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			if err := g.addMethod(methods.Get(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// stable, so that bindings of equal specificity keep declaration order
+	slices.SortStableFunc(g.routes, func(a, b *route) int {
+		return cmp.Compare(b.template.literals(), a.template.literals())
+	})
+	return g, nil
+}
+
+func (g *Gateway) addMethod(method protoreflect.MethodDescriptor) error {
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return fmt.Errorf("%s: streaming methods cannot be served over HTTP", method.FullName())
+	}
+	for i, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		rt, err := newRoute(method, r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", method.FullName(), err)
+		}
+		if rt != nil {
+			rt.binding = i
+			g.routes = append(g.routes, rt)
+		}
+	}
+	return nil
+}
+
+func newRoute(method protoreflect.MethodDescriptor, rule *annotations.HttpRule) (*route, error) {
+	rt := &route{
+		method:       method,
+		fullMethod:   fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name()),
+		body:         rule.GetBody(),
+		responseBody: rule.GetResponseBody(),
+	}
+	var path string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		rt.httpMethod, path = http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		rt.httpMethod, path = http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		rt.httpMethod, path = http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		rt.httpMethod, path = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		rt.httpMethod, path = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		rt.httpMethod, path = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return nil, nil
+	}
+
+	var err error
+	if rt.template, err = parseTemplate(path); err != nil {
+		return nil, err
+	}
+	for _, v := range rt.template.variables {
+		if _, err := resolveField(method.Input(), v.fieldPath); err != nil {
+			return nil, fmt.Errorf("path %s: %w", path, err)
+		}
+	}
+	if rt.body != "" && rt.body != "*" {
+		fd := method.Input().Fields().ByName(protoreflect.Name(rt.body))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("body %q must name a message field of %s", rt.body, method.Input().FullName())
+		}
+	}
+	if rt.responseBody != "" {
+		fd := method.Output().Fields().ByName(protoreflect.Name(rt.responseBody))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("response_body %q must name a message field of %s", rt.responseBody, method.Output().FullName())
+		}
+	}
+	return rt, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var allowed []string
+	for _, rt := range g.routes {
+		values, ok := rt.template.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		if rt.httpMethod != r.Method {
+			allowed = append(allowed, rt.httpMethod)
+			continue
+		}
+		g.serve(w, r, rt, values)
+		return
+	}
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(slices.Compact(slices.Sorted(slices.Values(allowed))), ", "))
+		writeStatus(w, http.StatusMethodNotAllowed, status.Newf(codes.Unimplemented, "method %s is not allowed on %s", r.Method, r.URL.Path))
+		return
+	}
+	writeStatus(w, http.StatusNotFound, status.Newf(codes.NotFound, "no route for %s", r.URL.Path))
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request, rt *route, pathValues map[string]string) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	req, err := rt.newRequest(r, pathValues)
+	if err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	outputType, err := protoregistry.GlobalTypes.FindMessageByName(rt.method.Output().FullName())
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "unknown response type: %v", err))
+		return
+	}
+	resp := outputType.New().Interface()
+
+	// continue the caller's trace, if any; the client connection propagates
+	// it on to the gRPC server
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx = metadata.NewOutgoingContext(ctx, g.outgoingMetadata(r))
+	var header metadata.MD
+	err = g.conn.Invoke(ctx, rt.fullMethod, req, resp, grpc.Header(&header))
+	if ids := header.Get(requestIDKey); len(ids) > 0 {
+		w.Header().Set(requestIDKey, ids[0])
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var out proto.Message = resp
+	if rt.responseBody != "" {
+		fd := resp.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(rt.responseBody))
+		out = resp.ProtoReflect().Get(fd).Message().Interface()
+	}
+	body, err := marshalOptions.Marshal(out)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// newRequest decodes the request message from the body, the path variables
+// and, unless the body holds the whole message, the query string.
+func (rt *route) newRequest(r *http.Request, pathValues map[string]string) (proto.Message, error) {
+	inputType, err := protoregistry.GlobalTypes.FindMessageByName(rt.method.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+	req := inputType.New()
+
+	if rt.body != "" {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body: %w", err)
+		}
+		if len(body) > 0 {
+			target := req
+			if rt.body != "*" {
+				target = req.Mutable(req.Descriptor().Fields().ByName(protoreflect.Name(rt.body))).Message()
+			}
+			if err := unmarshalOptions.Unmarshal(body, target.Interface()); err != nil {
+				return nil, fmt.Errorf("invalid body: %w", err)
+			}
+		}
+	}
+
+	for path, value := range pathValues {
+		if err := setField(req, strings.Split(path, "."), []string{value}); err != nil {
+			return nil, err
+		}
+	}
+
+	if rt.body != "*" {
+		for key, values := range r.URL.Query() {
+			path := strings.Split(key, ".")
+			if _, bound := pathValues[key]; bound || (rt.body != "" && path[0] == rt.body) {
+				continue
+			}
+			if err := setField(req, path, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return req.Interface(), nil
+}
+
+// resolveField follows a dotted field path, accepting proto or JSON names.
+func resolveField(md protoreflect.MessageDescriptor, path []string) ([]protoreflect.FieldDescriptor, error) {
+	fields := make([]protoreflect.FieldDescriptor, 0, len(path))
+	for i, name := range path {
+		if md == nil {
+			return nil, fmt.Errorf("field %s is not a message", strings.Join(path[:i], "."))
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s", strings.Join(path[:i+1], "."))
+		}
+		if i < len(path)-1 && (fd.IsList() || fd.IsMap()) {
+			return nil, fmt.Errorf("field %s is repeated", strings.Join(path[:i+1], "."))
+		}
+		fields = append(fields, fd)
+		md = fd.Message()
+	}
+	return fields, nil
+}
+
+func setField(msg protoreflect.Message, path []string, values []string) error {
+	fields, err := resolveField(msg.Descriptor(), path)
+	if err != nil {
+		return err
+	}
+	for _, fd := range fields[:len(fields)-1] {
+		msg = msg.Mutable(fd).Message()
+	}
+	fd := fields[len(fields)-1]
+	name := strings.Join(path, ".")
+
+	switch {
+	case fd.IsMap():
+		return fmt.Errorf("field %s cannot be set from the URL", name)
+	case fd.IsList():
+		list := msg.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseScalar(fd, s)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+			list.Append(v)
+		}
+	default:
+		if len(values) != 1 {
+			return fmt.Errorf("field %s takes a single value", name)
+		}
+		v, err := parseScalar(fd, values[0])
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+		msg.Set(fd, v)
+	}
+	return nil
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.BytesKind:
+		b, err := base64.URLEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.StdEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown %s value %q", fd.Enum().Name(), s)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	}
+	return protoreflect.Value{}, errors.New("message fields cannot be set from the URL")
+}
+
+// TrustProxies passes on the X-Forwarded-For header of requests from peers
+// within proxies. The header of any other request is replaced by the peer
+// address, so that clients cannot pose as someone else in the server logs.
+func (g *Gateway) TrustProxies(proxies []netip.Prefix) {
+	g.trustedProxies = proxies
+}
+
+func (g *Gateway) trusted(remoteAddr string) bool {
+	addrPort, err := netip.ParseAddrPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, proxy := range g.trustedProxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Gateway) outgoingMetadata(r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if v := r.Header.Get("Authorization"); v != "" {
+		md.Set("authorization", v)
+	}
+	if v := r.Header.Get(requestIDKey); v != "" {
+		md.Set(requestIDKey, v)
+	}
+	for key, values := range r.Header {
+		if name, ok := strings.CutPrefix(key, MetadataHeaderPrefix); ok {
+			md.Append(strings.ToLower(name), values...)
+		}
+	}
+	forwardedFor := r.RemoteAddr
+	if prior := r.Header.Get("X-Forwarded-For"); prior != "" && g.trusted(r.RemoteAddr) {
+		forwardedFor = prior + ", " + r.RemoteAddr
+	}
+	md.Set("x-forwarded-for", forwardedFor)
+	return md
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeStatus(w, HTTPStatusFromCode(st.Code()), st)
+}
+
+// writeStatus renders st as a JSON google.rpc.Status, details included.
+func writeStatus(w http.ResponseWriter, code int, st *status.Status) {
+	body, err := marshalOptions.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// HTTPStatusFromCode maps gRPC status codes to HTTP status codes as
+// documented in google/rpc/code.proto.
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// client closed request
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
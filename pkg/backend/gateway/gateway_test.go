@@ -0,0 +1,602 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	userpb "github.com/aburifat/go-agro/pkg/backend/services/user_service/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// userServer records the last call it received and fails it with err, if set.
+type userServer struct {
+	userpb.UnimplementedUserServiceServer
+
+	mu     sync.Mutex
+	method string
+	req    proto.Message
+	md     metadata.MD
+	err    error
+}
+
+func (s *userServer) record(ctx context.Context, method string, req proto.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.method, s.req = method, req
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	if ids := s.md.Get(requestIDKey); len(ids) > 0 {
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, ids[0]))
+	}
+	return s.err
+}
+
+func (s *userServer) last() (string, proto.Message, metadata.MD) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.method, s.req, s.md
+}
+
+func (s *userServer) CreateUser(ctx context.Context, req *userpb.CreateUserRequest) (*userpb.CreateUserResponse, error) {
+	return &userpb.CreateUserResponse{Id: "u1"}, s.record(ctx, "CreateUser", req)
+}
+
+func (s *userServer) GetUserById(ctx context.Context, req *userpb.GetUserByIdRequest) (*userpb.GetUserByIdResponse, error) {
+	return &userpb.GetUserByIdResponse{Id: req.GetId(), Username: "ada"}, s.record(ctx, "GetUserById", req)
+}
+
+func (s *userServer) GetUsers(ctx context.Context, req *userpb.GetUsersRequest) (*userpb.GetUsersResponse, error) {
+	return &userpb.GetUsersResponse{Users: []*userpb.User{{Id: "u1", CreatedAt: 1700000000}}}, s.record(ctx, "GetUsers", req)
+}
+
+func (s *userServer) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequest) (*userpb.UpdateUserResponse, error) {
+	return &userpb.UpdateUserResponse{}, s.record(ctx, "UpdateUser", req)
+}
+
+func (s *userServer) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequest) (*userpb.DeleteUserResponse, error) {
+	return &userpb.DeleteUserResponse{}, s.record(ctx, "DeleteUser", req)
+}
+
+func (s *userServer) VerifyPassword(ctx context.Context, req *userpb.VerifyPasswordRequest) (*userpb.VerifyPasswordResponse, error) {
+	return &userpb.VerifyPasswordResponse{Valid: true}, s.record(ctx, "VerifyPassword", req)
+}
+
+// newGateway serves the user service in-process and returns a gateway to it.
+func newGateway(t *testing.T) (*Gateway, *userServer) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &userServer{}
+	s := grpc.NewServer()
+	userpb.RegisterUserServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///"+lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	g, err := New(conn, "user.UserService")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, srv
+}
+
+func serve(g *Gateway, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for key, values := range header {
+		r.Header[key] = values
+	}
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	return w
+}
+
+func TestRouting(t *testing.T) {
+	g, srv := newGateway(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantMethod string
+		wantReq    proto.Message
+		wantErr    string
+		wantAllow  string
+	}{
+		{
+			name:       "path variable",
+			method:     http.MethodGet,
+			target:     "/v1/users/u1",
+			wantStatus: http.StatusOK,
+			wantMethod: "GetUserById",
+			wantReq:    &userpb.GetUserByIdRequest{Id: "u1"},
+		},
+		{
+			name:       "escaped path variable",
+			method:     http.MethodGet,
+			target:     "/v1/users/a%2Fb",
+			wantStatus: http.StatusOK,
+			wantMethod: "GetUserById",
+			wantReq:    &userpb.GetUserByIdRequest{Id: "a/b"},
+		},
+		{
+			name:       "literal route wins",
+			method:     http.MethodPatch,
+			target:     "/v1/users/me",
+			body:       `{"username":"ada"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "UpdateUser",
+			wantReq:    &userpb.UpdateUserRequest{Username: "ada"},
+		},
+		{
+			name:       "literal of another method",
+			method:     http.MethodGet,
+			target:     "/v1/users/me",
+			wantStatus: http.StatusOK,
+			wantMethod: "GetUserById",
+			wantReq:    &userpb.GetUserByIdRequest{Id: "me"},
+		},
+		{
+			name:       "path variable over body",
+			method:     http.MethodPatch,
+			target:     "/v1/users/u1",
+			body:       `{"id":"u2","email":"ada@example.com"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "UpdateUser",
+			wantReq:    &userpb.UpdateUserRequest{Id: "u1", Email: "ada@example.com"},
+		},
+		{
+			name:       "query ignored with whole body",
+			method:     http.MethodPatch,
+			target:     "/v1/users/u1?username=eve",
+			body:       `{"email":"ada@example.com"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "UpdateUser",
+			wantReq:    &userpb.UpdateUserRequest{Id: "u1", Email: "ada@example.com"},
+		},
+		{
+			name:       "verb",
+			method:     http.MethodPost,
+			target:     "/v1/users:verifyPassword",
+			body:       `{"login":"ada","password":"hunter22"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "VerifyPassword",
+			wantReq:    &userpb.VerifyPasswordRequest{Login: "ada", Password: "hunter22"},
+		},
+		{
+			name:       "empty body",
+			method:     http.MethodPost,
+			target:     "/v1/users",
+			wantStatus: http.StatusOK,
+			wantMethod: "CreateUser",
+			wantReq:    &userpb.CreateUserRequest{},
+		},
+		{
+			name:       "query",
+			method:     http.MethodGet,
+			target:     "/v1/users?pageSize=5&usernamePrefix=a%20b&createdAfter=1700000000&sortBy=USER_SORT_FIELD_USERNAME&descending=true",
+			wantStatus: http.StatusOK,
+			wantMethod: "GetUsers",
+			wantReq: &userpb.GetUsersRequest{
+				PageSize:       5,
+				UsernamePrefix: "a b",
+				CreatedAfter:   1700000000,
+				SortBy:         userpb.UserSortField_USER_SORT_FIELD_USERNAME,
+				Descending:     true,
+			},
+		},
+		{
+			name:       "enum by number",
+			method:     http.MethodGet,
+			target:     "/v1/users?sortBy=3",
+			wantStatus: http.StatusOK,
+			wantMethod: "GetUsers",
+			wantReq:    &userpb.GetUsersRequest{SortBy: userpb.UserSortField_USER_SORT_FIELD_EMAIL},
+		},
+		{
+			name:       "malformed number",
+			method:     http.MethodGet,
+			target:     "/v1/users?pageSize=five",
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "field pageSize",
+		},
+		{
+			name:       "unknown enum value",
+			method:     http.MethodGet,
+			target:     "/v1/users?sortBy=NAME",
+			wantStatus: http.StatusBadRequest,
+			wantErr:    `unknown UserSortField value "NAME"`,
+		},
+		{
+			name:       "unknown query parameter",
+			method:     http.MethodGet,
+			target:     "/v1/users?colour=red",
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "unknown field colour",
+		},
+		{
+			name:       "repeated scalar",
+			method:     http.MethodGet,
+			target:     "/v1/users?pageSize=1&pageSize=2",
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "field pageSize takes a single value",
+		},
+		{
+			name:       "malformed body",
+			method:     http.MethodPost,
+			target:     "/v1/users",
+			body:       `{"username":`,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "invalid body",
+		},
+		{
+			name:       "unknown body field",
+			method:     http.MethodPost,
+			target:     "/v1/users",
+			body:       `{"name":"ada"}`,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "invalid body",
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodPut,
+			target:     "/v1/users/me",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantErr:    "method PUT is not allowed",
+			wantAllow:  "DELETE, GET, PATCH",
+		},
+		{
+			name:       "empty path variable",
+			method:     http.MethodGet,
+			target:     "/v1/users/",
+			wantStatus: http.StatusNotFound,
+			wantErr:    "no route for /v1/users/",
+		},
+		{
+			name:       "unknown path",
+			method:     http.MethodGet,
+			target:     "/v1/users/u1/roles",
+			wantStatus: http.StatusNotFound,
+			wantErr:    "no route",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv.record(context.Background(), "", nil)
+
+			w := serve(g, tt.method, tt.target, tt.body, nil)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if got := w.Header().Get("Allow"); got != tt.wantAllow {
+				t.Errorf("Allow = %q, want %q", got, tt.wantAllow)
+			}
+			method, req, _ := srv.last()
+			if method != tt.wantMethod {
+				t.Errorf("called %q, want %q", method, tt.wantMethod)
+			}
+			if tt.wantReq != nil && !proto.Equal(req, tt.wantReq) {
+				t.Errorf("request = %v, want %v", req, tt.wantReq)
+			}
+			if tt.wantErr != "" {
+				st := &spb.Status{}
+				if err := protojson.Unmarshal(w.Body.Bytes(), st); err != nil {
+					t.Fatalf("error body %s: %v", w.Body, err)
+				}
+				if !strings.Contains(st.GetMessage(), tt.wantErr) {
+					t.Errorf("message = %q, want %q", st.GetMessage(), tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestResponse(t *testing.T) {
+	g, srv := newGateway(t)
+
+	w := serve(g, http.MethodGet, "/v1/users?pageSize=1", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	// unpopulated fields are rendered, 64 bit integers as strings
+	want := map[string]any{
+		"users":         []any{map[string]any{"id": "u1", "username": "", "email": "", "createdAt": "1700000000"}},
+		"nextPageToken": "",
+		"totalCount":    "0",
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %v, want %v", body, want)
+	}
+
+	violation := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "id", Description: "no user found with ID: u2"}}}
+	st, err := status.New(codes.NotFound, "no user found with ID: u2").WithDetails(violation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.mu.Lock()
+	srv.err = st.Err()
+	srv.mu.Unlock()
+
+	w = serve(g, http.MethodGet, "/v1/users/u2", "", nil)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	got := &spb.Status{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), got); err != nil {
+		t.Fatalf("error body %s: %v", w.Body, err)
+	}
+	if !proto.Equal(got, st.Proto()) {
+		t.Errorf("error body = %v, want %v", got, st.Proto())
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	g, srv := newGateway(t)
+
+	w := serve(g, http.MethodGet, "/v1/users/u1", "", http.Header{
+		"Authorization":        {"Bearer token"},
+		"X-Request-Id":         {"req-1"},
+		"Grpc-Metadata-Tenant": {"north", "south"},
+		"Cookie":               {"session=secret"},
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	// echoed by the server as a response header
+	if got := w.Header().Get("X-Request-Id"); got != "req-1" {
+		t.Errorf("X-Request-Id = %q, want req-1", got)
+	}
+
+	_, _, md := srv.last()
+	for key, want := range map[string][]string{
+		"authorization": {"Bearer token"},
+		requestIDKey:    {"req-1"},
+		"tenant":        {"north", "south"},
+		"cookie":        nil,
+	} {
+		if got := md.Get(key); !reflect.DeepEqual(got, want) {
+			t.Errorf("metadata %s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestForwardedFor(t *testing.T) {
+	g, srv := newGateway(t)
+	g.TrustProxies([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("::1/128")})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		want         string
+	}{
+		{"direct", "192.0.2.1:4000", "", "192.0.2.1:4000"},
+		{"spoofed", "192.0.2.1:4000", "203.0.113.7", "192.0.2.1:4000"},
+		{"trusted proxy", "10.0.0.2:4000", "203.0.113.7", "203.0.113.7, 10.0.0.2:4000"},
+		{"trusted chain", "10.0.0.2:4000", "203.0.113.7, 10.1.1.1", "203.0.113.7, 10.1.1.1, 10.0.0.2:4000"},
+		{"trusted proxy without header", "10.0.0.2:4000", "", "10.0.0.2:4000"},
+		{"trusted ipv6 proxy", "[::1]:4000", "203.0.113.7", "203.0.113.7, [::1]:4000"},
+		{"ipv4 mapped proxy", "[::ffff:10.0.0.2]:4000", "203.0.113.7", "203.0.113.7, [::ffff:10.0.0.2]:4000"},
+		{"unparsable peer", "proxy", "203.0.113.7", "proxy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v1/users/u1", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, r)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", w.Code, w.Body)
+			}
+			_, _, md := srv.last()
+			if got := md.Get("x-forwarded-for"); len(got) != 1 || got[0] != tt.want {
+				t.Errorf("x-forwarded-for = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	tests := map[codes.Code]int{
+		codes.OK:                 http.StatusOK,
+		codes.Canceled:           499,
+		codes.Unknown:            http.StatusInternalServerError,
+		codes.InvalidArgument:    http.StatusBadRequest,
+		codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+		codes.NotFound:           http.StatusNotFound,
+		codes.AlreadyExists:      http.StatusConflict,
+		codes.PermissionDenied:   http.StatusForbidden,
+		codes.ResourceExhausted:  http.StatusTooManyRequests,
+		codes.FailedPrecondition: http.StatusBadRequest,
+		codes.Aborted:            http.StatusConflict,
+		codes.OutOfRange:         http.StatusBadRequest,
+		codes.Unimplemented:      http.StatusNotImplemented,
+		codes.Internal:           http.StatusInternalServerError,
+		codes.Unavailable:        http.StatusServiceUnavailable,
+		codes.DataLoss:           http.StatusInternalServerError,
+		codes.Unauthenticated:    http.StatusUnauthorized,
+	}
+	for code, want := range tests {
+		if got := HTTPStatusFromCode(code); got != want {
+			t.Errorf("HTTPStatusFromCode(%v) = %d, want %d", code, got, want)
+		}
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	g, _ := newGateway(t)
+	raw, err := g.OpenAPI("go-agro", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name       string         `json:"name"`
+				In         string         `json:"in"`
+				Required   bool           `json:"required"`
+				Deprecated bool           `json:"deprecated"`
+				Schema     map[string]any `json:"schema"`
+			} `json:"parameters"`
+			RequestBody map[string]any `json:"requestBody"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	operations := map[string]string{}
+	for path, methods := range doc.Paths {
+		for method, op := range methods {
+			operations[method+" "+path] = op.OperationID
+		}
+	}
+	wantOperations := map[string]string{
+		"post /v1/users":                "UserService_CreateUser",
+		"get /v1/users":                 "UserService_GetUsers",
+		"get /v1/users/{id}":            "UserService_GetUserById",
+		"patch /v1/users/{id}":          "UserService_UpdateUser",
+		"patch /v1/users/me":            "UserService_UpdateUser_1",
+		"delete /v1/users/{id}":         "UserService_DeleteUser",
+		"post /v1/users:verifyPassword": "UserService_VerifyPassword",
+	}
+	if !reflect.DeepEqual(operations, wantOperations) {
+		t.Errorf("operations = %v, want %v", operations, wantOperations)
+	}
+
+	get := doc.Paths["/v1/users/{id}"]["get"]
+	if len(get.Parameters) != 1 || get.Parameters[0].Name != "id" || get.Parameters[0].In != "path" || !get.Parameters[0].Required {
+		t.Errorf("GetUserById parameters = %+v, want the id path parameter", get.Parameters)
+	}
+
+	// the whole message is the body, nothing but the path is a parameter
+	patch := doc.Paths["/v1/users/{id}"]["patch"]
+	if len(patch.Parameters) != 1 || patch.Parameters[0].Name != "id" {
+		t.Errorf("UpdateUser parameters = %+v, want only id", patch.Parameters)
+	}
+	schema := patch.RequestBody["content"].(map[string]any)["application/json"].(map[string]any)["schema"]
+	if want := map[string]any{"$ref": "#/components/schemas/user.UpdateUserRequest"}; !reflect.DeepEqual(schema, want) {
+		t.Errorf("UpdateUser body schema = %v, want %v", schema, want)
+	}
+
+	query := map[string]map[string]any{}
+	deprecated := map[string]bool{}
+	for _, p := range doc.Paths["/v1/users"]["get"].Parameters {
+		if p.In != "query" {
+			t.Errorf("GetUsers parameter %s is in %s", p.Name, p.In)
+		}
+		query[p.Name] = p.Schema
+		deprecated[p.Name] = p.Deprecated
+	}
+	if len(query) != 10 {
+		t.Errorf("GetUsers has %d query parameters, want every field", len(query))
+	}
+	if want := map[string]any{"type": "string", "format": "int64"}; !reflect.DeepEqual(query["createdAfter"], want) {
+		t.Errorf("createdAfter schema = %v, want %v", query["createdAfter"], want)
+	}
+	if want := []any{"USER_SORT_FIELD_UNSPECIFIED", "USER_SORT_FIELD_CREATED_AT", "USER_SORT_FIELD_USERNAME", "USER_SORT_FIELD_EMAIL"}; !reflect.DeepEqual(query["sortBy"]["enum"], want) {
+		t.Errorf("sortBy values = %v, want %v", query["sortBy"]["enum"], want)
+	}
+	if !deprecated["pageNumber"] || deprecated["pageToken"] {
+		t.Errorf("deprecated = %v, want only pageNumber", deprecated)
+	}
+
+	for _, name := range []string{"google.rpc.Status", "user.GetUsersResponse", "user.User", "user.UpdateUserRequest"} {
+		if doc.Components.Schemas[name] == nil {
+			t.Errorf("schema %s is missing", name)
+		}
+	}
+	users := doc.Components.Schemas["user.GetUsersResponse"]["properties"].(map[string]any)["users"]
+	if want := map[string]any{"type": "array", "items": map[string]any{"$ref": "#/components/schemas/user.User"}}; !reflect.DeepEqual(users, want) {
+		t.Errorf("users schema = %v, want %v", users, want)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	tests := []struct {
+		template   string
+		path       string
+		wantValues map[string]string
+	}{
+		{"/v1/users/{id}", "/v1/users/u1", map[string]string{"id": "u1"}},
+		{"/v1/users/{id}", "/v1/users/u1/roles", nil},
+		{"/v1/users/{id}", "/v1/users", nil},
+		{"/v1/users/me", "/v1/users/me", map[string]string{}},
+		{"/v1/{name=farms/*}/fields", "/v1/farms/f1/fields", map[string]string{"name": "farms/f1"}},
+		{"/v1/{name=farms/*}/fields", "/v1/crops/c1/fields", nil},
+		{"/v1/farms/{farm.id}", "/v1/farms/f%201", map[string]string{"farm.id": "f 1"}},
+		{"/v1/files/{path=**}", "/v1/files/a/b/c", map[string]string{"path": "a/b/c"}},
+		{"/v1/files/*/{id}", "/v1/files/x/y", map[string]string{"id": "y"}},
+		{"/v1/geo:nearby", "/v1/geo:nearby", map[string]string{}},
+		{"/v1/geo:nearby", "/v1/geo", nil},
+		{"/v1/{name=farms/*}:stats", "/v1/farms/f1:stats", map[string]string{"name": "farms/f1"}},
+	}
+	for _, tt := range tests {
+		tmpl, err := parseTemplate(tt.template)
+		if err != nil {
+			t.Fatalf("parseTemplate(%q) error = %v", tt.template, err)
+		}
+		values, ok := tmpl.match(tt.path)
+		if ok != (tt.wantValues != nil) || !reflect.DeepEqual(values, tt.wantValues) {
+			t.Errorf("%s match(%q) = %v, %v, want %v", tt.template, tt.path, values, ok, tt.wantValues)
+		}
+	}
+
+	for _, raw := range []string{"v1/users", "/v1/{id", "/v1/a}b", "/v1/**/users"} {
+		if _, err := parseTemplate(raw); err == nil {
+			t.Errorf("parseTemplate(%q) = nil error", raw)
+		}
+	}
+
+	for raw, want := range map[string]string{
+		"/v1/users/{id}":           "/v1/users/{id}",
+		"/v1/{name=farms/*}:stats": "/v1/{name}:stats",
+		"/v1/files/*/{path=**}":    "/v1/files/*/{path}",
+	} {
+		tmpl, err := parseTemplate(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tmpl.openAPIPath(); got != want {
+			t.Errorf("openAPIPath(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenAPI describes the routes of g as an OpenAPI 3 document.
+func (g *Gateway) OpenAPI(title, version string) ([]byte, error) {
+	schemas := map[string]any{}
+	paths := map[string]map[string]any{}
+
+	errorRef := schemaRef(schemas, (&spb.Status{}).ProtoReflect().Descriptor())
+	for _, rt := range g.routes {
+		input, output := rt.method.Input(), rt.method.Output()
+
+		// by declaration, as the routes are sorted by specificity
+		id := fmt.Sprintf("%s_%s", rt.method.Parent().Name(), rt.method.Name())
+		if rt.binding > 0 {
+			id = fmt.Sprintf("%s_%d", id, rt.binding)
+		}
+
+		op := map[string]any{
+			"operationId": id,
+			"tags":        []string{string(rt.method.Parent().Name())},
+		}
+		if isDeprecated(rt.method) {
+			op["deprecated"] = true
+		}
+
+		var parameters []any
+		bound := map[string]bool{}
+		for _, v := range rt.template.variables {
+			fields, _ := resolveField(input, v.fieldPath)
+			name := strings.Join(v.fieldPath, ".")
+			bound[name] = true
+			parameters = append(parameters, map[string]any{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   fieldSchema(schemas, fields[len(fields)-1]),
+			})
+		}
+		if rt.body != "*" {
+			fields := input.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				if bound[string(fd.Name())] || bound[fd.JSONName()] || string(fd.Name()) == rt.body || fd.Message() != nil {
+					continue
+				}
+				p := map[string]any{
+					"name":   fd.JSONName(),
+					"in":     "query",
+					"schema": fieldSchema(schemas, fd),
+				}
+				if isDeprecated(fd) {
+					p["deprecated"] = true
+				}
+				parameters = append(parameters, p)
+			}
+		}
+		if len(parameters) > 0 {
+			op["parameters"] = parameters
+		}
+
+		switch rt.body {
+		case "":
+		case "*":
+			op["requestBody"] = jsonContent(schemaRef(schemas, input), true)
+		default:
+			op["requestBody"] = jsonContent(schemaRef(schemas, input.Fields().ByName(protoreflect.Name(rt.body)).Message()), true)
+		}
+
+		respType := output
+		if rt.responseBody != "" {
+			respType = output.Fields().ByName(protoreflect.Name(rt.responseBody)).Message()
+		}
+		ok := jsonContent(schemaRef(schemas, respType), false)
+		ok["description"] = "OK"
+		failed := jsonContent(errorRef, false)
+		failed["description"] = "The gRPC status of the failed call"
+		op["responses"] = map[string]any{"200": ok, "default": failed}
+
+		path := rt.template.openAPIPath()
+		if paths[path] == nil {
+			paths[path] = map[string]any{}
+		}
+		paths[path][strings.ToLower(rt.httpMethod)] = op
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"openapi": "3.0.3",
+		"info":    map[string]any{"title": title, "version": version},
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearer": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{"bearer": []string{}}},
+	}, "", "  ")
+}
+
+// OpenAPIHandler serves the document returned by OpenAPI.
+func (g *Gateway) OpenAPIHandler(title, version string) (http.Handler, error) {
+	doc, err := g.OpenAPI(title, version)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(doc)
+	}), nil
+}
+
+func jsonContent(schema map[string]any, required bool) map[string]any {
+	c := map[string]any{
+		"content": map[string]any{"application/json": map[string]any{"schema": schema}},
+	}
+	if required {
+		c["required"] = true
+	}
+	return c
+}
+
+// schemaRef adds md and the messages it refers to to schemas and returns a
+// reference to it.
+func schemaRef(schemas map[string]any, md protoreflect.MessageDescriptor) map[string]any {
+	name := string(md.FullName())
+	if wkt := wellKnownSchema(md); wkt != nil {
+		return wkt
+	}
+	ref := map[string]any{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	// registered before the fields so that recursive messages terminate
+	schemas[name] = schema
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = fieldSchema(schemas, fd)
+	}
+	return ref
+}
+
+func fieldSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	if fd.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": singularSchema(schemas, fd.MapValue()),
+		}
+	}
+	s := singularSchema(schemas, fd)
+	if fd.IsList() {
+		s = map[string]any{"type": "array", "items": s}
+	}
+	if isDeprecated(fd) {
+		s["deprecated"] = true
+	}
+	return s
+}
+
+func singularSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson renders 64 bit integers as strings
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]any{"type": "string", "enum": names}
+	}
+	return schemaRef(schemas, fd.Message())
+}
+
+func wellKnownSchema(md protoreflect.MessageDescriptor) map[string]any {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "example": "1.5s"}
+	case "google.protobuf.Any":
+		return map[string]any{
+			"type":                 "object",
+			"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+			"additionalProperties": true,
+		}
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object", "additionalProperties": true}
+	case "google.protobuf.Empty":
+		return map[string]any{"type": "object"}
+	}
+	return nil
+}
+
+func isDeprecated(d protoreflect.Descriptor) bool {
+	type deprecatable interface{ GetDeprecated() bool }
+	opts, ok := d.Options().(deprecatable)
+	return ok && opts.GetDeprecated()
+}
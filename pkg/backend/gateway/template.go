@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// template is a parsed google.api.http path template such as
+// "/v1/users/{id}" or "/v1/{name=farms/*}:verb".
+type template struct {
+	raw       string
+	segments  []segment
+	variables []variable
+	verb      string
+}
+
+type segment struct {
+	// literal is empty for the "*" and "**" wildcards
+	literal string
+	// deep marks "**", which matches the rest of the path
+	deep bool
+}
+
+// variable binds the path segments [start, end) to a request field.
+type variable struct {
+	fieldPath  []string
+	start, end int
+}
+
+func parseTemplate(raw string) (*template, error) {
+	if !strings.HasPrefix(raw, "/") {
+		return nil, fmt.Errorf("path template %q must start with /", raw)
+	}
+	t := &template{raw: raw}
+	rest := raw[1:]
+	if i := strings.LastIndex(rest, ":"); i >= 0 && i > strings.LastIndex(rest, "}") && i > strings.LastIndex(rest, "/") {
+		t.verb = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	for _, part := range splitOutsideBraces(rest) {
+		if !strings.HasPrefix(part, "{") {
+			if strings.ContainsAny(part, "{}") {
+				return nil, fmt.Errorf("path template %q has a malformed segment %q", raw, part)
+			}
+			t.segments = append(t.segments, newSegment(part))
+			continue
+		}
+		if !strings.HasSuffix(part, "}") {
+			return nil, fmt.Errorf("path template %q has a malformed variable %q", raw, part)
+		}
+		name, pattern, found := strings.Cut(part[1:len(part)-1], "=")
+		if !found {
+			pattern = "*"
+		}
+		v := variable{fieldPath: strings.Split(name, "."), start: len(t.segments)}
+		for _, s := range strings.Split(pattern, "/") {
+			t.segments = append(t.segments, newSegment(s))
+		}
+		v.end = len(t.segments)
+		t.variables = append(t.variables, v)
+	}
+
+	for i, s := range t.segments {
+		if s.deep && i != len(t.segments)-1 {
+			return nil, fmt.Errorf("path template %q may only use ** as its last segment", raw)
+		}
+	}
+	return t, nil
+}
+
+func splitOutsideBraces(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '/':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func newSegment(s string) segment {
+	switch s {
+	case "*":
+		return segment{}
+	case "**":
+		return segment{deep: true}
+	}
+	return segment{literal: s}
+}
+
+// match reports whether the escaped request path matches t and returns the
+// unescaped values of its variables keyed by field path.
+func (t *template) match(escapedPath string) (map[string]string, bool) {
+	if !strings.HasPrefix(escapedPath, "/") {
+		return nil, false
+	}
+	rest := escapedPath[1:]
+	if t.verb != "" {
+		var ok bool
+		if rest, ok = strings.CutSuffix(rest, ":"+t.verb); !ok {
+			return nil, false
+		}
+	}
+	parts := strings.Split(rest, "/")
+
+	for i, s := range t.segments {
+		if s.deep {
+			parts = append(parts[:i], strings.Join(parts[i:], "/"))
+			break
+		}
+		if i >= len(parts) {
+			return nil, false
+		}
+		if s.literal != "" && s.literal != parts[i] {
+			return nil, false
+		}
+		if s.literal == "" && parts[i] == "" {
+			return nil, false
+		}
+	}
+	if len(parts) != len(t.segments) {
+		return nil, false
+	}
+
+	values := make(map[string]string, len(t.variables))
+	for _, v := range t.variables {
+		unescaped := make([]string, 0, v.end-v.start)
+		for _, p := range parts[v.start:v.end] {
+			u, err := url.PathUnescape(p)
+			if err != nil {
+				return nil, false
+			}
+			unescaped = append(unescaped, u)
+		}
+		values[strings.Join(v.fieldPath, ".")] = strings.Join(unescaped, "/")
+	}
+	return values, true
+}
+
+// literals counts the literal segments of t; templates with more literals
+// are tried first so that "/v1/users/me" wins over "/v1/users/{id}".
+func (t *template) literals() int {
+	n := 0
+	for _, s := range t.segments {
+		if s.literal != "" {
+			n++
+		}
+	}
+	if t.verb != "" {
+		n++
+	}
+	return n
+}
+
+// openAPIPath renders t in the OpenAPI path syntax.
+func (t *template) openAPIPath() string {
+	parts := make([]string, 0, len(t.segments))
+	for i := 0; i < len(t.segments); {
+		if v, ok := t.variableAt(i); ok {
+			parts = append(parts, "{"+strings.Join(v.fieldPath, ".")+"}")
+			i = v.end
+			continue
+		}
+		s := t.segments[i]
+		switch {
+		case s.deep:
+			parts = append(parts, "**")
+		case s.literal == "":
+			parts = append(parts, "*")
+		default:
+			parts = append(parts, s.literal)
+		}
+		i++
+	}
+	path := "/" + strings.Join(parts, "/")
+	if t.verb != "" {
+		path += ":" + t.verb
+	}
+	return path
+}
+
+func (t *template) variableAt(i int) (variable, bool) {
+	for _, v := range t.variables {
+		if v.start == i {
+			return v, true
+		}
+	}
+	return variable{}, false
+}
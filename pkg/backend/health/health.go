@@ -0,0 +1,198 @@
+// Package health serves grpc.health.v1 and keeps it in line with the state of
+// the dependencies the server needs.
+//
+// Three kinds of service names are reported:
+//
+//   - Liveness is SERVING for as long as the process answers at all. It only
+//     turns NOT_SERVING during shutdown, so orchestrators should not restart
+//     a replica because a database is unreachable.
+//   - Readiness, the empty service name, is SERVING when every probed
+//     dependency is reachable.
+//   - Every registered gRPC service is SERVING when the dependencies it was
+//     registered with are reachable. A dependency without a probe is not
+//     configured and keeps its services NOT_SERVING.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	Liveness  = "liveness"
+	Readiness = ""
+)
+
+// Policy is the access policy of the health service. Orchestrators probe
+// without credentials.
+var Policy = auth.Policy{
+	healthpb.Health_Check_FullMethodName: auth.Public,
+	healthpb.Health_Watch_FullMethodName: auth.Public,
+}
+
+// Probe reports whether a dependency is reachable.
+type Probe func(ctx context.Context) error
+
+type Monitor struct {
+	server   *grpchealth.Server
+	logger   *zap.Logger
+	interval time.Duration
+	timeout  time.Duration
+
+	mu       sync.Mutex
+	probes   map[string]Probe
+	services map[string][]string
+	failures map[string]error
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewMonitor returns a monitor probing every interval, allowing each probe
+// timeout to answer. Readiness is NOT_SERVING until the first round of probes.
+func NewMonitor(logger *zap.Logger, interval, timeout time.Duration) *Monitor {
+	server := grpchealth.NewServer()
+	server.SetServingStatus(Liveness, healthpb.HealthCheckResponse_SERVING)
+	server.SetServingStatus(Readiness, healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Monitor{
+		server:   server,
+		logger:   logger,
+		interval: interval,
+		timeout:  timeout,
+		probes:   map[string]Probe{},
+		services: map[string][]string{},
+		failures: map[string]error{},
+	}
+}
+
+// Register adds the health service to s.
+func (m *Monitor) Register(s grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(s, m.server)
+}
+
+// AddProbe registers a dependency checked by probe under name.
+func (m *Monitor) AddProbe(name string, probe Probe) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.probes[name] = probe
+}
+
+// AddService reports service as serving while all of dependencies are
+// reachable. Dependencies must have a probe to be reachable.
+func (m *Monitor) AddService(service string, dependencies ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.services[service] = dependencies
+	m.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Start runs a first round of probes and keeps probing in the background
+// until Stop is called.
+func (m *Monitor) Start(ctx context.Context) error {
+	m.probeAll(ctx)
+
+	ctx, m.cancel = context.WithCancel(context.Background())
+	m.done = make(chan struct{})
+	go func() {
+		defer close(m.done)
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.probeAll(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop reports every service, liveness included, as NOT_SERVING so that
+// clients move away before the server drains, and stops probing.
+func (m *Monitor) Stop(ctx context.Context) error {
+	m.server.Shutdown()
+	if m.cancel != nil {
+		m.cancel()
+		<-m.done
+	}
+	return nil
+}
+
+func (m *Monitor) probeAll(ctx context.Context) {
+	m.mu.Lock()
+	probes := make(map[string]Probe, len(m.probes))
+	for name, probe := range m.probes {
+		probes[name] = probe
+	}
+	m.mu.Unlock()
+
+	var wg sync.WaitGroup
+	results := make(map[string]error, len(probes))
+	var resultsMu sync.Mutex
+	for name, probe := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, m.timeout)
+			defer cancel()
+			err := probe(probeCtx)
+			resultsMu.Lock()
+			results[name] = err
+			resultsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, err := range results {
+		_, wasFailing := m.failures[name]
+		switch {
+		case err != nil && !wasFailing:
+			m.logger.Warn("Dependency is unreachable", zap.String("dependency", name), zap.Error(err))
+		case err == nil && wasFailing:
+			m.logger.Info("Dependency is reachable again", zap.String("dependency", name))
+		}
+		if err != nil {
+			m.failures[name] = err
+		} else {
+			delete(m.failures, name)
+		}
+	}
+
+	readiness := healthpb.HealthCheckResponse_SERVING
+	if len(m.failures) > 0 {
+		readiness = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	m.server.SetServingStatus(Readiness, readiness)
+	for service, dependencies := range m.services {
+		m.server.SetServingStatus(service, m.status(dependencies))
+	}
+}
+
+// status reports whether dependencies are all probed and reachable. m.mu must
+// be held.
+func (m *Monitor) status(dependencies []string) healthpb.HealthCheckResponse_ServingStatus {
+	for _, name := range dependencies {
+		if _, probed := m.probes[name]; !probed {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if _, failing := m.failures[name]; failing {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,152 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	serving    = healthpb.HealthCheckResponse_SERVING
+	notServing = healthpb.HealthCheckResponse_NOT_SERVING
+)
+
+// dependency is a fake dependency whose probe fails while err is set and
+// hangs while hang is.
+type dependency struct {
+	mu   sync.Mutex
+	err  error
+	hang bool
+}
+
+func (d *dependency) set(err error, hang bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.err, d.hang = err, hang
+}
+
+func (d *dependency) probe(ctx context.Context) error {
+	d.mu.Lock()
+	err, hang := d.err, d.hang
+	d.mu.Unlock()
+	if hang {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return err
+}
+
+func check(t *testing.T, m *Monitor, want map[string]healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	for service, status := range want {
+		resp, err := m.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q) error = %v", service, err)
+		}
+		if resp.GetStatus() != status {
+			t.Errorf("Check(%q) = %v, want %v", service, resp.GetStatus(), status)
+		}
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	// probes run on demand, the interval never elapses
+	m := NewMonitor(zap.NewNop(), time.Hour, 50*time.Millisecond)
+	postgres, mongo := &dependency{}, &dependency{}
+	m.AddProbe("postgres", postgres.probe)
+	m.AddProbe("mongo", mongo.probe)
+	m.AddService("agro.UserService", "postgres")
+	m.AddService("agro.ActivityService", "postgres", "mongo")
+	// not probed, e.g. an optional dependency that is not configured
+	m.AddService("agro.WeatherService", "postgres", "weather")
+
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Liveness:               serving,
+		Readiness:              notServing,
+		"agro.UserService":     notServing,
+		"agro.ActivityService": notServing,
+	})
+
+	ctx := context.Background()
+	if err := m.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Liveness:               serving,
+		Readiness:              serving,
+		"agro.UserService":     serving,
+		"agro.ActivityService": serving,
+		"agro.WeatherService":  notServing,
+	})
+
+	mongo.set(errors.New("connection refused"), false)
+	m.probeAll(ctx)
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Liveness:               serving,
+		Readiness:              notServing,
+		"agro.UserService":     serving,
+		"agro.ActivityService": notServing,
+	})
+
+	// a probe that does not answer in time fails
+	mongo.set(nil, false)
+	postgres.set(nil, true)
+	m.probeAll(ctx)
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Liveness:               serving,
+		Readiness:              notServing,
+		"agro.UserService":     notServing,
+		"agro.ActivityService": notServing,
+	})
+
+	postgres.set(nil, false)
+	m.probeAll(ctx)
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Readiness:              serving,
+		"agro.UserService":     serving,
+		"agro.ActivityService": serving,
+		"agro.WeatherService":  notServing,
+	})
+
+	if err := m.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	check(t, m, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		Liveness:               notServing,
+		Readiness:              notServing,
+		"agro.UserService":     notServing,
+		"agro.ActivityService": notServing,
+	})
+}
+
+func TestMonitorProbesInBackground(t *testing.T) {
+	m := NewMonitor(zap.NewNop(), 10*time.Millisecond, 10*time.Millisecond)
+	postgres := &dependency{}
+	m.AddProbe("postgres", postgres.probe)
+	m.AddService("agro.UserService", "postgres")
+	if err := m.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop(context.Background())
+
+	postgres.set(errors.New("connection refused"), false)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := m.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "agro.UserService"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetStatus() == notServing {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("service still SERVING after its dependency failed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,121 @@
+// Package lifecycle starts and stops the components of the server in order.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Hook is a component managed by the Manager. Either function may be nil.
+// OnStart must not block; long running work is handed to Manager.Go.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+// Manager starts hooks in the order they were appended and stops them in
+// reverse order, so that components are torn down before the dependencies
+// they were built on.
+type Manager struct {
+	logger      *zap.Logger
+	stopTimeout time.Duration
+
+	mu    sync.Mutex
+	hooks []Hook
+
+	errOnce sync.Once
+	errs    chan error
+}
+
+// New returns a manager allowing each hook stopTimeout to stop, so that a
+// hook running out of time does not leave none to the hooks after it.
+func New(logger *zap.Logger, stopTimeout time.Duration) *Manager {
+	return &Manager{
+		logger:      logger,
+		stopTimeout: stopTimeout,
+		errs:        make(chan error, 1),
+	}
+}
+
+func (m *Manager) Append(h Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, h)
+}
+
+// Go runs fn in the background. When fn returns an error the manager shuts
+// down as if it had received a signal.
+func (m *Manager) Go(name string, fn func() error) {
+	go func() {
+		if err := fn(); err != nil {
+			m.errOnce.Do(func() {
+				m.errs <- fmt.Errorf("%s: %w", name, err)
+			})
+		}
+	}()
+}
+
+// Run starts every hook, blocks until SIGINT, SIGTERM, cancellation of ctx
+// or the failure of a background task, then stops the started hooks. It
+// returns the error that caused the shutdown, if any, joined with the errors
+// of hooks that failed to stop.
+func (m *Manager) Run(ctx context.Context) error {
+	ctx, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	m.mu.Lock()
+	hooks := append([]Hook(nil), m.hooks...)
+	m.mu.Unlock()
+
+	var cause error
+	started := 0
+	for _, h := range hooks {
+		if h.OnStart != nil {
+			m.logger.Info("Starting component", zap.String("component", h.Name))
+			if err := h.OnStart(ctx); err != nil {
+				cause = fmt.Errorf("failed to start %s: %w", h.Name, err)
+				break
+			}
+		}
+		started++
+	}
+
+	if cause == nil {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("Shutting down", zap.String("reason", context.Cause(ctx).Error()))
+		case cause = <-m.errs:
+			m.logger.Error("Shutting down after failure", zap.Error(cause))
+		}
+	}
+	// restore default signal handling so a second signal kills the process
+	stopSignals()
+
+	errs := []error{cause}
+	for i := started - 1; i >= 0; i-- {
+		h := hooks[i]
+		if h.OnStop == nil {
+			continue
+		}
+		m.logger.Info("Stopping component", zap.String("component", h.Name))
+		if err := m.stop(h); err != nil {
+			m.logger.Error("Failed to stop component", zap.String("component", h.Name), zap.Error(err))
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", h.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Manager) stop(h Hook) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.stopTimeout)
+	defer cancel()
+	return h.OnStop(ctx)
+}
@@ -0,0 +1,145 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// recorder appends the calls made to the hooks it creates.
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (r *recorder) record(call string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+func (r *recorder) hook(name string, startErr error) Hook {
+	return Hook{
+		Name: name,
+		OnStart: func(ctx context.Context) error {
+			r.record("start " + name)
+			return startErr
+		},
+		OnStop: func(ctx context.Context) error {
+			r.record("stop " + name)
+			return nil
+		},
+	}
+}
+
+func run(t *testing.T, m *Manager) error {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	// stops the manager as soon as every hook has started
+	m.Append(Hook{Name: "shutdown", OnStart: func(context.Context) error {
+		cancel()
+		return nil
+	}})
+	done := make(chan error)
+	go func() { done <- m.Run(ctx) }()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(10 * time.Second):
+		t.Fatal("Run() did not return")
+		return nil
+	}
+}
+
+func TestRunStopsInReverseOrder(t *testing.T) {
+	r := &recorder{}
+	m := New(zap.NewNop(), time.Second)
+	m.Append(r.hook("postgres", nil))
+	m.Append(Hook{Name: "start only", OnStart: func(context.Context) error { r.record("start start only"); return nil }})
+	m.Append(Hook{Name: "stop only", OnStop: func(context.Context) error { r.record("stop stop only"); return nil }})
+	m.Append(r.hook("grpc", nil))
+
+	if err := run(t, m); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	want := []string{"start postgres", "start start only", "start grpc", "stop grpc", "stop stop only", "stop postgres"}
+	if !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %q, want %q", r.calls, want)
+	}
+}
+
+func TestRunStopsStartedHooksOnStartFailure(t *testing.T) {
+	r := &recorder{}
+	m := New(zap.NewNop(), time.Second)
+	m.Append(r.hook("postgres", nil))
+	m.Append(r.hook("mongo", errors.New("unreachable")))
+	m.Append(r.hook("grpc", nil))
+
+	err := run(t, m)
+	if err == nil || err.Error() != "failed to start mongo: unreachable" {
+		t.Errorf("Run() error = %v", err)
+	}
+	// the failed hook is not stopped, the later ones never started
+	want := []string{"start postgres", "start mongo", "stop postgres"}
+	if !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %q, want %q", r.calls, want)
+	}
+}
+
+func TestRunGivesEveryHookItsOwnTimeout(t *testing.T) {
+	const timeout = 100 * time.Millisecond
+	m := New(zap.NewNop(), timeout)
+	var remaining time.Duration
+	m.Append(Hook{Name: "postgres", OnStop: func(ctx context.Context) error {
+		deadline, _ := ctx.Deadline()
+		remaining = time.Until(deadline)
+		return ctx.Err()
+	}})
+	m.Append(Hook{Name: "grpc", OnStop: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+
+	err := run(t, m)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "failed to stop grpc") {
+		t.Errorf("Run() error = %v, want grpc to time out", err)
+	}
+	if strings.Contains(err.Error(), "postgres") {
+		t.Errorf("Run() error = %v, postgres was left no time", err)
+	}
+	if remaining < timeout/2 {
+		t.Errorf("postgres was given %v to stop, want about %v", remaining, timeout)
+	}
+}
+
+func TestGoFailureShutsDown(t *testing.T) {
+	r := &recorder{}
+	m := New(zap.NewNop(), time.Second)
+	m.Append(r.hook("postgres", nil))
+	m.Append(Hook{Name: "grpc", OnStart: func(context.Context) error {
+		m.Go("grpc", func() error { return errors.New("listener closed") })
+		m.Go("http", func() error { return errors.New("also failed") })
+		return nil
+	}})
+
+	done := make(chan error)
+	go func() { done <- m.Run(context.Background()) }()
+	select {
+	case err := <-done:
+		// only the first failure is reported
+		if err == nil || (err.Error() != "grpc: listener closed" && err.Error() != "http: also failed") {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Run() did not return")
+	}
+	if want := []string{"start postgres", "stop postgres"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %q, want %q", r.calls, want)
+	}
+}
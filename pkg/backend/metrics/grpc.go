@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func (m *Metrics) observeRPC(fullMethod string, start time.Time, err error) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	code := status.Code(err).String()
+	m.rpcHandled.WithLabelValues(service, method, code).Inc()
+	m.rpcDuration.WithLabelValues(service, method, code).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,73 @@
+// Package metrics records Prometheus metrics for RPCs, GORM statements,
+// the Postgres connection pool and MongoDB commands.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics owns a registry holding every metric of the server, so that
+// instrumenting twice, e.g. in tests, cannot panic on duplicate
+// registration in the global registry.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcHandled  *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	queryDuration *prometheus.HistogramVec
+	queryErrors   *prometheus.CounterVec
+
+	mongoDuration *prometheus.HistogramVec
+	mongoFailures *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "RPCs completed on the server, by method and status code.",
+		}, []string{"grpc_service", "grpc_method", "grpc_code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Time taken to handle RPCs, by method and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_service", "grpc_method", "grpc_code"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gorm_query_duration_seconds",
+			Help:    "Time taken by GORM statements, by table and operation.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"table", "operation"}),
+		queryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gorm_query_errors_total",
+			Help: "GORM statements that failed, by table and operation. Missing records are not counted.",
+		}, []string{"table", "operation"}),
+		mongoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mongodb_command_duration_seconds",
+			Help:    "Time taken by MongoDB commands, by database and command.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"database", "command"}),
+		mongoFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mongodb_command_failures_total",
+			Help: "MongoDB commands that failed, by database and command.",
+		}, []string{"database", "command"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcHandled, m.rpcDuration,
+		m.queryDuration, m.queryErrors,
+		m.mongoDuration, m.mongoFailures,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// CommandMonitor records the duration and failures of MongoDB commands. Set
+// it with options.Client().SetMonitor.
+func (m *Metrics) CommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			m.mongoDuration.WithLabelValues(e.DatabaseName, e.CommandName).Observe(e.Duration.Seconds())
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			m.mongoDuration.WithLabelValues(e.DatabaseName, e.CommandName).Observe(e.Duration.Seconds())
+			m.mongoFailures.WithLabelValues(e.DatabaseName, e.CommandName).Inc()
+		},
+	}
+}
@@ -0,0 +1,329 @@
+// Package migrations applies the versioned SQL migrations embedded from the
+// sql directory. Every migration is a pair of files
+//
+//	NNNN_name.up.sql
+//	NNNN_name.down.sql
+//
+// applied in a transaction each. Applied versions are recorded in the
+// schema_migrations table together with a checksum of the up script, and a
+// Postgres advisory lock serialises concurrent runs so that several replicas
+// can start at once.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed sql/*.sql
+var embedded embed.FS
+
+// Dir is where `migrate create` writes new migrations, relative to the
+// repository root.
+const Dir = "pkg/backend/migrations/sql"
+
+// lockID is the advisory lock key, an arbitrary constant private to go-agro.
+const lockID = 7_261_503_311_024_718_341
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    bigint PRIMARY KEY,
+    name       text        NOT NULL,
+    checksum   char(64)    NOT NULL,
+    applied_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Status describes a migration known to the source, the database or both.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	// Modified is set when the up script changed after it was applied.
+	Modified bool
+	// Missing is set when an applied migration is no longer in the source.
+	Missing bool
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New returns a migrator for the embedded migrations.
+func New(db *sql.DB) (*Migrator, error) {
+	return NewFromFS(db, embedded, "sql")
+}
+
+func NewFromFS(db *sql.DB, fsys fs.FS, dir string) (*Migrator, error) {
+	migrations, err := load(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		m := fileName.FindStringSubmatch(e.Name())
+		if m == nil {
+			return nil, fmt.Errorf("unexpected file %s in migrations", e.Name())
+		}
+		version, _ := strconv.ParseInt(m[1], 10, 64)
+		body, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration: %w", err)
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+			sum := sha256.Sum256(body)
+			mig.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up script", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+type applied struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Up applies up to steps pending migrations, all of them when steps <= 0,
+// and returns the versions applied.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]int64, error) {
+	var done []int64
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		state, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(state); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := state[mig.Version]; ok {
+				continue
+			}
+			if steps > 0 && len(done) == steps {
+				break
+			}
+			err := inTx(ctx, conn, mig.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					mig.Version, mig.Name, mig.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig.Version)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the steps most recently applied migrations and returns the
+// versions reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]int64, error) {
+	var done []int64
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		state, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := state[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down script", mig.Version, mig.Name)
+			}
+			err := inTx(ctx, conn, mig.Down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("reverting migration %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig.Version)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status lists every migration known to the source or the database.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	state, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Status
+	for _, mig := range m.migrations {
+		s := Status{Version: mig.Version, Name: mig.Name}
+		if a, ok := state[mig.Version]; ok {
+			s.AppliedAt = &a.appliedAt
+			s.Modified = a.checksum != mig.Checksum
+			delete(state, mig.Version)
+		}
+		out = append(out, s)
+	}
+	for version, a := range state {
+		out = append(out, Status{Version: version, Name: a.name, AppliedAt: &a.appliedAt, Missing: true})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+// verify refuses to continue when applied migrations were edited or removed,
+// since the schema would no longer match the source.
+func (m *Migrator) verify(state map[int64]applied) error {
+	known := map[int64]bool{}
+	var errs []error
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		if a, ok := state[mig.Version]; ok && a.checksum != mig.Checksum {
+			errs = append(errs, fmt.Errorf("migration %d_%s was modified after it was applied", mig.Version, mig.Name))
+		}
+	}
+	for version, a := range state {
+		if !known[version] {
+			errs = append(errs, fmt.Errorf("applied migration %d_%s is missing from the source", version, a.name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]applied, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+	state := map[int64]applied{}
+	for rows.Next() {
+		var version int64
+		var a applied
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		state[version] = a
+	}
+	return state, rows.Err()
+}
+
+// locked runs fn on a single connection holding the migration lock.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, int64(lockID)); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, int64(lockID))
+
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, script string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if err := record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Create writes an empty up and down migration named name to dir, numbered
+// after the highest version found there, and returns their paths.
+func Create(dir, name string) (string, string, error) {
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(name) {
+		return "", "", fmt.Errorf("migration name %q must consist of lower case letters, digits and underscores", name)
+	}
+	existing, err := load(os.DirFS(dir), ".")
+	if err != nil {
+		return "", "", err
+	}
+	var next int64 = 1
+	if len(existing) > 0 {
+		next = existing[len(existing)-1].Version + 1
+	}
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", next, name))
+	up, down := base+".up.sql", base+".down.sql"
+	if err := os.WriteFile(up, []byte("-- "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte("-- revert "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	return up, down, nil
+}
@@ -0,0 +1,369 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func checksum(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestEmbedded(t *testing.T) {
+	migrations, err := load(embedded, "sql")
+	if err != nil {
+		t.Fatalf("load() error = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no embedded migrations")
+	}
+	for i, mig := range migrations {
+		// versions are contiguous, so that a migration added on a branch
+		// cannot slip in below one already applied elsewhere
+		if want := int64(i + 1); mig.Version != want {
+			t.Errorf("migrations[%d].Version = %d, want %d", i, mig.Version, want)
+		}
+		if mig.Down == "" {
+			t.Errorf("migration %d_%s has no down script", mig.Version, mig.Name)
+		}
+		if mig.Checksum != checksum(mig.Up) {
+			t.Errorf("migration %d_%s checksum = %s, want the sha256 of its up script", mig.Version, mig.Name, mig.Checksum)
+		}
+	}
+	if first := migrations[0]; first.Name != "init" {
+		t.Errorf("first migration is %s, want init", first.Name)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	file := func(body string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(body)} }
+
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []Migration
+		wantErr string
+	}{
+		{
+			name: "ordered by version",
+			fsys: fstest.MapFS{
+				"sql/0010_tenth.up.sql":    file("CREATE TABLE tenth ();"),
+				"sql/0002_second.up.sql":   file("CREATE TABLE second ();"),
+				"sql/0002_second.down.sql": file("DROP TABLE second;"),
+				"sql/0001_first.up.sql":    file("CREATE TABLE first ();"),
+			},
+			want: []Migration{
+				{Version: 1, Name: "first", Up: "CREATE TABLE first ();", Checksum: checksum("CREATE TABLE first ();")},
+				{Version: 2, Name: "second", Up: "CREATE TABLE second ();", Down: "DROP TABLE second;", Checksum: checksum("CREATE TABLE second ();")},
+				{Version: 10, Name: "tenth", Up: "CREATE TABLE tenth ();", Checksum: checksum("CREATE TABLE tenth ();")},
+			},
+		},
+		{
+			name: "empty",
+			fsys: fstest.MapFS{"sql": &fstest.MapFile{Mode: os.ModeDir}},
+			want: []Migration{},
+		},
+		{
+			name:    "unexpected file",
+			fsys:    fstest.MapFS{"sql/0001_first.up.sql": file("SELECT 1;"), "sql/README.md": file("")},
+			wantErr: "unexpected file README.md in migrations",
+		},
+		{
+			name:    "upper case name",
+			fsys:    fstest.MapFS{"sql/0001_First.up.sql": file("SELECT 1;")},
+			wantErr: "unexpected file 0001_First.up.sql",
+		},
+		{
+			name:    "conflicting names",
+			fsys:    fstest.MapFS{"sql/0001_first.up.sql": file("SELECT 1;"), "sql/0001_other.down.sql": file("SELECT 1;")},
+			wantErr: "migration 1 has conflicting names",
+		},
+		{
+			name:    "no up script",
+			fsys:    fstest.MapFS{"sql/0001_first.down.sql": file("SELECT 1;")},
+			wantErr: "migration 1_first has no up script",
+		},
+		{
+			name:    "no directory",
+			fsys:    fstest.MapFS{},
+			wantErr: "failed to read migrations",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := load(tt.fsys, "sql")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("load() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("load() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	m := &Migrator{migrations: []Migration{
+		{Version: 1, Name: "first", Checksum: checksum("first")},
+		{Version: 2, Name: "second", Checksum: checksum("second")},
+	}}
+
+	tests := []struct {
+		name    string
+		state   map[int64]applied
+		wantErr []string
+	}{
+		{"nothing applied", map[int64]applied{}, nil},
+		{"partly applied", map[int64]applied{1: {name: "first", checksum: checksum("first")}}, nil},
+		{
+			name:    "modified",
+			state:   map[int64]applied{1: {name: "first", checksum: checksum("edited")}},
+			wantErr: []string{"migration 1_first was modified after it was applied"},
+		},
+		{
+			name:    "missing",
+			state:   map[int64]applied{1: {name: "first", checksum: checksum("first")}, 3: {name: "third"}},
+			wantErr: []string{"applied migration 3_third is missing from the source"},
+		},
+		{
+			name: "modified and missing",
+			state: map[int64]applied{
+				2: {name: "second", checksum: checksum("edited")},
+				4: {name: "fourth"},
+			},
+			wantErr: []string{"migration 2_second was modified", "applied migration 4_fourth is missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.verify(tt.state)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("verify() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("verify() = nil, want %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("verify() error = %v, want %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+// database is a database/sql connector standing in for PostgreSQL. It keeps
+// the schema_migrations rows and records the scripts run, ignoring the lock.
+type database struct {
+	state   map[int64]applied
+	scripts []string
+}
+
+func (d *database) Connect(context.Context) (driver.Conn, error) { return &conn{d}, nil }
+func (d *database) Driver() driver.Driver                        { return nil }
+
+type conn struct{ d *database }
+
+func (c *conn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *conn) Close() error                        { return nil }
+func (c *conn) Begin() (driver.Tx, error)           { return tx{}, nil }
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	switch {
+	case strings.HasPrefix(query, "SELECT pg_advisory"), query == createTable:
+	case strings.HasPrefix(query, "INSERT INTO schema_migrations"):
+		c.d.state[args[0].Value.(int64)] = applied{name: args[1].Value.(string), checksum: args[2].Value.(string)}
+	case strings.HasPrefix(query, "DELETE FROM schema_migrations"):
+		delete(c.d.state, args[0].Value.(int64))
+	default:
+		c.d.scripts = append(c.d.scripts, query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if !strings.HasPrefix(query, "SELECT version, name, checksum, applied_at FROM schema_migrations") {
+		return nil, fmt.Errorf("unexpected query %s", query)
+	}
+	r := &rows{}
+	for version, a := range c.d.state {
+		r.values = append(r.values, []driver.Value{version, a.name, a.checksum, time.Now()})
+	}
+	return r, nil
+}
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type rows struct {
+	values [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return []string{"version", "name", "checksum", "applied_at"} }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func (d *database) versions() []int64 {
+	var versions []int64
+	for v := range d.state {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+func TestUpDown(t *testing.T) {
+	fsys := fstest.MapFS{}
+	for i, name := range []string{"first", "second", "third"} {
+		fsys[fmt.Sprintf("sql/%04d_%s.up.sql", i+1, name)] = &fstest.MapFile{Data: []byte("up " + name)}
+		fsys[fmt.Sprintf("sql/%04d_%s.down.sql", i+1, name)] = &fstest.MapFile{Data: []byte("down " + name)}
+	}
+	ctx := context.Background()
+	d := &database{state: map[int64]applied{1: {name: "first", checksum: checksum("up first")}}}
+	m, err := NewFromFS(sql.OpenDB(d), fsys, "sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step := func(name string, run func() ([]int64, error), want []int64, wantScripts []string, wantState []int64) {
+		t.Helper()
+		d.scripts = nil
+		got, err := run()
+		if err != nil {
+			t.Fatalf("%s error = %v", name, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+		if !reflect.DeepEqual(d.scripts, wantScripts) {
+			t.Errorf("%s ran %q, want %q", name, d.scripts, wantScripts)
+		}
+		if !reflect.DeepEqual(d.versions(), wantState) {
+			t.Errorf("%s left %v applied, want %v", name, d.versions(), wantState)
+		}
+	}
+	step("Up(1)", func() ([]int64, error) { return m.Up(ctx, 1) },
+		[]int64{2}, []string{"up second"}, []int64{1, 2})
+	step("Up(0)", func() ([]int64, error) { return m.Up(ctx, 0) },
+		[]int64{3}, []string{"up third"}, []int64{1, 2, 3})
+	step("Up(0) again", func() ([]int64, error) { return m.Up(ctx, 0) },
+		nil, nil, []int64{1, 2, 3})
+	step("Down(2)", func() ([]int64, error) { return m.Down(ctx, 2) },
+		[]int64{3, 2}, []string{"down third", "down second"}, []int64{1})
+	step("Down(5)", func() ([]int64, error) { return m.Down(ctx, 5) },
+		[]int64{1}, []string{"down first"}, nil)
+	step("Up(0) from scratch", func() ([]int64, error) { return m.Up(ctx, 0) },
+		[]int64{1, 2, 3}, []string{"up first", "up second", "up third"}, []int64{1, 2, 3})
+
+	// an edited migration stops Up before anything is applied
+	d.state[2] = applied{name: "second", checksum: checksum("edited")}
+	delete(d.state, 3)
+	d.scripts = nil
+	if _, err := m.Up(ctx, 0); err == nil || !strings.Contains(err.Error(), "migration 2_second was modified") {
+		t.Errorf("Up() of a modified migration error = %v", err)
+	}
+	if d.scripts != nil {
+		t.Errorf("Up() of a modified migration ran %q", d.scripts)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("first", func(t *testing.T) {
+		dir := t.TempDir()
+		up, down, err := Create(dir, "add_farms")
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if want := filepath.Join(dir, "0001_add_farms.up.sql"); up != want {
+			t.Errorf("Create() up = %s, want %s", up, want)
+		}
+		if want := filepath.Join(dir, "0001_add_farms.down.sql"); down != want {
+			t.Errorf("Create() down = %s, want %s", down, want)
+		}
+		if body, _ := os.ReadFile(up); string(body) != "-- add_farms\n" {
+			t.Errorf("up script = %q", body)
+		}
+		if body, _ := os.ReadFile(down); string(body) != "-- revert add_farms\n" {
+			t.Errorf("down script = %q", body)
+		}
+	})
+
+	t.Run("after the highest version", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, name := range []string{"0001_init.up.sql", "0009_inventory.up.sql", "0009_inventory.down.sql"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		up, _, err := Create(dir, "lots")
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if want := filepath.Join(dir, "0010_lots.up.sql"); up != want {
+			t.Errorf("Create() up = %s, want %s", up, want)
+		}
+		// the new migration loads with the others
+		migrations, err := load(os.DirFS(dir), ".")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last := migrations[len(migrations)-1]; last.Version != 10 || last.Name != "lots" {
+			t.Errorf("last migration = %d_%s, want 10_lots", last.Version, last.Name)
+		}
+	})
+
+	for _, name := range []string{"AddFarms", "add-farms", "", "../escape"} {
+		t.Run("invalid name "+name, func(t *testing.T) {
+			dir := t.TempDir()
+			if _, _, err := Create(dir, name); err == nil {
+				t.Errorf("Create(%q) error = nil", name)
+			}
+			if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+				t.Errorf("Create(%q) wrote %d files", name, len(entries))
+			}
+		})
+	}
+
+	t.Run("unexpected file", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := Create(dir, "lots"); err == nil {
+			t.Error("Create() error = nil")
+		}
+	})
+}
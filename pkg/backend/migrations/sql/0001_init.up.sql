@@ -0,0 +1,64 @@
+-- Baseline schema. Tables are created with IF NOT EXISTS so that databases
+-- previously set up by GORM's AutoMigrate adopt the migration history.
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS users (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    username   varchar(50)  NOT NULL,
+    email      varchar(100) NOT NULL,
+    password   varchar(255) NOT NULL,
+    admin      boolean      NOT NULL DEFAULT false,
+    created_at timestamptz  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz,
+    CONSTRAINT uni_users_username UNIQUE (username),
+    CONSTRAINT uni_users_email UNIQUE (email)
+);
+-- tables created by AutoMigrate before these columns existed
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS admin boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    family_id  uuid        NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at    timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz,
+    CONSTRAINT uni_refresh_tokens_token_hash UNIQUE (token_hash)
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    id   uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name varchar(100) NOT NULL,
+    CONSTRAINT uni_permissions_name UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS roles (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name        varchar(50) NOT NULL,
+    description varchar(255),
+    CONSTRAINT uni_roles_name UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id       uuid NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    permission_id uuid NOT NULL REFERENCES permissions (id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS role_bindings (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role_id    uuid        NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    scope_type varchar(20) NOT NULL,
+    scope_id   varchar(64) NOT NULL DEFAULT '',
+    created_at timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_role_binding ON role_bindings (user_id, role_id, scope_type, scope_id);
@@ -0,0 +1 @@
+DELETE FROM roles WHERE name IN ('owner', 'agronomist', 'field_worker', 'buyer');
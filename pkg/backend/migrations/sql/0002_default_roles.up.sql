@@ -0,0 +1,33 @@
+-- Built-in roles. Existing roles and permissions are left untouched.
+INSERT INTO permissions (name) VALUES
+    ('farm.*'), ('farm.read'),
+    ('field.*'), ('field.read'),
+    ('crop.*'), ('crop.read'),
+    ('activity.*'), ('activity.read'), ('activity.write'),
+    ('inventory.*'), ('inventory.read'),
+    ('telemetry.*'), ('telemetry.read'),
+    ('report.*'), ('report.read'),
+    ('rbac.manage')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO roles (name, description) VALUES
+    ('owner', 'Farm owner with full control over the farm'),
+    ('agronomist', 'Plans crops and reviews field data'),
+    ('field_worker', 'Records field operations'),
+    ('buyer', 'Views produce availability')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('owner', 'farm.*'), ('owner', 'field.*'), ('owner', 'crop.*'), ('owner', 'activity.*'),
+    ('owner', 'inventory.*'), ('owner', 'telemetry.*'), ('owner', 'report.*'), ('owner', 'rbac.manage'),
+    ('agronomist', 'farm.read'), ('agronomist', 'field.read'), ('agronomist', 'crop.*'),
+    ('agronomist', 'activity.*'), ('agronomist', 'telemetry.read'), ('agronomist', 'report.read'),
+    ('field_worker', 'farm.read'), ('field_worker', 'field.read'), ('field_worker', 'crop.read'),
+    ('field_worker', 'activity.read'), ('field_worker', 'activity.write'), ('field_worker', 'inventory.read'),
+    ('buyer', 'farm.read'), ('buyer', 'crop.read')
+) AS grants (role, permission)
+JOIN roles r ON r.name = grants.role
+JOIN permissions p ON p.name = grants.permission
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+DELETE FROM role_bindings WHERE scope_type = 'farm';
+DROP TABLE IF EXISTS fields;
+DROP TABLE IF EXISTS farms;
@@ -0,0 +1,25 @@
+CREATE TABLE farms (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id        uuid             NOT NULL REFERENCES users (id),
+    organisation_id varchar(64)      NOT NULL DEFAULT '',
+    name            varchar(100)     NOT NULL,
+    address         varchar(255)     NOT NULL DEFAULT '',
+    boundary        jsonb,
+    area_hectares   double precision NOT NULL DEFAULT 0,
+    created_at      timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      timestamptz
+);
+CREATE INDEX idx_farms_owner_id ON farms (owner_id);
+CREATE INDEX idx_farms_organisation_id ON farms (organisation_id) WHERE organisation_id <> '';
+
+CREATE TABLE fields (
+    id            uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    farm_id       uuid             NOT NULL REFERENCES farms (id) ON DELETE CASCADE,
+    name          varchar(100)     NOT NULL,
+    soil_type     varchar(20)      NOT NULL DEFAULT '',
+    boundary      jsonb            NOT NULL,
+    area_hectares double precision NOT NULL,
+    created_at    timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    timestamptz
+);
+CREATE UNIQUE INDEX idx_fields_farm_name ON fields (farm_id, name);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS planting_plans;
+DROP TABLE IF EXISTS crops;
@@ -0,0 +1,36 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE crops (
+    id                   uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    species              varchar(100)     NOT NULL,
+    variety              varchar(100)     NOT NULL DEFAULT '',
+    days_to_maturity     integer          NOT NULL CHECK (days_to_maturity > 0),
+    row_spacing_cm       double precision NOT NULL DEFAULT 0,
+    plant_spacing_cm     double precision NOT NULL DEFAULT 0,
+    water_requirement_mm double precision NOT NULL DEFAULT 0,
+    base_temperature_c   double precision NOT NULL DEFAULT 0,
+    created_at           timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at           timestamptz
+);
+CREATE UNIQUE INDEX idx_crops_species_variety ON crops (species, variety);
+
+CREATE TABLE planting_plans (
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    field_id     uuid          NOT NULL REFERENCES fields (id) ON DELETE CASCADE,
+    crop_id      uuid          NOT NULL REFERENCES crops (id) ON DELETE RESTRICT,
+    season       varchar(50)   NOT NULL,
+    sowing_date  date          NOT NULL,
+    harvest_date date          NOT NULL,
+    status       varchar(20)   NOT NULL DEFAULT 'planned',
+    notes        varchar(1000) NOT NULL DEFAULT '',
+    created_at   timestamptz   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   timestamptz,
+    CHECK (harvest_date > sowing_date),
+    -- a field grows one crop at a time; plans may end on the day the next
+    -- one is sown
+    CONSTRAINT planting_plans_no_overlap EXCLUDE USING gist (
+        field_id WITH =,
+        daterange(sowing_date, harvest_date) WITH &&
+    ) WHERE (status <> 'cancelled')
+);
+CREATE INDEX idx_planting_plans_crop_id ON planting_plans (crop_id);
@@ -0,0 +1,2 @@
+DELETE FROM roles WHERE name = 'device';
+DELETE FROM permissions WHERE name = 'telemetry.write';
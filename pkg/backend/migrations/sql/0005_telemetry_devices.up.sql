@@ -0,0 +1,16 @@
+-- Sensor stations push readings with the permissions of a device account.
+INSERT INTO permissions (name) VALUES ('telemetry.write')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO roles (name, description) VALUES
+    ('device', 'Sensor station sending telemetry')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('device', 'telemetry.read'), ('device', 'telemetry.write')
+) AS grants (role, permission)
+JOIN roles r ON r.name = grants.role
+JOIN permissions p ON p.name = grants.permission
+ON CONFLICT DO NOTHING;
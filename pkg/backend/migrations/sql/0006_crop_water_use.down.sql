@@ -0,0 +1,5 @@
+ALTER TABLE crops
+    DROP COLUMN IF EXISTS kc_initial,
+    DROP COLUMN IF EXISTS kc_mid,
+    DROP COLUMN IF EXISTS kc_end,
+    DROP COLUMN IF EXISTS root_depth_m;
@@ -0,0 +1,7 @@
+-- FAO-56 crop coefficients of the initial, mid-season and late stages and
+-- the effective rooting depth, used by the irrigation scheduler.
+ALTER TABLE crops
+    ADD COLUMN kc_initial   double precision NOT NULL DEFAULT 0.4 CHECK (kc_initial BETWEEN 0.1 AND 2),
+    ADD COLUMN kc_mid       double precision NOT NULL DEFAULT 1.1 CHECK (kc_mid BETWEEN 0.1 AND 2),
+    ADD COLUMN kc_end       double precision NOT NULL DEFAULT 0.6 CHECK (kc_end BETWEEN 0.1 AND 2),
+    ADD COLUMN root_depth_m double precision NOT NULL DEFAULT 0.6 CHECK (root_depth_m > 0 AND root_depth_m <= 5);
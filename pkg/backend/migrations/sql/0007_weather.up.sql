@@ -0,0 +1,3 @@
+-- Weather imports feed every farm and are granted through global bindings.
+INSERT INTO permissions (name) VALUES ('weather.*'), ('weather.write')
+ON CONFLICT (name) DO NOTHING;
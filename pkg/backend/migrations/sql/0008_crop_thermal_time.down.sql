@@ -0,0 +1,2 @@
+ALTER TABLE crops
+    DROP COLUMN IF EXISTS degree_days_to_maturity;
@@ -0,0 +1,4 @@
+-- Growing degree days above the base temperature a crop needs from sowing to
+-- maturity. 0 leaves growth stages to be estimated from days to maturity.
+ALTER TABLE crops
+    ADD COLUMN degree_days_to_maturity double precision NOT NULL DEFAULT 0 CHECK (degree_days_to_maturity BETWEEN 0 AND 10000);
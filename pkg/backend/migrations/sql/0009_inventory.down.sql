@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS stock_balances;
+DROP TABLE IF EXISTS stock_movements;
+DROP FUNCTION IF EXISTS stock_movements_immutable();
+DROP TABLE IF EXISTS stock_lots;
+DROP TABLE IF EXISTS skus;
+DROP TABLE IF EXISTS warehouses;
+DELETE FROM permissions WHERE name = 'inventory.write';
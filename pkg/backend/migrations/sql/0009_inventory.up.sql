@@ -0,0 +1,95 @@
+-- Farm inputs are kept in warehouses. Every change of stock is a movement
+-- of an immutable ledger; stock_balances holds the resulting quantity of
+-- every lot in every warehouse and is updated in the transaction recording
+-- the movement.
+INSERT INTO permissions (name) VALUES ('inventory.write')
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE warehouses (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    farm_id    uuid          NOT NULL REFERENCES farms (id) ON DELETE CASCADE,
+    name       varchar(100)  NOT NULL,
+    location   varchar(255)  NOT NULL DEFAULT '',
+    created_at timestamptz   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz
+);
+CREATE UNIQUE INDEX idx_warehouses_farm_name ON warehouses (farm_id, name);
+
+CREATE TABLE skus (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    farm_id    uuid          NOT NULL REFERENCES farms (id) ON DELETE CASCADE,
+    code       varchar(50)   NOT NULL,
+    name       varchar(100)  NOT NULL,
+    category   varchar(20)   NOT NULL CHECK (category IN ('seed', 'fertiliser', 'chemical', 'other')),
+    unit       varchar(20)   NOT NULL,
+    created_at timestamptz   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz
+);
+CREATE UNIQUE INDEX idx_skus_farm_code ON skus (farm_id, code);
+
+CREATE TABLE stock_lots (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sku_id      uuid          NOT NULL REFERENCES skus (id) ON DELETE CASCADE,
+    lot_number  varchar(50)   NOT NULL,
+    expiry_date date,
+    created_at  timestamptz   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX idx_stock_lots_sku_lot_number ON stock_lots (sku_id, lot_number);
+
+-- quantity is the change of the stock of warehouse_id in the unit of the
+-- SKU; a transfer moves the same amount on to to_warehouse_id
+CREATE TABLE stock_movements (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    farm_id         uuid           NOT NULL REFERENCES farms (id) ON DELETE CASCADE,
+    kind            varchar(20)    NOT NULL,
+    warehouse_id    uuid           NOT NULL REFERENCES warehouses (id) ON DELETE CASCADE,
+    to_warehouse_id uuid           REFERENCES warehouses (id) ON DELETE CASCADE,
+    sku_id          uuid           NOT NULL REFERENCES skus (id) ON DELETE CASCADE,
+    lot_id          uuid           NOT NULL REFERENCES stock_lots (id) ON DELETE CASCADE,
+    quantity        numeric(14, 3) NOT NULL,
+    -- the field activity stock is issued to
+    activity_id     uuid,
+    field_id        uuid,
+    reference       varchar(100)   NOT NULL DEFAULT '',
+    reason          varchar(500)   NOT NULL DEFAULT '',
+    actor_id        uuid           NOT NULL,
+    occurred_at     timestamptz    NOT NULL,
+    recorded_at     timestamptz    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (kind = 'receipt' AND quantity > 0 AND to_warehouse_id IS NULL AND activity_id IS NULL) OR
+        (kind = 'issue' AND quantity < 0 AND to_warehouse_id IS NULL AND activity_id IS NOT NULL) OR
+        (kind = 'transfer' AND quantity < 0 AND to_warehouse_id IS NOT NULL AND to_warehouse_id <> warehouse_id AND activity_id IS NULL) OR
+        (kind = 'adjustment' AND quantity <> 0 AND to_warehouse_id IS NULL AND activity_id IS NULL AND reason <> '')
+    )
+);
+CREATE INDEX idx_stock_movements_farm_recorded_at ON stock_movements (farm_id, recorded_at, id);
+CREATE INDEX idx_stock_movements_warehouse_id ON stock_movements (warehouse_id);
+CREATE INDEX idx_stock_movements_to_warehouse_id ON stock_movements (to_warehouse_id) WHERE to_warehouse_id IS NOT NULL;
+CREATE INDEX idx_stock_movements_lot_id ON stock_movements (lot_id);
+CREATE INDEX idx_stock_movements_activity_id ON stock_movements (activity_id) WHERE activity_id IS NOT NULL;
+
+-- The ledger is append-only, mistakes are fixed with adjustments. Rows are
+-- only deleted by the cascade from the farm, warehouse or SKU they belong
+-- to, which runs as a trigger itself.
+CREATE FUNCTION stock_movements_immutable() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'stock movements are immutable' USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movements_immutable
+    BEFORE UPDATE OR DELETE ON stock_movements
+    FOR EACH ROW EXECUTE FUNCTION stock_movements_immutable();
+
+CREATE TABLE stock_balances (
+    warehouse_id uuid           NOT NULL REFERENCES warehouses (id) ON DELETE CASCADE,
+    lot_id       uuid           NOT NULL REFERENCES stock_lots (id) ON DELETE CASCADE,
+    sku_id       uuid           NOT NULL REFERENCES skus (id) ON DELETE CASCADE,
+    quantity     numeric(14, 3) NOT NULL CHECK (quantity >= 0),
+    updated_at   timestamptz    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (warehouse_id, lot_id)
+);
+CREATE INDEX idx_stock_balances_sku_id ON stock_balances (sku_id);
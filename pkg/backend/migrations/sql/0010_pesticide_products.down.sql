@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pesticide_products;
@@ -0,0 +1,20 @@
+-- The registry of plant protection products and the conditions of their
+-- use. Sprayings are checked against it when they are recorded.
+CREATE TABLE pesticide_products (
+    id                           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name                         varchar(100)     NOT NULL,
+    registration_number          varchar(50)      NOT NULL,
+    active_ingredient            varchar(200)     NOT NULL DEFAULT '',
+    -- days from the last spraying to the harvest
+    pre_harvest_interval_days    integer          NOT NULL DEFAULT 0 CHECK (pre_harvest_interval_days BETWEEN 0 AND 365),
+    -- hours after a spraying before the field may be entered
+    re_entry_interval_hours      integer          NOT NULL DEFAULT 0 CHECK (re_entry_interval_hours BETWEEN 0 AND 720),
+    -- 0 when not limited
+    max_applications_per_season  integer          NOT NULL DEFAULT 0 CHECK (max_applications_per_season >= 0),
+    max_dose_per_hectare         double precision NOT NULL DEFAULT 0 CHECK (max_dose_per_hectare >= 0),
+    dose_unit                    varchar(20)      NOT NULL DEFAULT '',
+    created_at                   timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                   timestamptz,
+    CHECK (max_dose_per_hectare = 0 OR dose_unit <> '')
+);
+CREATE UNIQUE INDEX idx_pesticide_products_registration_number ON pesticide_products (registration_number);
@@ -1 +1,7 @@
-protoc --go_out=. --go-grpc_out=. --proto_path=./common/proto user.proto
+protoc --go_out=. --go_opt=module=github.com/aburifat/go-agro/pkg/backend \
+  --go-grpc_out=. --go-grpc_opt=module=github.com/aburifat/go-agro/pkg/backend \
+  --proto_path=./common/proto \
+  validate/validate.proto user.proto auth.proto rbac.proto farm.proto crop.proto activity.proto telemetry.proto irrigation.proto weather.proto growth.proto inventory.proto compliance.proto
+protoc --go_out=. --go_opt=module=github.com/aburifat/go-agro/pkg/backend \
+  --proto_path=./common/logging/internal/testpb \
+  redact.proto
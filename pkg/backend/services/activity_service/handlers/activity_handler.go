@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/rules"
+	farmrepository "github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	userrepository "github.com/aburifat/go-agro/pkg/backend/services/user_service/repository"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var errNoJournal = status.Error(codes.FailedPrecondition, "the activity journal requires MongoDB to be configured")
+
+type ActivityHandler struct {
+	proto.UnimplementedActivityServiceServer
+	db         *gorm.DB
+	journal    *journal.Journal
+	compliance *rules.Engine
+	logger     *zap.Logger
+}
+
+// NewActivityHandler returns the ActivityService handler. j and compliance
+// are nil when MongoDB is not configured, every call then fails with
+// FailedPrecondition.
+func NewActivityHandler(db *gorm.DB, j *journal.Journal, compliance *rules.Engine, logger *zap.Logger) *ActivityHandler {
+	activityHandler := ActivityHandler{
+		db:         db,
+		journal:    j,
+		compliance: compliance,
+		logger:     logger,
+	}
+	return &activityHandler
+}
+
+func (h *ActivityHandler) RecordActivity(ctx context.Context, req *proto.RecordActivityRequest) (*proto.RecordActivityResponse, error) {
+	if h.journal == nil {
+		return nil, errNoJournal
+	}
+	performedAt, err := parseTime("performedAt", req.GetPerformedAt())
+	if err != nil {
+		return nil, err
+	}
+	field, err := farmrepository.GetField(h.db.WithContext(ctx), req.GetFieldId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get field")
+	}
+
+	activity := &journal.Activity{
+		FieldID:     field.ID,
+		FarmID:      field.FarmID,
+		Kind:        kindName(req.GetKind()),
+		ActorID:     auth.FromContext(ctx).UserID,
+		PerformedAt: performedAt,
+		Quantity:    req.GetQuantity(),
+		Unit:        req.GetUnit(),
+		Inputs:      inputsFromProto(req.GetInputs()),
+		Notes:       req.GetNotes(),
+	}
+	id, warnings, err := h.record(ctx, activity, field, "failed to record activity")
+	if err != nil {
+		return nil, err
+	}
+	logging.WithContext(ctx, h.logger).Info("Activity recorded", zap.String("activity_id", id), zap.String("field_id", field.ID),
+		zap.Int("compliance_warnings", len(warnings)))
+
+	return &proto.RecordActivityResponse{
+		Id:       id,
+		Message:  "Activity recorded successfully",
+		Warnings: warnings,
+	}, nil
+}
+
+func (h *ActivityHandler) CorrectActivity(ctx context.Context, req *proto.CorrectActivityRequest) (*proto.CorrectActivityResponse, error) {
+	if h.journal == nil {
+		return nil, errNoJournal
+	}
+	corrected, err := h.journal.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get activity")
+	}
+
+	correction := &journal.Activity{
+		Corrects: corrected.ID,
+		ActorID:  auth.FromContext(ctx).UserID,
+		Notes:    req.GetNotes(),
+		Void:     req.GetVoid(),
+	}
+	if !req.GetVoid() {
+		correction.Kind = corrected.Kind
+		if req.GetKind() != proto.ActivityKind_ACTIVITY_KIND_UNSPECIFIED {
+			correction.Kind = kindName(req.GetKind())
+		}
+		correction.PerformedAt = corrected.PerformedAt
+		if req.GetPerformedAt() != "" {
+			if correction.PerformedAt, err = parseTime("performedAt", req.GetPerformedAt()); err != nil {
+				return nil, err
+			}
+		}
+		correction.Quantity = req.GetQuantity()
+		correction.Unit = req.GetUnit()
+		correction.Inputs = inputsFromProto(req.GetInputs())
+	}
+
+	var id string
+	var warnings []*proto.ComplianceViolation
+	if correction.Void {
+		if id, err = h.journal.Append(ctx, correction); err != nil {
+			return nil, grpcerr.FromError(err, "failed to correct activity")
+		}
+	} else {
+		field, err := farmrepository.GetField(h.db.WithContext(ctx), corrected.FieldID)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to get field")
+		}
+		correction.FieldID = field.ID
+		correction.FarmID = field.FarmID
+		if id, warnings, err = h.record(ctx, correction, field, "failed to correct activity"); err != nil {
+			return nil, err
+		}
+	}
+	logging.WithContext(ctx, h.logger).Info("Activity corrected", zap.String("activity_id", id),
+		zap.String("corrected_activity_id", corrected.ID), zap.Bool("void", correction.Void),
+		zap.Int("compliance_warnings", len(warnings)))
+
+	return &proto.CorrectActivityResponse{
+		Id:       id,
+		Message:  "Activity corrected successfully",
+		Warnings: warnings,
+	}, nil
+}
+
+func (h *ActivityHandler) GetActivity(ctx context.Context, req *proto.GetActivityRequest) (*proto.GetActivityResponse, error) {
+	if h.journal == nil {
+		return nil, errNoJournal
+	}
+	activity, err := h.journal.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get activity")
+	}
+	activities, err := h.toProto(ctx, []*journal.Activity{activity})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetActivityResponse{Activity: activities[0]}, nil
+}
+
+func (h *ActivityHandler) ListActivities(ctx context.Context, req *proto.ListActivitiesRequest) (*proto.ListActivitiesResponse, error) {
+	if h.journal == nil {
+		return nil, errNoJournal
+	}
+	filter := journal.Filter{
+		FieldID: req.GetFieldId(),
+		Kind:    kindName(req.GetKind()),
+		History: req.GetHistory(),
+	}
+	var err error
+	if req.GetFrom() != "" {
+		if filter.From, err = parseTime("from", req.GetFrom()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetTo() != "" {
+		if filter.To, err = parseTime("to", req.GetTo()); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := h.journal.List(ctx, filter, pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list activities")
+	}
+	activities, err := h.toProto(ctx, page.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ListActivitiesResponse{
+		Activities:    activities,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// toProto converts activities, resolving the usernames of their actors.
+func (h *ActivityHandler) toProto(ctx context.Context, activities []*journal.Activity) ([]*proto.Activity, error) {
+	var actorIDs []string
+	for _, a := range activities {
+		actorIDs = append(actorIDs, a.ActorID)
+	}
+	usernames, err := userrepository.GetUsernames(h.db.WithContext(ctx), actorIDs)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get actors")
+	}
+
+	var activityList []*proto.Activity
+	for _, a := range activities {
+		activity := &proto.Activity{
+			Id:            a.ID,
+			FieldId:       a.FieldID,
+			FarmId:        a.FarmID,
+			Kind:          kindFromName(a.Kind),
+			PerformedAt:   a.PerformedAt.UTC().Format(time.RFC3339Nano),
+			RecordedAt:    a.RecordedAt.UTC().Format(time.RFC3339Nano),
+			ActorId:       a.ActorID,
+			ActorUsername: usernames[a.ActorID],
+			Quantity:      a.Quantity,
+			Unit:          a.Unit,
+			Notes:         a.Notes,
+			Corrects:      a.Corrects,
+			Void:          a.Void,
+			CorrectedBy:   a.CorrectedBy,
+		}
+		for _, in := range a.Inputs {
+			activity.Inputs = append(activity.Inputs, &proto.Input{Name: in.Name, Quantity: in.Quantity, Unit: in.Unit, ProductId: in.ProductID})
+		}
+		activityList = append(activityList, activity)
+	}
+	return activityList, nil
+}
+
+func inputsFromProto(inputs []*proto.Input) []journal.Input {
+	var inputList []journal.Input
+	for _, in := range inputs {
+		inputList = append(inputList, journal.Input{Name: in.GetName(), Quantity: in.GetQuantity(), Unit: in.GetUnit(), ProductID: in.GetProductId()})
+	}
+	return inputList
+}
+
+// record appends activity to the journal if it complies with the pesticide
+// registry, failing with msg if the append does. Violations blocking the
+// activity fail as PreconditionFailure details, others are returned as
+// warnings.
+func (h *ActivityHandler) record(ctx context.Context, activity *journal.Activity, field *api.Field, msg string) (string, []*proto.ComplianceViolation, error) {
+	var id string
+	violations, err := h.compliance.Record(ctx, activity, field, func() error {
+		var err error
+		if id, err = h.journal.Append(ctx, activity); err != nil {
+			return grpcerr.FromError(err, msg)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, grpcerr.FromError(err, "failed to check activity compliance")
+	}
+
+	var warnings []*proto.ComplianceViolation
+	for _, v := range violations {
+		warnings = append(warnings, &proto.ComplianceViolation{
+			Rule:        v.Rule,
+			Subject:     v.Subject,
+			Description: v.Description,
+			Advisory:    v.Advisory,
+		})
+	}
+	return id, warnings, nil
+}
+
+func parseTime(field, value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, grpcerr.InvalidArgument(field, "must be an RFC 3339 timestamp")
+	}
+	return t, nil
+}
+
+// kindName returns the name stored for k, e.g. "irrigation", and the empty
+// string for ACTIVITY_KIND_UNSPECIFIED.
+func kindName(k proto.ActivityKind) string {
+	if k == proto.ActivityKind_ACTIVITY_KIND_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(k.String(), "ACTIVITY_KIND_"))
+}
+
+func kindFromName(name string) proto.ActivityKind {
+	return proto.ActivityKind(proto.ActivityKind_value["ACTIVITY_KIND_"+strings.ToUpper(name)])
+}
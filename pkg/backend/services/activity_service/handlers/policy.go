@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/proto"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of ActivityService. Activities are checked
+// against the scope of the farm owning their field.
+func Policy(db *gorm.DB, checker *rbac.Checker, j *journal.Journal) auth.Policy {
+	field := farmhandlers.FieldResource(db, func(req any) string {
+		return req.(interface{ GetFieldId() string }).GetFieldId()
+	})
+	activity := activityResource(db, j)
+
+	return auth.Policy{
+		proto.ActivityService_RecordActivity_FullMethodName:  checker.RequireLookup("activity.write", field),
+		proto.ActivityService_CorrectActivity_FullMethodName: checker.RequireLookup("activity.write", activity),
+		proto.ActivityService_GetActivity_FullMethodName:     checker.RequireLookup("activity.read", activity),
+		proto.ActivityService_ListActivities_FullMethodName:  checker.RequireLookup("activity.read", field),
+	}
+}
+
+func activityResource(db *gorm.DB, j *journal.Journal) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		if j == nil {
+			return rbac.Resource{}, errNoJournal
+		}
+		activity, err := j.Get(ctx, req.(interface{ GetId() string }).GetId())
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		return farmhandlers.FieldResource(db, func(any) string { return activity.FieldID })(ctx, req)
+	}
+}
@@ -0,0 +1,347 @@
+// Package journal keeps the operations carried out on fields as an
+// append-only log in MongoDB. Activities are never updated or deleted; a
+// mistake is fixed by appending a correction that replaces or withdraws the
+// activity it corrects.
+package journal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const CollectionName = "field_activities"
+
+const (
+	KindSowing      = "sowing"
+	KindIrrigation  = "irrigation"
+	KindSpraying    = "spraying"
+	KindFertilising = "fertilising"
+	KindHarvesting  = "harvesting"
+)
+
+// Journal is the activity log of all fields.
+type Journal struct {
+	collection *mongo.Collection
+}
+
+func NewJournal(store *storage.Storage) *Journal {
+	journal := Journal{
+		collection: store.GetCollection(CollectionName),
+	}
+	return &journal
+}
+
+// Input is a product used by an activity, e.g. seed, fertiliser or a
+// pesticide. ProductID refers to the pesticide registry for sprayed
+// products.
+type Input struct {
+	Name      string  `bson:"name"`
+	Quantity  float64 `bson:"quantity"`
+	Unit      string  `bson:"unit"`
+	ProductID string  `bson:"productId,omitempty"`
+}
+
+// Activity is an operation carried out on a field by ActorID, the id of an
+// api.User.
+type Activity struct {
+	ID          string    `bson:"_id"`
+	FieldID     string    `bson:"fieldId"`
+	FarmID      string    `bson:"farmId"`
+	Kind        string    `bson:"kind"`
+	ActorID     string    `bson:"actorId"`
+	PerformedAt time.Time `bson:"performedAt"`
+	RecordedAt  time.Time `bson:"recordedAt"`
+	// Quantity is the amount worked in Unit, e.g. 25 mm of irrigation or
+	// 3.2 t harvested.
+	Quantity float64 `bson:"quantity,omitempty"`
+	Unit     string  `bson:"unit,omitempty"`
+	Inputs   []Input `bson:"inputs,omitempty"`
+	Notes    string  `bson:"notes,omitempty"`
+	// Corrects is the id of the activity a correction replaces.
+	Corrects string `bson:"corrects,omitempty"`
+	// Void marks a correction that withdraws the corrected activity without
+	// replacing it.
+	Void bool `bson:"void,omitempty"`
+	// CorrectedBy is the id of the correction of the activity, if any. It is
+	// derived when reading and never stored.
+	CorrectedBy string `bson:"-"`
+}
+
+// listed is an activity joined with its correction.
+type listed struct {
+	Activity    `bson:",inline"`
+	Corrections []struct {
+		ID string `bson:"_id"`
+	} `bson:"correctedBy"`
+}
+
+func (l *listed) activity() *Activity {
+	a := l.Activity
+	if len(l.Corrections) > 0 {
+		a.CorrectedBy = l.Corrections[0].ID
+	}
+	return &a
+}
+
+// EnsureIndexes creates the indexes the queries rely on. The unique index on
+// corrects keeps the history of an activity linear.
+func (j *Journal) EnsureIndexes(ctx context.Context) error {
+	_, err := j.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "fieldId", Value: 1}, {Key: "performedAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "corrects", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create activity indexes: %w", err)
+	}
+	return nil
+}
+
+// Append records activity and returns its id. A correction must set
+// Corrects; it is recorded for the field of the activity it corrects, which
+// must not have been corrected or withdrawn already.
+func (j *Journal) Append(ctx context.Context, activity *Activity) (string, error) {
+	if activity.Corrects != "" {
+		corrected, err := j.Get(ctx, activity.Corrects)
+		if err != nil {
+			return "", err
+		}
+		if corrected.Void {
+			return "", repoerr.Conflict("id", "activity %s withdraws another activity and cannot be corrected", corrected.ID)
+		}
+		if corrected.CorrectedBy != "" {
+			return "", repoerr.Conflict("id", "activity %s was already corrected by %s", corrected.ID, corrected.CorrectedBy)
+		}
+		activity.FieldID = corrected.FieldID
+		activity.FarmID = corrected.FarmID
+		if activity.Void {
+			activity.Kind = corrected.Kind
+			activity.PerformedAt = corrected.PerformedAt
+		}
+	}
+
+	activity.ID = uuid.NewString()
+	if err := j.insert(ctx, activity); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", repoerr.Conflict("id", "activity %s was corrected concurrently", activity.Corrects)
+		}
+		return "", err
+	}
+	return activity.ID, nil
+}
+
+// AppendOnce records activity under id unless an activity with that id is
+// recorded already, so that retried appends of the same activity record it
+// once. It does not record corrections.
+func (j *Journal) AppendOnce(ctx context.Context, id string, activity *Activity) (string, error) {
+	if activity.Corrects != "" {
+		return "", repoerr.InvalidArgument("corrects", "corrections cannot be appended once")
+	}
+	err := j.collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}},
+		options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).Err()
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return "", fmt.Errorf("failed to fetch activity: %w", err)
+	}
+	activity.ID = id
+	// a duplicate was appended concurrently under the same id
+	if err := j.insert(ctx, activity); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return "", err
+	}
+	return id, nil
+}
+
+func (j *Journal) insert(ctx context.Context, activity *Activity) error {
+	// MongoDB stores milliseconds, truncating keeps the returned activity
+	// equal to the stored one
+	activity.PerformedAt = activity.PerformedAt.UTC().Truncate(time.Millisecond)
+	activity.RecordedAt = time.Now().UTC().Truncate(time.Millisecond)
+	if _, err := j.collection.InsertOne(ctx, activity); err != nil {
+		return fmt.Errorf("failed to record activity: %w", err)
+	}
+	return nil
+}
+
+func (j *Journal) Get(ctx context.Context, id string) (*Activity, error) {
+	var activity Activity
+	err := j.collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&activity)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repoerr.NotFound("id", "no activity found with ID: %s", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch activity: %w", err)
+	}
+
+	var correction Activity
+	err = j.collection.FindOne(ctx, bson.D{{Key: "corrects", Value: id}},
+		options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).Decode(&correction)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to fetch activity correction: %w", err)
+	}
+	activity.CorrectedBy = correction.ID
+	return &activity, nil
+}
+
+// Filter selects the activities of a field. Zero fields are ignored.
+type Filter struct {
+	FieldID string
+	// From is inclusive, To exclusive.
+	From, To time.Time
+	Kind     string
+	// History includes corrected activities and withdrawals, which are left
+	// out by default so that the listing shows the activities as corrected.
+	History bool
+}
+
+type cursor struct {
+	PerformedAt time.Time `json:"p"`
+	ID          string    `json:"i"`
+}
+
+// List returns a page of the activities matching filter ordered by the time
+// they were performed.
+func (j *Journal) List(ctx context.Context, filter Filter, q pagination.Query) (*pagination.Page[Activity], error) {
+	size := q.PageSize
+	if size <= 0 {
+		size = pagination.DefaultPageSize
+	}
+	if size > pagination.MaxPageSize {
+		size = pagination.MaxPageSize
+	}
+
+	match := bson.D{{Key: "fieldId", Value: filter.FieldID}}
+	performedAt := bson.D{}
+	if !filter.From.IsZero() {
+		performedAt = append(performedAt, bson.E{Key: "$gte", Value: filter.From})
+	}
+	if !filter.To.IsZero() {
+		performedAt = append(performedAt, bson.E{Key: "$lt", Value: filter.To})
+	}
+	if len(performedAt) > 0 {
+		match = append(match, bson.E{Key: "performedAt", Value: performedAt})
+	}
+	if filter.Kind != "" {
+		match = append(match, bson.E{Key: "kind", Value: filter.Kind})
+	}
+	page := &pagination.Page[Activity]{}
+	if q.IncludeTotal {
+		total, err := j.count(ctx, append(mongo.Pipeline{{{Key: "$match", Value: match}}}, filter.corrections()...))
+		if err != nil {
+			return nil, err
+		}
+		page.TotalCount = total
+	}
+
+	if q.PageToken != "" {
+		c, err := decodeCursor(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		match = append(match, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "performedAt", Value: bson.D{{Key: "$gt", Value: c.PerformedAt}}}},
+			bson.D{{Key: "performedAt", Value: c.PerformedAt}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: c.ID}}}},
+		}})
+	}
+	// sorted before the lookup so that the limit stops it early
+	stages := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "performedAt", Value: 1}, {Key: "_id", Value: 1}}}},
+	}
+	stages = append(stages, filter.corrections()...)
+	stages = append(stages, bson.D{{Key: "$limit", Value: size + 1}})
+
+	cur, err := j.collection.Aggregate(ctx, stages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activities: %w", err)
+	}
+	var rows []*listed
+	if err := cur.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode activities: %w", err)
+	}
+
+	if len(rows) > size {
+		rows = rows[:size]
+		last := rows[size-1]
+		token, err := encodeCursor(cursor{PerformedAt: last.PerformedAt, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+		page.NextPageToken = token
+	}
+	for _, row := range rows {
+		page.Items = append(page.Items, row.activity())
+	}
+	return page, nil
+}
+
+// corrections joins each activity with its correction and, unless the
+// history was asked for, drops corrected activities and withdrawals.
+func (f Filter) corrections() mongo.Pipeline {
+	stages := mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: CollectionName},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "corrects"},
+			{Key: "pipeline", Value: bson.A{bson.D{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}}}},
+			{Key: "as", Value: "correctedBy"},
+		}}},
+	}
+	if !f.History {
+		stages = append(stages, bson.D{{Key: "$match", Value: bson.D{
+			{Key: "correctedBy", Value: bson.D{{Key: "$size", Value: 0}}},
+			{Key: "void", Value: bson.D{{Key: "$ne", Value: true}}},
+		}}})
+	}
+	return stages
+}
+
+func (j *Journal) count(ctx context.Context, stages mongo.Pipeline) (int64, error) {
+	cur, err := j.collection.Aggregate(ctx, append(stages, bson.D{{Key: "$count", Value: "total"}}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count activities: %w", err)
+	}
+	var counts []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := cur.All(ctx, &counts); err != nil {
+		return 0, fmt.Errorf("failed to count activities: %w", err)
+	}
+	if len(counts) == 0 {
+		return 0, nil
+	}
+	return counts[0].Total, nil
+}
+
+func encodeCursor(c cursor) (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(token string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, repoerr.InvalidArgument("pageToken", "malformed page token")
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, repoerr.InvalidArgument("pageToken", "malformed page token")
+	}
+	return &c, nil
+}
@@ -0,0 +1,251 @@
+package journal
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCursor(t *testing.T) {
+	c := cursor{PerformedAt: time.Date(2025, 3, 1, 6, 30, 0, 0, time.UTC), ID: "a1"}
+	token, err := encodeCursor(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeCursor(token)
+	if err != nil {
+		t.Fatalf("decodeCursor() error = %v", err)
+	}
+	if !got.PerformedAt.Equal(c.PerformedAt) || got.ID != c.ID {
+		t.Errorf("decodeCursor() = %+v, want %+v", got, c)
+	}
+
+	for name, token := range map[string]string{
+		"not base64": "!!",
+		"not json":   "bm90IGpzb24",
+		"no id":      "eyJwIjoiMjAyNS0wMy0wMVQwNjozMDowMFoifQ",
+	} {
+		if _, err := decodeCursor(token); repoerr.KindOf(err) != repoerr.KindInvalidArgument || repoerr.FieldOf(err) != "pageToken" {
+			t.Errorf("%s: decodeCursor() error = %v, want an invalid pageToken", name, err)
+		}
+	}
+
+	// rejected before the database is asked
+	_, err = (&Journal{}).List(context.Background(), Filter{FieldID: "f1"}, pagination.Query{PageToken: "!!"})
+	if repoerr.KindOf(err) != repoerr.KindInvalidArgument {
+		t.Errorf("List() error = %v, want an invalid pageToken", err)
+	}
+}
+
+func newJournal(t *testing.T) *Journal {
+	t.Helper()
+	j := NewJournal(dbtest.Mongo(t))
+	if err := j.EnsureIndexes(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return j
+}
+
+var day = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func appendActivity(t *testing.T, j *Journal, activity *Activity) string {
+	t.Helper()
+	id, err := j.Append(context.Background(), activity)
+	if err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	return id
+}
+
+// list returns the ids of the listed activities and of their corrections.
+func list(t *testing.T, j *Journal, filter Filter) ([]string, map[string]string) {
+	t.Helper()
+	page, err := j.List(context.Background(), filter, pagination.Query{PageSize: pagination.MaxPageSize})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	ids := []string{}
+	correctedBy := map[string]string{}
+	for _, a := range page.Items {
+		ids = append(ids, a.ID)
+		if a.CorrectedBy != "" {
+			correctedBy[a.ID] = a.CorrectedBy
+		}
+	}
+	return ids, correctedBy
+}
+
+func TestCorrections(t *testing.T) {
+	j := newJournal(t)
+	ctx := context.Background()
+
+	sowing := appendActivity(t, j, &Activity{FieldID: "f1", FarmID: "farm1", Kind: KindSowing, ActorID: "u1", PerformedAt: day})
+	irrigation := appendActivity(t, j, &Activity{FieldID: "f1", FarmID: "farm1", Kind: KindIrrigation, ActorID: "u1", PerformedAt: day.Add(24 * time.Hour), Quantity: 25, Unit: "mm"})
+	appendActivity(t, j, &Activity{FieldID: "f2", FarmID: "farm1", Kind: KindSowing, ActorID: "u1", PerformedAt: day})
+
+	// a correction replaces the activity, on the field of the corrected one
+	fixed := appendActivity(t, j, &Activity{FieldID: "f2", Kind: KindSowing, ActorID: "u2", PerformedAt: day.Add(2 * time.Hour), Corrects: sowing})
+	got, err := j.Get(ctx, fixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.FieldID != "f1" || got.FarmID != "farm1" {
+		t.Errorf("correction recorded for field %s of farm %s, want f1 of farm1", got.FieldID, got.FarmID)
+	}
+	if got, _ := j.Get(ctx, sowing); got.CorrectedBy != fixed {
+		t.Errorf("Get().CorrectedBy = %q, want %q", got.CorrectedBy, fixed)
+	}
+
+	ids, _ := list(t, j, Filter{FieldID: "f1"})
+	if want := []string{fixed, irrigation}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+
+	// history stays linear
+	if _, err := j.Append(ctx, &Activity{Kind: KindSowing, PerformedAt: day, Corrects: sowing}); repoerr.KindOf(err) != repoerr.KindConflict {
+		t.Errorf("second correction of %s error = %v, want a conflict", sowing, err)
+	}
+	refixed := appendActivity(t, j, &Activity{Kind: KindSowing, ActorID: "u1", PerformedAt: day.Add(3 * time.Hour), Corrects: fixed})
+
+	// a withdrawal hides the activity and itself, keeping its kind and time
+	void := appendActivity(t, j, &Activity{ActorID: "u1", Void: true, Corrects: irrigation})
+	got, err = j.Get(ctx, void)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Kind != KindIrrigation || !got.PerformedAt.Equal(day.Add(24*time.Hour)) {
+		t.Errorf("withdrawal = %s at %v, want the irrigation's", got.Kind, got.PerformedAt)
+	}
+	if _, err := j.Append(ctx, &Activity{Kind: KindIrrigation, PerformedAt: day, Corrects: void}); repoerr.KindOf(err) != repoerr.KindConflict {
+		t.Errorf("correction of a withdrawal error = %v, want a conflict", err)
+	}
+	if _, err := j.Append(ctx, &Activity{Kind: KindIrrigation, PerformedAt: day, Corrects: uuid.NewString()}); repoerr.KindOf(err) != repoerr.KindNotFound {
+		t.Errorf("correction of a missing activity error = %v, want not found", err)
+	}
+
+	ids, _ = list(t, j, Filter{FieldID: "f1"})
+	if want := []string{refixed}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+	// the withdrawal shares the time of the irrigation, ties go by id
+	withdrawn := []string{irrigation, void}
+	if void < irrigation {
+		withdrawn = []string{void, irrigation}
+	}
+	ids, correctedBy := list(t, j, Filter{FieldID: "f1", History: true})
+	if want := append([]string{sowing, fixed, refixed}, withdrawn...); !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() of the history = %v, want %v", ids, want)
+	}
+	if want := map[string]string{sowing: fixed, fixed: refixed, irrigation: void}; !reflect.DeepEqual(correctedBy, want) {
+		t.Errorf("corrected by %v, want %v", correctedBy, want)
+	}
+
+	ids, _ = list(t, j, Filter{FieldID: "f1", Kind: KindIrrigation, History: true})
+	if want := withdrawn; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() of irrigations = %v, want %v", ids, want)
+	}
+
+	page, err := j.List(ctx, Filter{FieldID: "f1"}, pagination.Query{IncludeTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 1 {
+		t.Errorf("TotalCount = %d, want 1", page.TotalCount)
+	}
+}
+
+func TestCorrectsIsUnique(t *testing.T) {
+	j := newJournal(t)
+	ctx := context.Background()
+	sowing := appendActivity(t, j, &Activity{FieldID: "f1", Kind: KindSowing, PerformedAt: day})
+
+	// as two writers that both passed the check in Append would
+	for i, id := range []string{uuid.NewString(), uuid.NewString()} {
+		err := j.insert(ctx, &Activity{ID: id, FieldID: "f1", Kind: KindSowing, PerformedAt: day, Corrects: sowing})
+		if i == 1 && !mongo.IsDuplicateKeyError(err) {
+			t.Errorf("second correction of %s error = %v, want a duplicate key", sowing, err)
+		}
+	}
+
+	irrigation := appendActivity(t, j, &Activity{FieldID: "f1", Kind: KindIrrigation, PerformedAt: day})
+	const writers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := j.Append(ctx, &Activity{Kind: KindIrrigation, PerformedAt: day, Quantity: 10, Unit: "mm", Corrects: irrigation})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	succeeded := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case repoerr.KindOf(err) != repoerr.KindConflict:
+			t.Errorf("concurrent correction error = %v, want a conflict", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d concurrent corrections succeeded, want 1", succeeded)
+	}
+}
+
+func TestListPages(t *testing.T) {
+	j := newJournal(t)
+	ctx := context.Background()
+
+	// two activities share a time, ordered by id
+	var want []string
+	for i, at := range []time.Duration{0, time.Hour, time.Hour, 2 * time.Hour, 3 * time.Hour} {
+		id := appendActivity(t, j, &Activity{FieldID: "f1", Kind: KindIrrigation, PerformedAt: day.Add(at)})
+		if i == 2 && id < want[1] {
+			want[1], id = id, want[1]
+		}
+		want = append(want, id)
+	}
+	// hidden, the page sizes count only what is listed
+	corrected := appendActivity(t, j, &Activity{FieldID: "f1", Kind: KindIrrigation, PerformedAt: day.Add(90 * time.Minute)})
+	appendActivity(t, j, &Activity{Void: true, Corrects: corrected})
+	appendActivity(t, j, &Activity{FieldID: "f1", Kind: KindIrrigation, PerformedAt: day.Add(-time.Hour)})
+
+	var got []string
+	var sizes []int
+	q := pagination.Query{PageSize: 2, IncludeTotal: true}
+	for {
+		page, err := j.List(ctx, Filter{FieldID: "f1", From: day, To: day.Add(4 * time.Hour)}, q)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if page.TotalCount != 5 {
+			t.Errorf("TotalCount = %d, want 5", page.TotalCount)
+		}
+		sizes = append(sizes, len(page.Items))
+		for _, a := range page.Items {
+			got = append(got, a.ID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		q.PageToken = page.NextPageToken
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paged through %v, want %v", got, want)
+	}
+	if want := []int{2, 2, 1}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("page sizes = %v, want %v", sizes, want)
+	}
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/token"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/credentials"
+	userrepository "github.com/aburifat/go-agro/pkg/backend/services/user_service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type AuthHandler struct {
+	proto.UnimplementedAuthServiceServer
+	db         *gorm.DB
+	issuer     *token.Issuer
+	refreshTTL time.Duration
+	logger     *zap.Logger
+}
+
+func NewAuthHandler(db *gorm.DB, issuer *token.Issuer, refreshTTL time.Duration, logger *zap.Logger) *AuthHandler {
+	authHandler := AuthHandler{
+		db:         db,
+		issuer:     issuer,
+		refreshTTL: refreshTTL,
+		logger:     logger,
+	}
+	return &authHandler
+}
+
+func (h *AuthHandler) Login(ctx context.Context, req *proto.LoginRequest) (*proto.TokenResponse, error) {
+	user, err := credentials.Check(h.db.WithContext(ctx), req.GetLogin(), req.GetPassword())
+	if errors.Is(err, credentials.ErrInvalidCredentials) {
+		logging.WithContext(ctx, h.logger).Info("Login failed", zap.String("login", req.GetLogin()))
+		return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to log in")
+	}
+	plain, hash, err := token.NewRefreshToken()
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to log in")
+	}
+	refresh, err := repository.Create(h.db.WithContext(ctx), user.ID, hash, time.Now().Add(h.refreshTTL))
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to log in")
+	}
+	return h.tokenResponse(user, plain, refresh.ExpiresAt)
+}
+
+func (h *AuthHandler) Refresh(ctx context.Context, req *proto.RefreshRequest) (*proto.TokenResponse, error) {
+	plain, hash, err := token.NewRefreshToken()
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to refresh")
+	}
+	refresh, err := repository.Rotate(h.db.WithContext(ctx), token.HashRefreshToken(req.GetRefreshToken()), hash, time.Now().Add(h.refreshTTL))
+	if errors.Is(err, repository.ErrTokenReused) {
+		// a stolen token or a client retrying; either way the family is revoked
+		logging.WithContext(ctx, h.logger).Warn("Refresh token reused, revoked its token family")
+	}
+	if err != nil {
+		return nil, refreshError(err)
+	}
+	user, err := userrepository.GetById(h.db.WithContext(ctx), refresh.UserID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to refresh")
+	}
+	return h.tokenResponse(user, plain, refresh.ExpiresAt)
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	refresh, err := repository.GetByHash(h.db.WithContext(ctx), token.HashRefreshToken(req.GetRefreshToken()))
+	if err != nil {
+		return nil, refreshError(err)
+	}
+	if err := repository.RevokeFamily(h.db.WithContext(ctx), refresh.FamilyID); err != nil {
+		return nil, grpcerr.FromError(err, "failed to log out")
+	}
+	return &proto.LogoutResponse{
+		Message: "Logged out successfully",
+	}, nil
+}
+
+func (h *AuthHandler) LogoutAll(ctx context.Context, req *proto.LogoutAllRequest) (*proto.LogoutResponse, error) {
+	refresh, err := repository.GetByHash(h.db.WithContext(ctx), token.HashRefreshToken(req.GetRefreshToken()))
+	if err != nil {
+		return nil, refreshError(err)
+	}
+	if refresh.RevokedAt != nil {
+		return nil, refreshError(repository.ErrTokenInvalid)
+	}
+	if err := repository.RevokeUser(h.db.WithContext(ctx), refresh.UserID); err != nil {
+		return nil, grpcerr.FromError(err, "failed to log out")
+	}
+	logging.WithContext(ctx, h.logger).Info("Revoked all sessions", zap.String("user_id", refresh.UserID))
+	return &proto.LogoutResponse{
+		Message: "Logged out of all sessions successfully",
+	}, nil
+}
+
+func (h *AuthHandler) tokenResponse(user *api.User, refreshToken string, refreshExpiresAt time.Time) (*proto.TokenResponse, error) {
+	var roles []string
+	if user.Admin {
+		roles = append(roles, auth.RoleAdmin)
+	}
+	access, accessExpiresAt, err := h.issuer.Issue(user.ID, roles)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to issue access token")
+	}
+	return &proto.TokenResponse{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  accessExpiresAt.Unix(),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt.Unix(),
+		TokenType:             "Bearer",
+	}, nil
+}
+
+func refreshError(err error) error {
+	if errors.Is(err, repository.ErrTokenInvalid) || errors.Is(err, repository.ErrTokenReused) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return grpcerr.FromError(err, "failed to process refresh token")
+}
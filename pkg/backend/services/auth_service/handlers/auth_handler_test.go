@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/password"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/token"
+	userrepository "github.com/aburifat/go-agro/pkg/backend/services/user_service/repository"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// startServer serves AuthService over a loopback connection, behind the
+// same authentication interceptor as in production.
+func startServer(t *testing.T, db *gorm.DB) proto.AuthServiceClient {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := token.NewIssuer(key, time.Minute)
+	authenticate := func(ctx context.Context, raw string) (*auth.Principal, error) {
+		claims, err := issuer.Verify(raw)
+		if err != nil {
+			return nil, err
+		}
+		return &auth.Principal{UserID: claims.Subject, Roles: claims.Roles}, nil
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(authenticate, Policy)))
+	proto.RegisterAuthServiceServer(server, NewAuthHandler(db, issuer, time.Hour, zap.NewNop()))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///"+lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewAuthServiceClient(conn)
+}
+
+func createUser(t *testing.T, db *gorm.DB, username string) {
+	t.Helper()
+	hash, err := password.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userrepository.Create(db, &api.User{Username: username, Email: username + "@example.com", Password: hash}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func login(t *testing.T, client proto.AuthServiceClient, username string) string {
+	t.Helper()
+	resp, err := client.Login(context.Background(), &proto.LoginRequest{Login: username, Password: "secret"})
+	if err != nil {
+		t.Fatalf("Login(%s) error = %v", username, err)
+	}
+	return resp.GetRefreshToken()
+}
+
+// refresh rotates refreshToken, returning its successor and the status code.
+func refresh(client proto.AuthServiceClient, refreshToken string) (string, codes.Code) {
+	resp, err := client.Refresh(context.Background(), &proto.RefreshRequest{RefreshToken: refreshToken})
+	return resp.GetRefreshToken(), status.Code(err)
+}
+
+func TestLogoutAll(t *testing.T) {
+	db := dbtest.Postgres(t)
+	client := startServer(t, db)
+	createUser(t, db, "alice")
+	createUser(t, db, "bob")
+
+	phone := login(t, client, "alice")
+	laptop := login(t, client, "alice")
+	// a session rotated before the logout is revoked with the others
+	laptop, code := refresh(client, laptop)
+	if code != codes.OK {
+		t.Fatalf("Refresh() code = %v", code)
+	}
+	other := login(t, client, "bob")
+
+	if _, err := client.LogoutAll(context.Background(), &proto.LogoutAllRequest{RefreshToken: phone}); err != nil {
+		t.Fatalf("LogoutAll() error = %v", err)
+	}
+
+	for name, session := range map[string]string{"phone": phone, "laptop": laptop} {
+		if _, code := refresh(client, session); code != codes.Unauthenticated {
+			t.Errorf("Refresh(%s) code = %v, want %v", name, code, codes.Unauthenticated)
+		}
+	}
+	if _, code := refresh(client, other); code != codes.OK {
+		t.Errorf("Refresh() of another user's session code = %v, want %v", code, codes.OK)
+	}
+	// a revoked token cannot log out again
+	_, err := client.LogoutAll(context.Background(), &proto.LogoutAllRequest{RefreshToken: laptop})
+	if code := status.Code(err); code != codes.Unauthenticated {
+		t.Errorf("LogoutAll() with a revoked token code = %v, want %v", code, codes.Unauthenticated)
+	}
+	// nor can an unknown one
+	_, err = client.LogoutAll(context.Background(), &proto.LogoutAllRequest{RefreshToken: "unknown"})
+	if code := status.Code(err); code != codes.Unauthenticated {
+		t.Errorf("LogoutAll() with an unknown token code = %v, want %v", code, codes.Unauthenticated)
+	}
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	db := dbtest.Postgres(t)
+	client := startServer(t, db)
+	createUser(t, db, "alice")
+
+	first := login(t, client, "alice")
+	second, code := refresh(client, first)
+	if code != codes.OK {
+		t.Fatalf("Refresh() code = %v", code)
+	}
+	untouched := login(t, client, "alice")
+
+	if _, code := refresh(client, first); code != codes.Unauthenticated {
+		t.Fatalf("Refresh() of a used token code = %v, want %v", code, codes.Unauthenticated)
+	}
+	// the successor belongs to the revoked family
+	if _, code := refresh(client, second); code != codes.Unauthenticated {
+		t.Errorf("Refresh() of the successor code = %v, want %v", code, codes.Unauthenticated)
+	}
+	// presenting the used token again is not reported as another reuse
+	if _, code := refresh(client, first); code != codes.Unauthenticated {
+		t.Errorf("Refresh() of a used token code = %v, want %v", code, codes.Unauthenticated)
+	}
+	if _, code := refresh(client, untouched); code != codes.OK {
+		t.Errorf("Refresh() of another family code = %v, want %v", code, codes.OK)
+	}
+}
+
+func TestLogoutRevokesFamily(t *testing.T) {
+	db := dbtest.Postgres(t)
+	client := startServer(t, db)
+	createUser(t, db, "alice")
+
+	first := login(t, client, "alice")
+	second, code := refresh(client, first)
+	if code != codes.OK {
+		t.Fatalf("Refresh() code = %v", code)
+	}
+	untouched := login(t, client, "alice")
+
+	// logging out with a used token of the family ends the session too
+	if _, err := client.Logout(context.Background(), &proto.LogoutRequest{RefreshToken: first}); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+	if _, code := refresh(client, second); code != codes.Unauthenticated {
+		t.Errorf("Refresh() after Logout() code = %v, want %v", code, codes.Unauthenticated)
+	}
+	if _, code := refresh(client, untouched); code != codes.OK {
+		t.Errorf("Refresh() of another family code = %v, want %v", code, codes.OK)
+	}
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/proto"
+)
+
+// Policy is the access policy of AuthService. Every method authenticates
+// with credentials or a refresh token carried in the request itself.
+var Policy = auth.Policy{
+	proto.AuthService_Login_FullMethodName:     auth.Public,
+	proto.AuthService_Refresh_FullMethodName:   auth.Public,
+	proto.AuthService_Logout_FullMethodName:    auth.Public,
+	proto.AuthService_LogoutAll_FullMethodName: auth.Public,
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTokenInvalid = errors.New("refresh token is invalid or expired")
+	ErrTokenReused  = errors.New("refresh token reuse detected")
+)
+
+// Create stores the first token of a new family for userID.
+func Create(db *gorm.DB, userID, tokenHash string, expiresAt time.Time) (*api.RefreshToken, error) {
+	token := &api.RefreshToken{
+		UserID:    userID,
+		FamilyID:  uuid.NewString(),
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+	if err := db.Create(token).Error; err != nil {
+		return nil, fmt.Errorf("failed to insert refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// Rotate consumes the token identified by tokenHash and stores its successor
+// in the same family. Presenting an already used token revokes the family,
+// since either the legitimate client or an attacker holds a stolen copy.
+func Rotate(db *gorm.DB, tokenHash, nextHash string, expiresAt time.Time) (*api.RefreshToken, error) {
+	var next *api.RefreshToken
+	var reused bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var current api.RefreshToken
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "token_hash = ?", tokenHash)
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ErrTokenInvalid
+		}
+		if result.Error != nil {
+			return fmt.Errorf("failed to fetch refresh token: %w", result.Error)
+		}
+		now := time.Now()
+		switch err := checkRotation(&current, now); {
+		case errors.Is(err, ErrTokenReused):
+			reused = true
+			return revokeWhere(tx, "family_id = ?", current.FamilyID)
+		case err != nil:
+			return err
+		}
+		if err := tx.Model(&current).Update("used_at", now).Error; err != nil {
+			return fmt.Errorf("failed to consume refresh token: %w", err)
+		}
+		next = &api.RefreshToken{
+			UserID:    current.UserID,
+			FamilyID:  current.FamilyID,
+			TokenHash: nextHash,
+			ExpiresAt: expiresAt,
+		}
+		if err := tx.Create(next).Error; err != nil {
+			return fmt.Errorf("failed to insert refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the family revocation has to commit, so the error is reported afterwards
+	if reused {
+		return nil, ErrTokenReused
+	}
+	return next, nil
+}
+
+// checkRotation tells whether current may be rotated at now: revoked and
+// expired tokens are invalid, used ones are reused.
+func checkRotation(current *api.RefreshToken, now time.Time) error {
+	if current.RevokedAt != nil || now.After(current.ExpiresAt) {
+		return ErrTokenInvalid
+	}
+	if current.UsedAt != nil {
+		return ErrTokenReused
+	}
+	return nil
+}
+
+func GetByHash(db *gorm.DB, tokenHash string) (*api.RefreshToken, error) {
+	var token api.RefreshToken
+	result := db.First(&token, "token_hash = ?", tokenHash)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrTokenInvalid
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to fetch refresh token: %w", result.Error)
+	}
+	return &token, nil
+}
+
+func RevokeFamily(db *gorm.DB, familyID string) error {
+	return revokeWhere(db, "family_id = ?", familyID)
+}
+
+func RevokeUser(db *gorm.DB, userID string) error {
+	return revokeWhere(db, "user_id = ?", userID)
+}
+
+func revokeWhere(db *gorm.DB, query string, args ...interface{}) error {
+	result := db.Model(&api.RefreshToken{}).
+		Where(query, args...).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+)
+
+func TestCheckRotation(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		token api.RefreshToken
+		want  error
+	}{
+		{"fresh", api.RefreshToken{ExpiresAt: now.Add(time.Hour)}, nil},
+		{"expires now", api.RefreshToken{ExpiresAt: now}, nil},
+		{"expired", api.RefreshToken{ExpiresAt: earlier}, ErrTokenInvalid},
+		{"revoked", api.RefreshToken{ExpiresAt: now.Add(time.Hour), RevokedAt: &earlier}, ErrTokenInvalid},
+		{"used", api.RefreshToken{ExpiresAt: now.Add(time.Hour), UsedAt: &earlier}, ErrTokenReused},
+		// a revoked family stays revoked, reuse of its tokens is not reported again
+		{"used and revoked", api.RefreshToken{ExpiresAt: now.Add(time.Hour), UsedAt: &earlier, RevokedAt: &earlier}, ErrTokenInvalid},
+		{"used and expired", api.RefreshToken{ExpiresAt: earlier, UsedAt: &earlier}, ErrTokenInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkRotation(&tt.token, now); !errors.Is(err, tt.want) {
+				t.Errorf("checkRotation() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const issuerName = "go-agro"
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Issuer signs access tokens with an Ed25519 key. Tokens carry the key id in
+// their header so that tokens signed by a retired key can still be verified
+// while it is listed in the verification keys.
+type Issuer struct {
+	kid  string
+	key  ed25519.PrivateKey
+	keys map[string]ed25519.PublicKey
+	ttl  time.Duration
+}
+
+func NewIssuer(key ed25519.PrivateKey, ttl time.Duration, verificationKeys ...ed25519.PublicKey) *Issuer {
+	pub := key.Public().(ed25519.PublicKey)
+	i := &Issuer{
+		kid:  keyID(pub),
+		key:  key,
+		keys: map[string]ed25519.PublicKey{keyID(pub): pub},
+		ttl:  ttl,
+	}
+	for _, k := range verificationKeys {
+		i.keys[keyID(k)] = k
+	}
+	return i
+}
+
+func (i *Issuer) Issue(userID string, roles []string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(i.ttl)
+	t := jwt.NewWithClaims(jwt.SigningMethodEdDSA, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuerName,
+			Subject:   userID,
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Roles: roles,
+	})
+	t.Header["kid"] = i.kid
+	signed, err := t.SignedString(i.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+func (i *Issuer) Verify(raw string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := i.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(issuerName),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// LoadSigningKey reads a PKCS#8 PEM encoded Ed25519 private key, as produced
+// by `openssl genpkey -algorithm ed25519`.
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	key, err := readPEM(path, func(der []byte) (any, error) { return x509.ParsePKCS8PrivateKey(der) })
+	if err != nil {
+		return nil, err
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ed25519 private key", path)
+	}
+	return priv, nil
+}
+
+// LoadVerificationKey reads a PKIX PEM encoded Ed25519 public key, as
+// produced by `openssl pkey -pubout`.
+func LoadVerificationKey(path string) (ed25519.PublicKey, error) {
+	key, err := readPEM(path, x509.ParsePKIXPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ed25519 public key", path)
+	}
+	return pub, nil
+}
+
+func readPEM(path string, parse func([]byte) (any, error)) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New(path + ": no PEM block found")
+	}
+	key, err := parse(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// NewRefreshToken returns an opaque random refresh token and the hash under
+// which it is stored. Only the hash is ever persisted.
+func NewRefreshToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	plain := base64.RawURLEncoding.EncodeToString(b)
+	return plain, HashRefreshToken(plain), nil
+}
+
+func HashRefreshToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+func keyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
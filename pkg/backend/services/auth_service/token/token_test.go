@@ -0,0 +1,88 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestIssuerVerify(t *testing.T) {
+	current, retired, other := newKey(t), newKey(t), newKey(t)
+	verifier := NewIssuer(current, time.Hour, retired.Public().(ed25519.PublicKey))
+
+	tests := []struct {
+		name    string
+		issuer  *Issuer
+		wantErr bool
+	}{
+		{"current key", NewIssuer(current, time.Hour), false},
+		{"retired key still listed", NewIssuer(retired, time.Hour), false},
+		{"unknown key", NewIssuer(other, time.Hour), true},
+		{"expired", NewIssuer(current, -time.Minute), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, expiresAt, err := tt.issuer.Issue("user-1", []string{"admin"})
+			if err != nil {
+				t.Fatalf("Issue() error = %v", err)
+			}
+			claims, err := verifier.Verify(raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if claims.Subject != "user-1" || !slices.Equal(claims.Roles, []string{"admin"}) {
+				t.Errorf("Verify() claims = %+v", claims)
+			}
+			if !claims.ExpiresAt.Time.Equal(expiresAt.Truncate(time.Second)) {
+				t.Errorf("Verify() expires at %v, want %v", claims.ExpiresAt.Time, expiresAt)
+			}
+		})
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	issuer := NewIssuer(newKey(t), time.Hour)
+	raw, _, err := issuer.Issue("user-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := []byte(raw)
+	tampered[len(tampered)-2] ^= 1
+	if _, err := issuer.Verify(string(tampered)); err == nil {
+		t.Error("Verify() accepted a tampered signature")
+	}
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	plain, hash, err := NewRefreshToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != HashRefreshToken(plain) {
+		t.Errorf("hash %q is not the hash of the token", hash)
+	}
+	if hash == plain {
+		t.Error("the token is stored in plain")
+	}
+	next, _, err := NewRefreshToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next == plain {
+		t.Error("NewRefreshToken() returned the same token twice")
+	}
+}
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/rules"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+	farmrepository "github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var errNoEngine = status.Error(codes.FailedPrecondition, "compliance reports require MongoDB to be configured")
+
+type ComplianceHandler struct {
+	proto.UnimplementedComplianceServiceServer
+	db     *gorm.DB
+	engine *rules.Engine
+	logger *zap.Logger
+}
+
+// NewComplianceHandler returns the ComplianceService handler. engine is nil
+// when MongoDB is not configured, reports then fail with FailedPrecondition.
+func NewComplianceHandler(db *gorm.DB, engine *rules.Engine, logger *zap.Logger) *ComplianceHandler {
+	complianceHandler := ComplianceHandler{
+		db:     db,
+		engine: engine,
+		logger: logger,
+	}
+	return &complianceHandler
+}
+
+func (h *ComplianceHandler) CreateProduct(ctx context.Context, req *proto.CreateProductRequest) (*proto.CreateProductResponse, error) {
+	if req.GetMaxDosePerHectare() > 0 && req.GetDoseUnit() == "" {
+		return nil, grpcerr.InvalidArgument("doseUnit", "doseUnit is required with maxDosePerHectare")
+	}
+	product := &api.PesticideProduct{
+		Name:                     req.GetName(),
+		RegistrationNumber:       req.GetRegistrationNumber(),
+		ActiveIngredient:         req.GetActiveIngredient(),
+		PreHarvestIntervalDays:   int(req.GetPreHarvestIntervalDays()),
+		ReEntryIntervalHours:     int(req.GetReEntryIntervalHours()),
+		MaxApplicationsPerSeason: int(req.GetMaxApplicationsPerSeason()),
+		MaxDosePerHectare:        req.GetMaxDosePerHectare(),
+		DoseUnit:                 req.GetDoseUnit(),
+	}
+
+	id, err := repository.CreateProduct(h.db.WithContext(ctx), product)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create pesticide product")
+	}
+	logging.WithContext(ctx, h.logger).Info("Pesticide product created", zap.String("product_id", id))
+
+	return &proto.CreateProductResponse{
+		Id:      id,
+		Message: "Pesticide product created successfully",
+	}, nil
+}
+
+func (h *ComplianceHandler) GetProduct(ctx context.Context, req *proto.GetProductRequest) (*proto.GetProductResponse, error) {
+	product, err := repository.GetProduct(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get pesticide product")
+	}
+	return &proto.GetProductResponse{Product: productToProto(product)}, nil
+}
+
+func (h *ComplianceHandler) ListProducts(ctx context.Context, req *proto.ListProductsRequest) (*proto.ListProductsResponse, error) {
+	page, err := repository.ListProducts(h.db.WithContext(ctx), req.GetNamePrefix(), pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list pesticide products")
+	}
+
+	var productList []*proto.Product
+	for _, p := range page.Items {
+		productList = append(productList, productToProto(p))
+	}
+	return &proto.ListProductsResponse{
+		Products:      productList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *ComplianceHandler) UpdateProduct(ctx context.Context, req *proto.UpdateProductRequest) (*proto.UpdateProductResponse, error) {
+	var product api.PesticideProduct
+	var columns []string
+	if req.Name != nil {
+		product.Name = req.GetName()
+		columns = append(columns, "name")
+	}
+	if req.RegistrationNumber != nil {
+		product.RegistrationNumber = req.GetRegistrationNumber()
+		columns = append(columns, "registration_number")
+	}
+	if req.ActiveIngredient != nil {
+		product.ActiveIngredient = req.GetActiveIngredient()
+		columns = append(columns, "active_ingredient")
+	}
+	if req.PreHarvestIntervalDays != nil {
+		product.PreHarvestIntervalDays = int(req.GetPreHarvestIntervalDays())
+		columns = append(columns, "pre_harvest_interval_days")
+	}
+	if req.ReEntryIntervalHours != nil {
+		product.ReEntryIntervalHours = int(req.GetReEntryIntervalHours())
+		columns = append(columns, "re_entry_interval_hours")
+	}
+	if req.MaxApplicationsPerSeason != nil {
+		product.MaxApplicationsPerSeason = int(req.GetMaxApplicationsPerSeason())
+		columns = append(columns, "max_applications_per_season")
+	}
+	if req.MaxDosePerHectare != nil {
+		product.MaxDosePerHectare = req.GetMaxDosePerHectare()
+		columns = append(columns, "max_dose_per_hectare")
+	}
+	if req.DoseUnit != nil {
+		product.DoseUnit = req.GetDoseUnit()
+		columns = append(columns, "dose_unit")
+	}
+	if len(columns) == 0 {
+		return nil, grpcerr.InvalidArgument("name", "no changes given")
+	}
+
+	// a dose limit without a unit is rejected by the table's check
+	if err := repository.UpdateProduct(h.db.WithContext(ctx), req.GetId(), &product, columns); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update pesticide product")
+	}
+	logging.WithContext(ctx, h.logger).Info("Pesticide product updated", zap.String("product_id", req.GetId()))
+
+	return &proto.UpdateProductResponse{
+		Message: "Pesticide product updated successfully",
+	}, nil
+}
+
+func (h *ComplianceHandler) GetFieldComplianceReport(ctx context.Context, req *proto.GetFieldComplianceReportRequest) (*proto.GetFieldComplianceReportResponse, error) {
+	if h.engine == nil {
+		return nil, errNoEngine
+	}
+	now := time.Now()
+	to := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	var err error
+	if req.GetTo() != "" {
+		if to, err = time.Parse(croprepository.DateLayout, req.GetTo()); err != nil {
+			return nil, grpcerr.InvalidArgument("to", "invalid date: "+req.GetTo())
+		}
+	}
+	from := to.AddDate(-1, 0, 0)
+	if req.GetFrom() != "" {
+		if from, err = time.Parse(croprepository.DateLayout, req.GetFrom()); err != nil {
+			return nil, grpcerr.InvalidArgument("from", "invalid date: "+req.GetFrom())
+		}
+	}
+
+	field, err := farmrepository.GetField(h.db.WithContext(ctx), req.GetFieldId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get field")
+	}
+	report, err := h.engine.Report(ctx, field, from, to, now)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to build compliance report")
+	}
+	logging.WithContext(ctx, h.logger).Info("Compliance report generated", zap.String("field_id", field.ID),
+		zap.Int("findings", len(report.Findings)))
+
+	resp := &proto.GetFieldComplianceReportResponse{Report: reportToProto(report)}
+	if req.GetCsv() {
+		if resp.Csv, err = reportCSV(report); err != nil {
+			return nil, grpcerr.FromError(err, "failed to write compliance report")
+		}
+	}
+	return resp, nil
+}
+
+func productToProto(p *api.PesticideProduct) *proto.Product {
+	return &proto.Product{
+		Id:                       p.ID,
+		Name:                     p.Name,
+		RegistrationNumber:       p.RegistrationNumber,
+		ActiveIngredient:         p.ActiveIngredient,
+		PreHarvestIntervalDays:   int32(p.PreHarvestIntervalDays),
+		ReEntryIntervalHours:     int32(p.ReEntryIntervalHours),
+		MaxApplicationsPerSeason: int32(p.MaxApplicationsPerSeason),
+		MaxDosePerHectare:        p.MaxDosePerHectare,
+		DoseUnit:                 p.DoseUnit,
+	}
+}
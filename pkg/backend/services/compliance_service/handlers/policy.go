@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/proto"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of ComplianceService. The product registry is
+// shared and maintained by admins; compliance reports need report.read on
+// the farm owning the field.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	field := farmhandlers.FieldResource(db, func(req any) string {
+		return req.(interface{ GetFieldId() string }).GetFieldId()
+	})
+
+	return auth.Policy{
+		proto.ComplianceService_CreateProduct_FullMethodName:            auth.Admin,
+		proto.ComplianceService_GetProduct_FullMethodName:               auth.Authenticated,
+		proto.ComplianceService_ListProducts_FullMethodName:             auth.Authenticated,
+		proto.ComplianceService_UpdateProduct_FullMethodName:            auth.Admin,
+		proto.ComplianceService_GetFieldComplianceReport_FullMethodName: checker.RequireLookup("report.read", field),
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/rules"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+)
+
+func reportToProto(r *rules.Report) *proto.ComplianceReport {
+	report := &proto.ComplianceReport{
+		FieldId:     r.Field.ID,
+		FarmId:      r.Field.FarmID,
+		From:        r.From.Format(croprepository.DateLayout),
+		To:          r.To.Format(croprepository.DateLayout),
+		GeneratedAt: r.GeneratedAt.Format(time.RFC3339),
+		Compliant:   r.Compliant(),
+	}
+	for _, a := range r.Applications {
+		report.Applications = append(report.Applications, &proto.Application{
+			ActivityId:         a.Activity.ID,
+			PerformedAt:        a.Activity.PerformedAt.UTC().Format(time.RFC3339),
+			ProductId:          a.Product.ID,
+			ProductName:        a.Product.Name,
+			RegistrationNumber: a.Product.RegistrationNumber,
+			Quantity:           a.Quantity,
+			Unit:               a.Unit,
+			DosePerHectare:     a.DosePerHectare,
+			Number:             int32(a.Number),
+			ReEntryAt:          formatTime(a.ReEntryAt, time.RFC3339),
+			SafeHarvestDate:    formatTime(a.SafeHarvest, croprepository.DateLayout),
+		})
+	}
+	for _, f := range r.Findings {
+		report.Findings = append(report.Findings, &proto.Finding{
+			ActivityId:   f.Activity.ID,
+			ActivityKind: f.Activity.Kind,
+			PerformedAt:  f.Activity.PerformedAt.UTC().Format(time.RFC3339),
+			Violation: &proto.Violation{
+				Rule:        f.Rule,
+				Subject:     f.Subject,
+				Description: f.Description,
+				Advisory:    f.Advisory,
+			},
+		})
+	}
+	return report
+}
+
+var csvHeader = []string{
+	"performed_at", "activity_id", "activity_kind", "product", "registration_number", "quantity", "unit",
+	"dose_per_ha", "application", "re_entry_at", "safe_harvest_date", "rule", "subject", "advisory", "description",
+}
+
+// reportCSV writes r with a row per application, followed by a row per
+// finding, each in the order of the activities.
+func reportCSV(r *rules.Report) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{csvHeader}
+	for _, a := range r.Applications {
+		rows = append(rows, []string{
+			a.Activity.PerformedAt.UTC().Format(time.RFC3339), a.Activity.ID, a.Activity.Kind,
+			a.Product.Name, a.Product.RegistrationNumber,
+			strconv.FormatFloat(a.Quantity, 'f', -1, 64), a.Unit,
+			strconv.FormatFloat(a.DosePerHectare, 'f', 3, 64), strconv.Itoa(a.Number),
+			formatTime(a.ReEntryAt, time.RFC3339), formatTime(a.SafeHarvest, croprepository.DateLayout),
+			"", "", "", "",
+		})
+	}
+	for _, f := range r.Findings {
+		rows = append(rows, []string{
+			f.Activity.PerformedAt.UTC().Format(time.RFC3339), f.Activity.ID, f.Activity.Kind,
+			"", "", "", "", "", "", "", "",
+			f.Rule, f.Subject, strconv.FormatBool(f.Advisory), f.Description,
+		})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(layout)
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"errors"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func CreateProduct(db *gorm.DB, product *api.PesticideProduct) (string, error) {
+	if err := db.Create(product).Error; err != nil {
+		return "", repoerr.FromGorm(err, "failed to insert pesticide product")
+	}
+	return product.ID, nil
+}
+
+func GetProduct(db *gorm.DB, id string) (*api.PesticideProduct, error) {
+	if err := validateID("id", id); err != nil {
+		return nil, err
+	}
+	var product api.PesticideProduct
+	result := db.First(&product, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no pesticide product found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch pesticide product")
+	}
+	return &product, nil
+}
+
+// GetProducts returns the products with the given ids by id. field names
+// the request field the ids come from when one of them is unknown.
+func GetProducts(db *gorm.DB, field string, ids []string) (map[string]*api.PesticideProduct, error) {
+	for _, id := range ids {
+		if err := validateID(field, id); err != nil {
+			return nil, err
+		}
+	}
+	products := make(map[string]*api.PesticideProduct, len(ids))
+	if len(ids) == 0 {
+		return products, nil
+	}
+	var productList []api.PesticideProduct
+	if err := db.Where("id IN ?", ids).Find(&productList).Error; err != nil {
+		return nil, repoerr.FromGorm(err, "failed to fetch pesticide products")
+	}
+	for i := range productList {
+		products[productList[i].ID] = &productList[i]
+	}
+	for _, id := range ids {
+		if products[id] == nil {
+			return nil, repoerr.NotFound(field, "no pesticide product found with ID: %s", id)
+		}
+	}
+	return products, nil
+}
+
+// ListProducts returns the products whose name starts with namePrefix,
+// ordered by name.
+func ListProducts(db *gorm.DB, namePrefix string, q pagination.Query) (*pagination.Page[api.PesticideProduct], error) {
+	q.Sort = pagination.Sort{Column: "name"}
+	tx := db.Model(&api.PesticideProduct{})
+	if namePrefix != "" {
+		tx = tx.Where("name ILIKE ?", pagination.EscapeLike(namePrefix)+"%")
+	}
+	return pagination.List(tx, q, func(p *api.PesticideProduct) (string, string) {
+		return p.Name, p.ID
+	})
+}
+
+// UpdateProduct writes the given columns of product to the product with the
+// given id, including zero values.
+func UpdateProduct(db *gorm.DB, id string, product *api.PesticideProduct, columns []string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	result := db.Model(&api.PesticideProduct{}).Where("id = ?", id).Select(columns).Updates(product)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to update pesticide product")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no pesticide product found with ID: %s", id)
+	}
+	return nil
+}
+
+func validateID(field, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return repoerr.InvalidArgument(field, "invalid uid format: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"context"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+)
+
+// MaxReportDays bounds the period of a compliance report.
+const MaxReportDays = 366
+
+// Report is the compliance of the activities recorded on a field from From
+// to To, checked against the registry as it is when the report is made.
+type Report struct {
+	Field       *api.Field
+	From, To    time.Time
+	GeneratedAt time.Time
+	// Applications are the registered products sprayed, in the order they
+	// were applied.
+	Applications []Application
+	Findings     []Finding
+}
+
+// Finding is a rule broken by Activity.
+type Finding struct {
+	Activity *journal.Activity
+	Violation
+}
+
+// Compliant reports whether no activity broke a rule; advisory findings do
+// not count.
+func (r *Report) Compliant() bool {
+	for _, f := range r.Findings {
+		if !f.Advisory {
+			return false
+		}
+	}
+	return true
+}
+
+// Report checks every activity recorded on field from from to to, as
+// corrected. The sprayings, products and plantings the activities are
+// checked against are loaded once for the whole period.
+func (e *Engine) Report(ctx context.Context, field *api.Field, from, to, now time.Time) (*Report, error) {
+	if !to.After(from) {
+		return nil, repoerr.InvalidArgument("to", "to must be after from")
+	}
+	if to.Sub(from) > MaxReportDays*24*time.Hour {
+		return nil, repoerr.InvalidArgument("to", "a report covers at most %d days", MaxReportDays)
+	}
+
+	report := &Report{
+		Field:       field,
+		From:        from,
+		To:          to,
+		GeneratedAt: now.UTC(),
+	}
+	h, err := e.loadHistory(ctx, field, from, to, map[string]*api.PesticideProduct{})
+	if err != nil {
+		return nil, err
+	}
+	q := pagination.Query{PageSize: pagination.MaxPageSize}
+	for {
+		page, err := e.journal.List(ctx, journal.Filter{FieldID: field.ID, From: from, To: to}, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, activity := range page.Items {
+			a := assess(activity, field, h)
+			report.Applications = append(report.Applications, a.applications...)
+			for _, v := range a.violations {
+				report.Findings = append(report.Findings, Finding{Activity: activity, Violation: v})
+			}
+		}
+		if page.NextPageToken == "" {
+			return report, nil
+		}
+		q.PageToken = page.NextPageToken
+	}
+}
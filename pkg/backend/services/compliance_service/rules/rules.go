@@ -0,0 +1,496 @@
+// Package rules checks field activities against the pesticide registry: the
+// pre-harvest and re-entry intervals of the products sprayed on a field,
+// their maximum number of applications per season and their maximum dose
+// per hectare. Sprayings are read from the activity journal.
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/repository"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	RulePreHarvestInterval = "pre_harvest_interval"
+	RuleReEntryInterval    = "re_entry_interval"
+	RuleMaxApplications    = "max_applications"
+	RuleMaxDose            = "max_dose"
+	// advisory, the use of the input could not be checked
+	RuleUnregisteredProduct = "unregistered_product"
+	RuleDoseUnit            = "dose_unit"
+)
+
+const (
+	// EnforcementBlock rejects activities breaking a rule.
+	EnforcementBlock = "block"
+	// EnforcementWarn records them and returns the violations as warnings.
+	EnforcementWarn = "warn"
+)
+
+// MaxPreHarvestIntervalDays and MaxReEntryIntervalHours bound the intervals
+// of registered products, and so how far back sprayings are looked up.
+const (
+	MaxPreHarvestIntervalDays = 365
+	MaxReEntryIntervalHours   = 720
+)
+
+// fieldLockSpace is the first key of the advisory locks Record takes on
+// fields, the second key is a hash of the field id.
+const fieldLockSpace = 25
+
+// Violation is a rule an activity breaks. Subject names what the rule was
+// broken for: "products/{id}" for the limits of a sprayed product and
+// "activities/{id}" for the intervals of an earlier spraying.
+type Violation struct {
+	Rule        string
+	Subject     string
+	Description string
+	// Advisory violations note what could not be checked and never block.
+	Advisory bool
+}
+
+// Application is a registered product sprayed by Activity.
+type Application struct {
+	Activity *journal.Activity
+	Product  *api.PesticideProduct
+	Quantity float64
+	Unit     string
+	// DosePerHectare is 0 unless Unit is the product's dose unit.
+	DosePerHectare float64
+	// Number counts the sprayings of the product in the season up to and
+	// including this one.
+	Number int
+	// ReEntryAt is when the field may be entered again and SafeHarvest the
+	// first day the crop may be harvested, zero without such an interval.
+	ReEntryAt   time.Time
+	SafeHarvest time.Time
+}
+
+// Engine checks activities against the rules of the registered products.
+type Engine struct {
+	db          *gorm.DB
+	journal     *journal.Journal
+	enforcement string
+}
+
+func NewEngine(db *gorm.DB, j *journal.Journal, enforcement string) *Engine {
+	engine := Engine{
+		db:          db,
+		journal:     j,
+		enforcement: enforcement,
+	}
+	return &engine
+}
+
+// Blocks reports whether violations keep an activity from being recorded,
+// which any violation that is not advisory does under EnforcementBlock.
+func (e *Engine) Blocks(violations []Violation) bool {
+	if e.enforcement != EnforcementBlock {
+		return false
+	}
+	for _, v := range violations {
+		if !v.Advisory {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns the rules activity, recorded or about to be recorded on
+// field, breaks given the other activities of the journal. The activity a
+// correction replaces is left out.
+func (e *Engine) Check(ctx context.Context, activity *journal.Activity, field *api.Field) ([]Violation, error) {
+	products, err := repository.GetProducts(e.db.WithContext(ctx), "inputs", productIDs(activity))
+	if err != nil {
+		return nil, err
+	}
+	h, err := e.loadHistory(ctx, field, activity.PerformedAt, activity.PerformedAt, products)
+	if err != nil {
+		return nil, err
+	}
+	return assess(activity, field, h).violations, nil
+}
+
+// BlockedError is returned by Record for an activity whose violations block
+// it. It converts to a FailedPrecondition status carrying the violations as
+// a PreconditionFailure.
+type BlockedError struct {
+	Violations []Violation
+}
+
+func (e *BlockedError) Error() string {
+	return "activity breaks the conditions of use of pesticide products"
+}
+
+func (e *BlockedError) GRPCStatus() *status.Status {
+	var details []*errdetails.PreconditionFailure_Violation
+	for _, v := range e.Violations {
+		details = append(details, &errdetails.PreconditionFailure_Violation{
+			Type:        v.Rule,
+			Subject:     v.Subject,
+			Description: v.Description,
+		})
+	}
+	return status.Convert(grpcerr.FailedPrecondition(e.Error(), details...))
+}
+
+// Record checks activity like Check and calls record to append it unless the
+// violations block it, in which case it returns a *BlockedError. Activities
+// recorded on the same field are serialised by a Postgres advisory lock held
+// from the check until record returns, so that each is checked against the
+// others, e.g. for the number of applications in the season.
+func (e *Engine) Record(ctx context.Context, activity *journal.Activity, field *api.Field, record func() error) ([]Violation, error) {
+	var violations []Violation
+	err := e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", fieldLockSpace, field.ID).Error; err != nil {
+			return repoerr.FromGorm(err, "failed to lock field")
+		}
+		var err error
+		if violations, err = e.Check(ctx, activity, field); err != nil {
+			return err
+		}
+		if e.Blocks(violations) {
+			return &BlockedError{Violations: violations}
+		}
+		return record()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+type assessment struct {
+	violations   []Violation
+	applications []Application
+}
+
+// history is what the activities of a field in a period are checked
+// against, loaded at once: the field's sprayings as corrected, the
+// registered products they use and its plantings.
+type history struct {
+	// sprayings are ordered by the time they were performed.
+	sprayings []*journal.Activity
+	products  map[string]*api.PesticideProduct
+	// plans are ordered by sowing date.
+	plans []api.PlantingPlan
+}
+
+// loadHistory loads the history of field for the activities performed from from
+// to to, both inclusive: the sprayings within their lookbacks and seasons.
+// products are the products already loaded, the history adds to them.
+func (e *Engine) loadHistory(ctx context.Context, field *api.Field, from, to time.Time, products map[string]*api.PesticideProduct) (*history, error) {
+	db := e.db.WithContext(ctx)
+	plans, err := croprepository.ListActivePlantingPlans(db, field.ID, day(from), day(to).AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	// the lookback of a harvest reaches furthest back, seasons may reach
+	// further and past to
+	start := from.Add(-MaxPreHarvestIntervalDays * 24 * time.Hour)
+	end := to
+	widen := func(seasonStart, seasonEnd time.Time) {
+		if seasonStart.Before(start) {
+			start = seasonStart
+		}
+		if seasonEnd.After(end) {
+			end = seasonEnd
+		}
+	}
+	widen(seasonOf(day(from), nil))
+	widen(seasonOf(day(to), nil))
+	for i := range plans {
+		widen(seasonOf(plans[i].SowingDate, &plans[i]))
+	}
+
+	sprayings, err := e.sprayings(ctx, field.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, s := range sprayings {
+		for _, id := range productIDs(s) {
+			if products[id] == nil {
+				missing = appendUnique(missing, id)
+			}
+		}
+	}
+	loaded, err := repository.GetProducts(db, "productId", missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, p := range loaded {
+		products[id] = p
+	}
+	return &history{sprayings: sprayings, products: products, plans: plans}, nil
+}
+
+// between returns the sprayings performed from from to to for which keep is
+// true.
+func (h *history) between(from, to time.Time, keep func(*journal.Activity) bool) []*journal.Activity {
+	var sprayings []*journal.Activity
+	for _, s := range h.sprayings {
+		if !s.PerformedAt.Before(from) && s.PerformedAt.Before(to) && keep(s) {
+			sprayings = append(sprayings, s)
+		}
+	}
+	return sprayings
+}
+
+// plan returns the first planting on the field on day, nil if there is none.
+func (h *history) plan(day time.Time) *api.PlantingPlan {
+	for i := range h.plans {
+		if h.plans[i].SowingDate.Before(day.AddDate(0, 0, 1)) && h.plans[i].HarvestDate.After(day) {
+			return &h.plans[i]
+		}
+	}
+	return nil
+}
+
+// assess checks activity against h, which must cover its lookbacks and, for
+// a spraying, its season.
+func assess(activity *journal.Activity, field *api.Field, h *history) *assessment {
+	a := &assessment{}
+	if activity.Void {
+		return a
+	}
+	other := func(s *journal.Activity) bool {
+		return s.ID != activity.ID && s.ID != activity.Corrects
+	}
+
+	if activity.Kind == journal.KindSpraying {
+		sprayed := day(activity.PerformedAt)
+		plan := h.plan(sprayed)
+		var season []*journal.Activity
+		if len(productIDs(activity)) > 0 {
+			seasonStart, seasonEnd := seasonOf(sprayed, plan)
+			season = h.between(seasonStart, seasonEnd, other)
+		}
+		checkSpraying(a, activity, field, plan, h.products, season)
+	}
+
+	// every activity enters the field, a harvest must also wait for the
+	// pre-harvest intervals
+	lookback := time.Duration(MaxReEntryIntervalHours) * time.Hour
+	if activity.Kind == journal.KindHarvesting {
+		lookback = MaxPreHarvestIntervalDays * 24 * time.Hour
+	}
+	earlier := h.between(activity.PerformedAt.Add(-lookback), activity.PerformedAt, other)
+	checkIntervals(a, activity, earlier, h.products)
+	return a
+}
+
+// checkIntervals checks activity against the re-entry intervals of the
+// products of the earlier sprayings and, for a harvest, their pre-harvest
+// intervals.
+func checkIntervals(a *assessment, activity *journal.Activity, earlier []*journal.Activity, products map[string]*api.PesticideProduct) {
+	for _, s := range earlier {
+		for _, id := range productIDs(s) {
+			p := products[id]
+			if p.ReEntryIntervalHours > 0 {
+				until := s.PerformedAt.Add(time.Duration(p.ReEntryIntervalHours) * time.Hour)
+				if activity.PerformedAt.Before(until) {
+					a.violations = append(a.violations, Violation{
+						Rule:    RuleReEntryInterval,
+						Subject: "activities/" + s.ID,
+						Description: fmt.Sprintf("the field is entered within the %d-hour re-entry interval of %s sprayed at %s, which ends at %s",
+							p.ReEntryIntervalHours, p.Name, s.PerformedAt.UTC().Format(time.RFC3339), until.UTC().Format(time.RFC3339)),
+					})
+				}
+			}
+			if activity.Kind == journal.KindHarvesting && p.PreHarvestIntervalDays > 0 {
+				safe := day(s.PerformedAt).AddDate(0, 0, p.PreHarvestIntervalDays)
+				if activity.PerformedAt.Before(safe) {
+					a.violations = append(a.violations, Violation{
+						Rule:    RulePreHarvestInterval,
+						Subject: "activities/" + s.ID,
+						Description: fmt.Sprintf("the crop is harvested within the %d-day pre-harvest interval of %s sprayed on %s, harvest is allowed from %s",
+							p.PreHarvestIntervalDays, p.Name, s.PerformedAt.UTC().Format(croprepository.DateLayout), safe.Format(croprepository.DateLayout)),
+					})
+				}
+			}
+		}
+	}
+}
+
+// seasonOf returns the season of a spraying on sprayed, from the sowing to
+// the day after the harvest of plan. Without a planting the season is the
+// calendar year.
+func seasonOf(sprayed time.Time, plan *api.PlantingPlan) (time.Time, time.Time) {
+	if plan != nil {
+		return plan.SowingDate, plan.HarvestDate.AddDate(0, 0, 1)
+	}
+	start := time.Date(sprayed.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, 0)
+}
+
+// checkSpraying checks the products sprayed by activity against their dose
+// and number of applications in the season, given the other sprayings of
+// the season, and the harvest of plan, nil without a planting, against
+// their pre-harvest intervals.
+func checkSpraying(a *assessment, activity *journal.Activity, field *api.Field, plan *api.PlantingPlan, products map[string]*api.PesticideProduct, season []*journal.Activity) {
+	sprayed := day(activity.PerformedAt)
+	seasonStart, seasonEnd := seasonOf(sprayed, plan)
+
+	for _, in := range activity.Inputs {
+		if in.ProductID == "" {
+			a.violations = append(a.violations, Violation{
+				Rule:        RuleUnregisteredProduct,
+				Subject:     "inputs/" + in.Name,
+				Description: fmt.Sprintf("%s is not a registered pesticide product, its use is not checked", in.Name),
+				Advisory:    true,
+			})
+		}
+	}
+
+	ids := productIDs(activity)
+	doses := make(map[string]float64, len(ids))
+	for _, in := range activity.Inputs {
+		p := products[in.ProductID]
+		if p == nil {
+			continue
+		}
+		app := Application{
+			Activity: activity,
+			Product:  p,
+			Quantity: in.Quantity,
+			Unit:     in.Unit,
+			Number:   1,
+		}
+		switch {
+		case p.DoseUnit == "":
+		case !strings.EqualFold(in.Unit, p.DoseUnit):
+			a.violations = append(a.violations, Violation{
+				Rule:    RuleDoseUnit,
+				Subject: "products/" + p.ID,
+				Description: fmt.Sprintf("%s is given in %s but dosed in %s per hectare, its dose is not checked",
+					p.Name, in.Unit, p.DoseUnit),
+				Advisory: true,
+			})
+		case field.AreaHectares > 0:
+			app.DosePerHectare = in.Quantity / field.AreaHectares
+			doses[p.ID] += app.DosePerHectare
+		}
+		for _, s := range season {
+			if containsProduct(s, p.ID) && !s.PerformedAt.After(activity.PerformedAt) {
+				app.Number++
+			}
+		}
+		if p.ReEntryIntervalHours > 0 {
+			app.ReEntryAt = activity.PerformedAt.Add(time.Duration(p.ReEntryIntervalHours) * time.Hour)
+		}
+		if p.PreHarvestIntervalDays > 0 {
+			app.SafeHarvest = sprayed.AddDate(0, 0, p.PreHarvestIntervalDays)
+		}
+		a.applications = append(a.applications, app)
+	}
+
+	for _, id := range ids {
+		p := products[id]
+		if p.MaxDosePerHectare > 0 && doses[id] > p.MaxDosePerHectare*(1+1e-9) {
+			a.violations = append(a.violations, Violation{
+				Rule:    RuleMaxDose,
+				Subject: "products/" + id,
+				Description: fmt.Sprintf("%s is applied at %.3g %s/ha, above its maximum dose of %.3g %s/ha",
+					p.Name, doses[id], p.DoseUnit, p.MaxDosePerHectare, p.DoseUnit),
+			})
+		}
+		if p.MaxApplicationsPerSeason > 0 {
+			applications := 1
+			for _, s := range season {
+				if containsProduct(s, id) {
+					applications++
+				}
+			}
+			if applications > p.MaxApplicationsPerSeason {
+				a.violations = append(a.violations, Violation{
+					Rule:    RuleMaxApplications,
+					Subject: "products/" + id,
+					Description: fmt.Sprintf("%s is applied %d times from %s to %s, at most %d applications per season are allowed",
+						p.Name, applications, seasonStart.Format(croprepository.DateLayout),
+						seasonEnd.AddDate(0, 0, -1).Format(croprepository.DateLayout), p.MaxApplicationsPerSeason),
+				})
+			}
+		}
+		if plan != nil && p.PreHarvestIntervalDays > 0 {
+			safe := sprayed.AddDate(0, 0, p.PreHarvestIntervalDays)
+			if plan.HarvestDate.Before(safe) {
+				a.violations = append(a.violations, Violation{
+					Rule:    RulePreHarvestInterval,
+					Subject: "products/" + id,
+					Description: fmt.Sprintf("the harvest planned on %s is within the %d-day pre-harvest interval of %s, harvest is allowed from %s",
+						plan.HarvestDate.Format(croprepository.DateLayout), p.PreHarvestIntervalDays, p.Name, safe.Format(croprepository.DateLayout)),
+				})
+			}
+		}
+	}
+}
+
+// sprayings returns the sprayings of fieldID performed from from to to, as
+// corrected.
+func (e *Engine) sprayings(ctx context.Context, fieldID string, from, to time.Time) ([]*journal.Activity, error) {
+	var sprayings []*journal.Activity
+	q := pagination.Query{PageSize: pagination.MaxPageSize}
+	for {
+		page, err := e.journal.List(ctx, journal.Filter{
+			FieldID: fieldID,
+			From:    from,
+			To:      to,
+			Kind:    journal.KindSpraying,
+		}, q)
+		if err != nil {
+			return nil, err
+		}
+		sprayings = append(sprayings, page.Items...)
+		if page.NextPageToken == "" {
+			return sprayings, nil
+		}
+		q.PageToken = page.NextPageToken
+	}
+}
+
+func productIDs(activity *journal.Activity) []string {
+	var ids []string
+	for _, in := range activity.Inputs {
+		if in.ProductID != "" {
+			ids = appendUnique(ids, in.ProductID)
+		}
+	}
+	return ids
+}
+
+func containsProduct(activity *journal.Activity, productID string) bool {
+	for _, in := range activity.Inputs {
+		if in.ProductID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
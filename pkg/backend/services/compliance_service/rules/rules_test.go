@@ -0,0 +1,373 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	copper = &api.PesticideProduct{
+		ID:                       "p1",
+		Name:                     "Copper",
+		PreHarvestIntervalDays:   14,
+		ReEntryIntervalHours:     24,
+		MaxApplicationsPerSeason: 2,
+		MaxDosePerHectare:        2,
+		DoseUnit:                 "l",
+	}
+	sulphur = &api.PesticideProduct{ID: "p2", Name: "Sulphur"}
+	catalog = map[string]*api.PesticideProduct{copper.ID: copper, sulphur.ID: sulphur}
+)
+
+func at(day, hour int) time.Time {
+	return time.Date(2024, 6, day, hour, 0, 0, 0, time.UTC)
+}
+
+func spraying(id string, performedAt time.Time, inputs ...journal.Input) *journal.Activity {
+	return &journal.Activity{ID: id, Kind: journal.KindSpraying, PerformedAt: performedAt, Inputs: inputs}
+}
+
+func rulesOf(violations []Violation) []string {
+	var out []string
+	for _, v := range violations {
+		r := v.Rule + " " + v.Subject
+		if v.Advisory {
+			r += " advisory"
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func TestCheckSpraying(t *testing.T) {
+	field := &api.Field{ID: "f1", AreaHectares: 2}
+	plan := &api.PlantingPlan{
+		SowingDate:  time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		HarvestDate: time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name           string
+		activity       *journal.Activity
+		field          *api.Field
+		plan           *api.PlantingPlan
+		season         []*journal.Activity
+		wantViolations []string
+		// product id, dose per hectare and application number of each
+		// application
+		wantApplications []string
+	}{
+		{
+			name:             "within the conditions of use",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 4, Unit: "L", ProductID: "p1"}),
+			wantApplications: []string{"p1 2 L/ha #1"},
+		},
+		{
+			name:             "unregistered product",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Garlic tea", Quantity: 10, Unit: "l"}),
+			wantViolations:   []string{"unregistered_product inputs/Garlic tea advisory"},
+			wantApplications: nil,
+		},
+		{
+			name:             "dose in another unit",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 4, Unit: "kg", ProductID: "p1"}),
+			wantViolations:   []string{"dose_unit products/p1 advisory"},
+			wantApplications: []string{"p1 0 kg/ha #1"},
+		},
+		{
+			name:             "dose above the maximum",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 5, Unit: "l", ProductID: "p1"}),
+			wantViolations:   []string{"max_dose products/p1"},
+			wantApplications: []string{"p1 2.5 l/ha #1"},
+		},
+		{
+			name: "doses of one product add up",
+			activity: spraying("a1", at(1, 8),
+				journal.Input{Name: "Copper", Quantity: 3, Unit: "l", ProductID: "p1"},
+				journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			wantViolations:   []string{"max_dose products/p1"},
+			wantApplications: []string{"p1 1.5 l/ha #1", "p1 1 l/ha #1"},
+		},
+		{
+			name:             "dose not checked without an area",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 50, Unit: "l", ProductID: "p1"}),
+			field:            &api.Field{ID: "f1"},
+			wantApplications: []string{"p1 0 l/ha #1"},
+		},
+		{
+			name:     "too many applications",
+			activity: spraying("a3", at(10, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			season: []*journal.Activity{
+				spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+				spraying("a2", at(5, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+				spraying("a4", at(6, 8), journal.Input{Name: "Sulphur", Quantity: 2, Unit: "kg", ProductID: "p2"}),
+			},
+			wantViolations:   []string{"max_applications products/p1"},
+			wantApplications: []string{"p1 1 l/ha #3"},
+		},
+		{
+			name:     "later sprayings count against the limit but not the number",
+			activity: spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			season: []*journal.Activity{
+				spraying("a2", at(5, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+				spraying("a3", at(10, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			},
+			wantViolations:   []string{"max_applications products/p1"},
+			wantApplications: []string{"p1 1 l/ha #1"},
+		},
+		{
+			name:             "planned harvest within the pre-harvest interval",
+			activity:         spraying("a1", at(10, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			plan:             plan,
+			wantViolations:   []string{"pre_harvest_interval products/p1"},
+			wantApplications: []string{"p1 1 l/ha #1"},
+		},
+		{
+			name:             "planned harvest after the pre-harvest interval",
+			activity:         spraying("a1", at(6, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+			plan:             plan,
+			wantApplications: []string{"p1 1 l/ha #1"},
+		},
+		{
+			name:             "product without limits",
+			activity:         spraying("a1", at(1, 8), journal.Input{Name: "Sulphur", Quantity: 100, Unit: "kg", ProductID: "p2"}),
+			plan:             plan,
+			season:           []*journal.Activity{spraying("a0", at(1, 6), journal.Input{Name: "Sulphur", Quantity: 1, Unit: "kg", ProductID: "p2"})},
+			wantApplications: []string{"p2 0 kg/ha #2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := field
+			if tt.field != nil {
+				f = tt.field
+			}
+			a := &assessment{}
+			checkSpraying(a, tt.activity, f, tt.plan, catalog, tt.season)
+
+			if got := rulesOf(a.violations); !reflect.DeepEqual(got, tt.wantViolations) {
+				t.Errorf("violations = %q, want %q", got, tt.wantViolations)
+			}
+			var apps []string
+			for _, app := range a.applications {
+				apps = append(apps, fmt.Sprintf("%s %g %s/ha #%d", app.Product.ID, app.DosePerHectare, app.Unit, app.Number))
+			}
+			if !reflect.DeepEqual(apps, tt.wantApplications) {
+				t.Errorf("applications = %q, want %q", apps, tt.wantApplications)
+			}
+		})
+	}
+}
+
+func TestCheckSprayingIntervals(t *testing.T) {
+	a := &assessment{}
+	activity := spraying("a1", at(1, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"})
+	checkSpraying(a, activity, &api.Field{AreaHectares: 1}, nil, catalog, nil)
+	app := a.applications[0]
+	if !app.ReEntryAt.Equal(at(2, 8)) || !app.SafeHarvest.Equal(at(15, 0)) {
+		t.Errorf("re-entry at %v, safe harvest on %v", app.ReEntryAt, app.SafeHarvest)
+	}
+}
+
+func TestSeasonOf(t *testing.T) {
+	sprayed := at(10, 0)
+	start, end := seasonOf(sprayed, nil)
+	if !start.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("season without a planting = %v to %v", start, end)
+	}
+	plan := &api.PlantingPlan{SowingDate: at(1, 0), HarvestDate: at(20, 0)}
+	start, end = seasonOf(sprayed, plan)
+	if !start.Equal(at(1, 0)) || !end.Equal(at(21, 0)) {
+		t.Errorf("season of a planting = %v to %v", start, end)
+	}
+}
+
+func TestCheckIntervals(t *testing.T) {
+	earlier := []*journal.Activity{
+		spraying("s1", at(10, 8), journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"}),
+		spraying("s2", at(10, 9), journal.Input{Name: "Sulphur", Quantity: 2, Unit: "kg", ProductID: "p2"}),
+	}
+
+	tests := []struct {
+		name     string
+		activity *journal.Activity
+		want     []string
+	}{
+		{
+			name:     "entered within the re-entry interval",
+			activity: &journal.Activity{Kind: journal.KindIrrigation, PerformedAt: at(11, 7)},
+			want:     []string{"re_entry_interval activities/s1"},
+		},
+		{
+			name:     "entered as the re-entry interval ends",
+			activity: &journal.Activity{Kind: journal.KindIrrigation, PerformedAt: at(11, 8)},
+		},
+		{
+			name:     "harvested within both intervals",
+			activity: &journal.Activity{Kind: journal.KindHarvesting, PerformedAt: at(11, 7)},
+			want:     []string{"re_entry_interval activities/s1", "pre_harvest_interval activities/s1"},
+		},
+		{
+			name:     "harvested on the last day of the pre-harvest interval",
+			activity: &journal.Activity{Kind: journal.KindHarvesting, PerformedAt: at(23, 18)},
+			want:     []string{"pre_harvest_interval activities/s1"},
+		},
+		{
+			name:     "harvested on the first safe day",
+			activity: &journal.Activity{Kind: journal.KindHarvesting, PerformedAt: at(24, 0)},
+		},
+		{
+			name:     "only harvests wait for the pre-harvest interval",
+			activity: &journal.Activity{Kind: journal.KindFertilising, PerformedAt: at(20, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &assessment{}
+			checkIntervals(a, tt.activity, earlier, catalog)
+			if got := rulesOf(a.violations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssess(t *testing.T) {
+	field := &api.Field{ID: "f1", AreaHectares: 1}
+	copperAt := func(id string, performedAt time.Time) *journal.Activity {
+		return spraying(id, performedAt, journal.Input{Name: "Copper", Quantity: 2, Unit: "l", ProductID: "p1"})
+	}
+	h := &history{
+		sprayings: []*journal.Activity{copperAt("s1", at(1, 8)), copperAt("s2", at(10, 8)), copperAt("s3", at(15, 8))},
+		products:  catalog,
+		plans: []api.PlantingPlan{{
+			SowingDate:  time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			HarvestDate: time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		activity *journal.Activity
+		want     []string
+	}{
+		{
+			name:     "spraying checked against the others of its season",
+			activity: h.sprayings[1],
+			want:     []string{"max_applications products/p1", "pre_harvest_interval products/p1"},
+		},
+		{
+			// s2 was a fertilising, not a spraying whose re-entry interval it is within
+			name:     "correction leaves out what it corrects",
+			activity: &journal.Activity{ID: "c1", Corrects: "s2", Kind: journal.KindFertilising, PerformedAt: at(10, 9)},
+		},
+		{
+			name:     "spraying without a planting",
+			activity: copperAt("s4", time.Date(2024, 8, 1, 8, 0, 0, 0, time.UTC)),
+			want:     []string{"max_applications products/p1"},
+		},
+		{
+			name:     "entered within a re-entry interval",
+			activity: &journal.Activity{ID: "i1", Kind: journal.KindIrrigation, PerformedAt: at(10, 20)},
+			want:     []string{"re_entry_interval activities/s2"},
+		},
+		{
+			name:     "harvested within pre-harvest intervals",
+			activity: &journal.Activity{ID: "h1", Kind: journal.KindHarvesting, PerformedAt: at(21, 8)},
+			want:     []string{"pre_harvest_interval activities/s2", "pre_harvest_interval activities/s3"},
+		},
+		{
+			name:     "withdrawal",
+			activity: &journal.Activity{ID: "v1", Corrects: "s2", Void: true, Kind: journal.KindSpraying, PerformedAt: at(10, 8)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rulesOf(assess(tt.activity, field, h).violations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := h.plan(time.Date(2024, 6, 19, 0, 0, 0, 0, time.UTC)); got != &h.plans[0] {
+		t.Errorf("plan() on the day before the harvest = %v, want the planting", got)
+	}
+	if got := h.plan(time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)); got != nil {
+		t.Errorf("plan() on the harvest = %v, want none", got)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	advisory := Violation{Rule: RuleDoseUnit, Advisory: true}
+	broken := Violation{Rule: RuleMaxDose}
+
+	tests := []struct {
+		name        string
+		enforcement string
+		violations  []Violation
+		want        bool
+	}{
+		{"block without violations", EnforcementBlock, nil, false},
+		{"block advisory only", EnforcementBlock, []Violation{advisory}, false},
+		{"block broken rule", EnforcementBlock, []Violation{advisory, broken}, true},
+		{"warn broken rule", EnforcementWarn, []Violation{broken}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewEngine(nil, nil, tt.enforcement).Blocks(tt.violations); got != tt.want {
+				t.Errorf("Blocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockedErrorStatus(t *testing.T) {
+	err := &BlockedError{Violations: []Violation{
+		{Rule: RuleMaxDose, Subject: "products/p1", Description: "too much"},
+		{Rule: RuleReEntryInterval, Subject: "activities/a1", Description: "too soon"},
+	}}
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition || st.Message() != err.Error() {
+		t.Fatalf("status = %v", st)
+	}
+	var got []string
+	for _, d := range st.Details() {
+		if failure, ok := d.(*errdetails.PreconditionFailure); ok {
+			for _, v := range failure.GetViolations() {
+				got = append(got, v.GetType()+" "+v.GetSubject()+" "+v.GetDescription())
+			}
+		}
+	}
+	want := []string{"max_dose products/p1 too much", "re_entry_interval activities/a1 too soon"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("precondition failures = %q, want %q", got, want)
+	}
+}
+
+func TestReportCompliant(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings []Finding
+		want     bool
+	}{
+		{"no findings", nil, true},
+		{"advisory findings", []Finding{{Violation: Violation{Advisory: true}}}, true},
+		{"broken rule", []Finding{{Violation: Violation{Advisory: true}}, {Violation: Violation{Rule: RuleMaxDose}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&Report{Findings: tt.findings}).Compliant(); got != tt.want {
+				t.Errorf("Compliant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/services/crop_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type CropHandler struct {
+	proto.UnimplementedCropServiceServer
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewCropHandler(db *gorm.DB, logger *zap.Logger) *CropHandler {
+	cropHandler := CropHandler{
+		db:     db,
+		logger: logger,
+	}
+	return &cropHandler
+}
+
+func (h *CropHandler) CreateCrop(ctx context.Context, req *proto.CreateCropRequest) (*proto.CreateCropResponse, error) {
+	crop := &api.Crop{
+		Species:              req.GetSpecies(),
+		Variety:              req.GetVariety(),
+		DaysToMaturity:       int(req.GetDaysToMaturity()),
+		RowSpacingCm:         req.GetRowSpacingCm(),
+		PlantSpacingCm:       req.GetPlantSpacingCm(),
+		WaterRequirementMm:   req.GetWaterRequirementMm(),
+		BaseTemperatureC:     req.GetBaseTemperatureC(),
+		KcInitial:            req.GetKcInitial(),
+		KcMid:                req.GetKcMid(),
+		KcEnd:                req.GetKcEnd(),
+		RootDepthM:           req.GetRootDepthM(),
+		DegreeDaysToMaturity: req.GetDegreeDaysToMaturity(),
+	}
+
+	id, err := repository.CreateCrop(h.db.WithContext(ctx), crop)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create crop")
+	}
+	logging.WithContext(ctx, h.logger).Info("Crop created", zap.String("crop_id", id))
+
+	return &proto.CreateCropResponse{
+		Id:      id,
+		Message: "Crop created successfully",
+	}, nil
+}
+
+func (h *CropHandler) GetCrop(ctx context.Context, req *proto.GetCropRequest) (*proto.GetCropResponse, error) {
+	crop, err := repository.GetCrop(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get crop")
+	}
+	return &proto.GetCropResponse{Crop: cropToProto(crop)}, nil
+}
+
+func (h *CropHandler) ListCrops(ctx context.Context, req *proto.ListCropsRequest) (*proto.ListCropsResponse, error) {
+	page, err := repository.ListCrops(h.db.WithContext(ctx), req.GetSpeciesPrefix(), pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list crops")
+	}
+
+	var cropList []*proto.Crop
+	for _, c := range page.Items {
+		cropList = append(cropList, cropToProto(c))
+	}
+	return &proto.ListCropsResponse{
+		Crops:         cropList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *CropHandler) UpdateCrop(ctx context.Context, req *proto.UpdateCropRequest) (*proto.UpdateCropResponse, error) {
+	var crop api.Crop
+	var columns []string
+	if req.Species != nil {
+		crop.Species = req.GetSpecies()
+		columns = append(columns, "species")
+	}
+	if req.Variety != nil {
+		crop.Variety = req.GetVariety()
+		columns = append(columns, "variety")
+	}
+	if req.DaysToMaturity != nil {
+		crop.DaysToMaturity = int(req.GetDaysToMaturity())
+		columns = append(columns, "days_to_maturity")
+	}
+	if req.RowSpacingCm != nil {
+		crop.RowSpacingCm = req.GetRowSpacingCm()
+		columns = append(columns, "row_spacing_cm")
+	}
+	if req.PlantSpacingCm != nil {
+		crop.PlantSpacingCm = req.GetPlantSpacingCm()
+		columns = append(columns, "plant_spacing_cm")
+	}
+	if req.WaterRequirementMm != nil {
+		crop.WaterRequirementMm = req.GetWaterRequirementMm()
+		columns = append(columns, "water_requirement_mm")
+	}
+	if req.BaseTemperatureC != nil {
+		crop.BaseTemperatureC = req.GetBaseTemperatureC()
+		columns = append(columns, "base_temperature_c")
+	}
+	if req.KcInitial != nil {
+		crop.KcInitial = req.GetKcInitial()
+		columns = append(columns, "kc_initial")
+	}
+	if req.KcMid != nil {
+		crop.KcMid = req.GetKcMid()
+		columns = append(columns, "kc_mid")
+	}
+	if req.KcEnd != nil {
+		crop.KcEnd = req.GetKcEnd()
+		columns = append(columns, "kc_end")
+	}
+	if req.RootDepthM != nil {
+		crop.RootDepthM = req.GetRootDepthM()
+		columns = append(columns, "root_depth_m")
+	}
+	if req.DegreeDaysToMaturity != nil {
+		crop.DegreeDaysToMaturity = req.GetDegreeDaysToMaturity()
+		columns = append(columns, "degree_days_to_maturity")
+	}
+	if len(columns) == 0 {
+		return nil, grpcerr.InvalidArgument("species", "no changes given")
+	}
+
+	if err := repository.UpdateCrop(h.db.WithContext(ctx), req.GetId(), &crop, columns); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update crop")
+	}
+
+	return &proto.UpdateCropResponse{
+		Message: "Crop updated successfully",
+	}, nil
+}
+
+func (h *CropHandler) DeleteCrop(ctx context.Context, req *proto.DeleteCropRequest) (*proto.DeleteCropResponse, error) {
+	err := repository.DeleteCrop(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete crop")
+	}
+	logging.WithContext(ctx, h.logger).Info("Crop deleted", zap.String("crop_id", req.GetId()))
+	return &proto.DeleteCropResponse{
+		Message: "Crop deleted successfully",
+	}, nil
+}
+
+func (h *CropHandler) CreatePlantingPlan(ctx context.Context, req *proto.CreatePlantingPlanRequest) (*proto.CreatePlantingPlanResponse, error) {
+	if (req.GetSowingDate() == "") == (req.GetHarvestDate() == "") {
+		return nil, grpcerr.InvalidArgument("sowingDate", "exactly one of sowingDate and harvestDate must be given")
+	}
+	db := h.db.WithContext(ctx)
+	crop, err := repository.GetCrop(db, req.GetCropId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get crop")
+	}
+
+	plan := &api.PlantingPlan{
+		FieldID: req.GetFieldId(),
+		CropID:  crop.ID,
+		Season:  req.GetSeason(),
+		Status:  api.PlantingStatusPlanned,
+		Notes:   req.GetNotes(),
+	}
+	if err := schedule(plan, crop, req.GetSowingDate(), req.GetHarvestDate()); err != nil {
+		return nil, err
+	}
+
+	id, err := repository.CreatePlantingPlan(db, plan)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create planting plan")
+	}
+	logging.WithContext(ctx, h.logger).Info("Planting plan created", zap.String("planting_plan_id", id), zap.String("field_id", plan.FieldID))
+
+	return &proto.CreatePlantingPlanResponse{
+		Id:          id,
+		SowingDate:  plan.SowingDate.Format(repository.DateLayout),
+		HarvestDate: plan.HarvestDate.Format(repository.DateLayout),
+		Message:     "Planting plan created successfully",
+	}, nil
+}
+
+func (h *CropHandler) GetPlantingPlan(ctx context.Context, req *proto.GetPlantingPlanRequest) (*proto.GetPlantingPlanResponse, error) {
+	plan, err := repository.GetPlantingPlan(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get planting plan")
+	}
+	return &proto.GetPlantingPlanResponse{PlantingPlan: planToProto(plan)}, nil
+}
+
+func (h *CropHandler) ListPlantingPlans(ctx context.Context, req *proto.ListPlantingPlansRequest) (*proto.ListPlantingPlansResponse, error) {
+	filter := repository.PlanFilter{
+		Season:           req.GetSeason(),
+		IncludeCancelled: req.GetIncludeCancelled(),
+	}
+	page, err := repository.ListPlantingPlans(h.db.WithContext(ctx), req.GetFieldId(), filter, pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list planting plans")
+	}
+
+	var planList []*proto.PlantingPlan
+	for _, p := range page.Items {
+		planList = append(planList, planToProto(p))
+	}
+	return &proto.ListPlantingPlansResponse{
+		PlantingPlans: planList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *CropHandler) UpdatePlantingPlan(ctx context.Context, req *proto.UpdatePlantingPlanRequest) (*proto.UpdatePlantingPlanResponse, error) {
+	if req.GetSowingDate() != "" && req.GetHarvestDate() != "" {
+		return nil, grpcerr.InvalidArgument("sowingDate", "at most one of sowingDate and harvestDate may be given")
+	}
+	db := h.db.WithContext(ctx)
+	plan, err := repository.GetPlantingPlan(db, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get planting plan")
+	}
+
+	if req.GetSeason() != "" {
+		plan.Season = req.GetSeason()
+	}
+	if req.Notes != nil {
+		plan.Notes = req.GetNotes()
+	}
+	if req.GetStatus() != proto.PlantingStatus_PLANTING_STATUS_UNSPECIFIED {
+		plan.Status = statusName(req.GetStatus())
+	}
+	if req.GetSowingDate() != "" || req.GetHarvestDate() != "" {
+		if err := schedule(plan, &plan.Crop, req.GetSowingDate(), req.GetHarvestDate()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := repository.UpdatePlantingPlan(db, plan); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update planting plan")
+	}
+
+	return &proto.UpdatePlantingPlanResponse{
+		SowingDate:  plan.SowingDate.Format(repository.DateLayout),
+		HarvestDate: plan.HarvestDate.Format(repository.DateLayout),
+		Message:     "Planting plan updated successfully",
+	}, nil
+}
+
+func (h *CropHandler) DeletePlantingPlan(ctx context.Context, req *proto.DeletePlantingPlanRequest) (*proto.DeletePlantingPlanResponse, error) {
+	err := repository.DeletePlantingPlan(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete planting plan")
+	}
+	logging.WithContext(ctx, h.logger).Info("Planting plan deleted", zap.String("planting_plan_id", req.GetId()))
+	return &proto.DeletePlantingPlanResponse{
+		Message: "Planting plan deleted successfully",
+	}, nil
+}
+
+// schedule sets the dates of plan from whichever of sowing and harvest is
+// given, deriving the other one from the crop's days to maturity.
+func schedule(plan *api.PlantingPlan, crop *api.Crop, sowing, harvest string) error {
+	if sowing != "" {
+		date, err := time.Parse(repository.DateLayout, sowing)
+		if err != nil {
+			return grpcerr.InvalidArgument("sowingDate", "invalid date: "+sowing)
+		}
+		plan.SowingDate = date
+		plan.HarvestDate = date.AddDate(0, 0, crop.DaysToMaturity)
+		return nil
+	}
+	date, err := time.Parse(repository.DateLayout, harvest)
+	if err != nil {
+		return grpcerr.InvalidArgument("harvestDate", "invalid date: "+harvest)
+	}
+	plan.HarvestDate = date
+	plan.SowingDate = date.AddDate(0, 0, -crop.DaysToMaturity)
+	return nil
+}
+
+func cropToProto(c *api.Crop) *proto.Crop {
+	return &proto.Crop{
+		Id:                   c.ID,
+		Species:              c.Species,
+		Variety:              c.Variety,
+		DaysToMaturity:       int32(c.DaysToMaturity),
+		RowSpacingCm:         c.RowSpacingCm,
+		PlantSpacingCm:       c.PlantSpacingCm,
+		WaterRequirementMm:   c.WaterRequirementMm,
+		BaseTemperatureC:     c.BaseTemperatureC,
+		KcInitial:            c.KcInitial,
+		KcMid:                c.KcMid,
+		KcEnd:                c.KcEnd,
+		RootDepthM:           c.RootDepthM,
+		DegreeDaysToMaturity: c.DegreeDaysToMaturity,
+	}
+}
+
+func planToProto(p *api.PlantingPlan) *proto.PlantingPlan {
+	plan := &proto.PlantingPlan{
+		Id:          p.ID,
+		FieldId:     p.FieldID,
+		CropId:      p.CropID,
+		Season:      p.Season,
+		SowingDate:  p.SowingDate.Format(repository.DateLayout),
+		HarvestDate: p.HarvestDate.Format(repository.DateLayout),
+		Status:      statusFromName(p.Status),
+		Notes:       p.Notes,
+	}
+	if p.Crop.ID != "" {
+		plan.Crop = cropToProto(&p.Crop)
+	}
+	return plan
+}
+
+// statusName returns the name stored for s, e.g. "sown".
+func statusName(s proto.PlantingStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "PLANTING_STATUS_"))
+}
+
+func statusFromName(name string) proto.PlantingStatus {
+	return proto.PlantingStatus(proto.PlantingStatus_value["PLANTING_STATUS_"+strings.ToUpper(name)])
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+	"github.com/aburifat/go-agro/pkg/backend/services/crop_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdatePlantingPlan(t *testing.T) {
+	db := dbtest.Postgres(t)
+	h := NewCropHandler(db, zap.NewNop())
+	ctx := context.Background()
+
+	owner := &api.User{Username: "ada", Email: "ada@example.com", Password: "x"}
+	if err := db.Create(owner).Error; err != nil {
+		t.Fatal(err)
+	}
+	farm := &api.Farm{OwnerID: owner.ID, Name: "Home"}
+	if err := db.Create(farm).Error; err != nil {
+		t.Fatal(err)
+	}
+	field := &api.Field{FarmID: farm.ID, Name: "North", AreaHectares: 1,
+		Boundary: `{"type":"Polygon","coordinates":[[[10,50],[10.01,50],[10.01,50.01],[10,50.01],[10,50]]]}`}
+	if err := db.Create(field).Error; err != nil {
+		t.Fatal(err)
+	}
+	crop := &api.Crop{Species: "Wheat", DaysToMaturity: 100}
+	if err := db.Create(crop).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := h.CreatePlantingPlan(ctx, &proto.CreatePlantingPlanRequest{
+		FieldId: field.ID, CropId: crop.ID, Season: "2025", SowingDate: "2025-03-01", Notes: "irrigate early",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replaced, cleared := "irrigate late", ""
+	tests := []struct {
+		name        string
+		req         *proto.UpdatePlantingPlanRequest
+		wantNotes   string
+		wantSowing  string
+		wantHarvest string
+	}{
+		{
+			name:        "unset notes are kept",
+			req:         &proto.UpdatePlantingPlanRequest{Season: "2025a"},
+			wantNotes:   "irrigate early",
+			wantSowing:  "2025-03-01",
+			wantHarvest: "2025-06-09",
+		},
+		{
+			name:        "notes are replaced",
+			req:         &proto.UpdatePlantingPlanRequest{Notes: &replaced},
+			wantNotes:   "irrigate late",
+			wantSowing:  "2025-03-01",
+			wantHarvest: "2025-06-09",
+		},
+		{
+			name:        "empty notes clear them",
+			req:         &proto.UpdatePlantingPlanRequest{Notes: &cleared},
+			wantNotes:   "",
+			wantSowing:  "2025-03-01",
+			wantHarvest: "2025-06-09",
+		},
+		{
+			name:        "rescheduled by harvest",
+			req:         &proto.UpdatePlantingPlanRequest{HarvestDate: "2025-07-01"},
+			wantSowing:  "2025-03-23",
+			wantHarvest: "2025-07-01",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Id = created.GetId()
+			if _, err := h.UpdatePlantingPlan(ctx, tt.req); err != nil {
+				t.Fatalf("UpdatePlantingPlan() error = %v", err)
+			}
+			got, err := h.GetPlantingPlan(ctx, &proto.GetPlantingPlanRequest{Id: created.GetId()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			plan := got.GetPlantingPlan()
+			if plan.GetNotes() != tt.wantNotes || plan.GetSowingDate() != tt.wantSowing || plan.GetHarvestDate() != tt.wantHarvest {
+				t.Errorf("plan = %v, want notes %q from %s to %s", plan, tt.wantNotes, tt.wantSowing, tt.wantHarvest)
+			}
+		})
+	}
+
+	// a second plan may not be moved onto the first
+	other, err := h.CreatePlantingPlan(ctx, &proto.CreatePlantingPlanRequest{
+		FieldId: field.ID, CropId: crop.ID, Season: "2025", SowingDate: "2025-07-01",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.UpdatePlantingPlan(ctx, &proto.UpdatePlantingPlanRequest{Id: other.GetId(), SowingDate: "2025-06-01"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UpdatePlantingPlan() onto another plan error = %v, want AlreadyExists", err)
+	}
+}
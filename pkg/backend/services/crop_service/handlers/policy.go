@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/crop_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of CropService. The crop catalogue is shared
+// and maintained by admins; planting plans are checked against the scope of
+// the farm owning the field.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	field := farmhandlers.FieldResource(db, func(req any) string {
+		return req.(interface{ GetFieldId() string }).GetFieldId()
+	})
+	plan := PlantingPlanResource(db, func(req any) string {
+		return req.(interface{ GetId() string }).GetId()
+	})
+
+	return auth.Policy{
+		proto.CropService_CreateCrop_FullMethodName:         auth.Admin,
+		proto.CropService_GetCrop_FullMethodName:            auth.Authenticated,
+		proto.CropService_ListCrops_FullMethodName:          auth.Authenticated,
+		proto.CropService_UpdateCrop_FullMethodName:         auth.Admin,
+		proto.CropService_DeleteCrop_FullMethodName:         auth.Admin,
+		proto.CropService_CreatePlantingPlan_FullMethodName: checker.RequireLookup("crop.write", field),
+		proto.CropService_GetPlantingPlan_FullMethodName:    checker.RequireLookup("crop.read", plan),
+		proto.CropService_ListPlantingPlans_FullMethodName:  checker.RequireLookup("crop.read", field),
+		proto.CropService_UpdatePlantingPlan_FullMethodName: checker.RequireLookup("crop.write", plan),
+		proto.CropService_DeletePlantingPlan_FullMethodName: checker.RequireLookup("crop.delete", plan),
+	}
+}
+
+// PlantingPlanResource returns a lookup of the farm owning the field of the
+// planting plan whose id planID extracts from the request.
+func PlantingPlanResource(db *gorm.DB, planID func(req any) string) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		plan, err := repository.GetPlantingPlan(db.WithContext(ctx), planID(req))
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		return farmhandlers.FieldResource(db, func(any) string { return plan.FieldID })(ctx, req)
+	}
+}
@@ -0,0 +1,2121 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.19.6
+// source: crop.proto
+
+package proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/aburifat/go-agro/pkg/backend/common/validate/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlantingStatus int32
+
+const (
+	PlantingStatus_PLANTING_STATUS_UNSPECIFIED PlantingStatus = 0
+	PlantingStatus_PLANTING_STATUS_PLANNED     PlantingStatus = 1
+	PlantingStatus_PLANTING_STATUS_SOWN        PlantingStatus = 2
+	PlantingStatus_PLANTING_STATUS_HARVESTED   PlantingStatus = 3
+	// cancelled plans do not block other plans of the field
+	PlantingStatus_PLANTING_STATUS_CANCELLED PlantingStatus = 4
+)
+
+// Enum value maps for PlantingStatus.
+var (
+	PlantingStatus_name = map[int32]string{
+		0: "PLANTING_STATUS_UNSPECIFIED",
+		1: "PLANTING_STATUS_PLANNED",
+		2: "PLANTING_STATUS_SOWN",
+		3: "PLANTING_STATUS_HARVESTED",
+		4: "PLANTING_STATUS_CANCELLED",
+	}
+	PlantingStatus_value = map[string]int32{
+		"PLANTING_STATUS_UNSPECIFIED": 0,
+		"PLANTING_STATUS_PLANNED":     1,
+		"PLANTING_STATUS_SOWN":        2,
+		"PLANTING_STATUS_HARVESTED":   3,
+		"PLANTING_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x PlantingStatus) Enum() *PlantingStatus {
+	p := new(PlantingStatus)
+	*p = x
+	return p
+}
+
+func (x PlantingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlantingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_crop_proto_enumTypes[0].Descriptor()
+}
+
+func (PlantingStatus) Type() protoreflect.EnumType {
+	return &file_crop_proto_enumTypes[0]
+}
+
+func (x PlantingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlantingStatus.Descriptor instead.
+func (PlantingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{0}
+}
+
+type Crop struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Species        string                 `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Variety        string                 `protobuf:"bytes,3,opt,name=variety,proto3" json:"variety,omitempty"`
+	DaysToMaturity int32                  `protobuf:"varint,4,opt,name=daysToMaturity,proto3" json:"daysToMaturity,omitempty"`
+	RowSpacingCm   float64                `protobuf:"fixed64,5,opt,name=rowSpacingCm,proto3" json:"rowSpacingCm,omitempty"`
+	PlantSpacingCm float64                `protobuf:"fixed64,6,opt,name=plantSpacingCm,proto3" json:"plantSpacingCm,omitempty"`
+	// water needed over the whole season
+	WaterRequirementMm float64 `protobuf:"fixed64,7,opt,name=waterRequirementMm,proto3" json:"waterRequirementMm,omitempty"`
+	// temperature below which the crop does not develop
+	BaseTemperatureC float64 `protobuf:"fixed64,8,opt,name=baseTemperatureC,proto3" json:"baseTemperatureC,omitempty"`
+	// FAO-56 crop coefficients of the initial, mid-season and late stages
+	KcInitial float64 `protobuf:"fixed64,9,opt,name=kcInitial,proto3" json:"kcInitial,omitempty"`
+	KcMid     float64 `protobuf:"fixed64,10,opt,name=kcMid,proto3" json:"kcMid,omitempty"`
+	KcEnd     float64 `protobuf:"fixed64,11,opt,name=kcEnd,proto3" json:"kcEnd,omitempty"`
+	// depth of soil the crop draws water from
+	RootDepthM float64 `protobuf:"fixed64,12,opt,name=rootDepthM,proto3" json:"rootDepthM,omitempty"`
+	// growing degree days above the base temperature from sowing to
+	// maturity, 0 when unknown
+	DegreeDaysToMaturity float64 `protobuf:"fixed64,13,opt,name=degreeDaysToMaturity,proto3" json:"degreeDaysToMaturity,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Crop) Reset() {
+	*x = Crop{}
+	mi := &file_crop_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crop) ProtoMessage() {}
+
+func (x *Crop) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crop.ProtoReflect.Descriptor instead.
+func (*Crop) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Crop) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Crop) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Crop) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *Crop) GetDaysToMaturity() int32 {
+	if x != nil {
+		return x.DaysToMaturity
+	}
+	return 0
+}
+
+func (x *Crop) GetRowSpacingCm() float64 {
+	if x != nil {
+		return x.RowSpacingCm
+	}
+	return 0
+}
+
+func (x *Crop) GetPlantSpacingCm() float64 {
+	if x != nil {
+		return x.PlantSpacingCm
+	}
+	return 0
+}
+
+func (x *Crop) GetWaterRequirementMm() float64 {
+	if x != nil {
+		return x.WaterRequirementMm
+	}
+	return 0
+}
+
+func (x *Crop) GetBaseTemperatureC() float64 {
+	if x != nil {
+		return x.BaseTemperatureC
+	}
+	return 0
+}
+
+func (x *Crop) GetKcInitial() float64 {
+	if x != nil {
+		return x.KcInitial
+	}
+	return 0
+}
+
+func (x *Crop) GetKcMid() float64 {
+	if x != nil {
+		return x.KcMid
+	}
+	return 0
+}
+
+func (x *Crop) GetKcEnd() float64 {
+	if x != nil {
+		return x.KcEnd
+	}
+	return 0
+}
+
+func (x *Crop) GetRootDepthM() float64 {
+	if x != nil {
+		return x.RootDepthM
+	}
+	return 0
+}
+
+func (x *Crop) GetDegreeDaysToMaturity() float64 {
+	if x != nil {
+		return x.DegreeDaysToMaturity
+	}
+	return 0
+}
+
+type PlantingPlan struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId string                 `protobuf:"bytes,2,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	CropId  string                 `protobuf:"bytes,3,opt,name=cropId,proto3" json:"cropId,omitempty"`
+	Season  string                 `protobuf:"bytes,4,opt,name=season,proto3" json:"season,omitempty"`
+	// YYYY-MM-DD
+	SowingDate string `protobuf:"bytes,5,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	// sowingDate plus the crop's days to maturity, YYYY-MM-DD
+	HarvestDate   string         `protobuf:"bytes,6,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	Status        PlantingStatus `protobuf:"varint,7,opt,name=status,proto3,enum=crop.PlantingStatus" json:"status,omitempty"`
+	Notes         string         `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	Crop          *Crop          `protobuf:"bytes,9,opt,name=crop,proto3" json:"crop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlantingPlan) Reset() {
+	*x = PlantingPlan{}
+	mi := &file_crop_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlantingPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlantingPlan) ProtoMessage() {}
+
+func (x *PlantingPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlantingPlan.ProtoReflect.Descriptor instead.
+func (*PlantingPlan) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlantingPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetCropId() string {
+	if x != nil {
+		return x.CropId
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetStatus() PlantingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PlantingStatus_PLANTING_STATUS_UNSPECIFIED
+}
+
+func (x *PlantingPlan) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PlantingPlan) GetCrop() *Crop {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+type CreateCropRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Species            string                 `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Variety            string                 `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`
+	DaysToMaturity     int32                  `protobuf:"varint,3,opt,name=daysToMaturity,proto3" json:"daysToMaturity,omitempty"`
+	RowSpacingCm       float64                `protobuf:"fixed64,4,opt,name=rowSpacingCm,proto3" json:"rowSpacingCm,omitempty"`
+	PlantSpacingCm     float64                `protobuf:"fixed64,5,opt,name=plantSpacingCm,proto3" json:"plantSpacingCm,omitempty"`
+	WaterRequirementMm float64                `protobuf:"fixed64,6,opt,name=waterRequirementMm,proto3" json:"waterRequirementMm,omitempty"`
+	BaseTemperatureC   float64                `protobuf:"fixed64,7,opt,name=baseTemperatureC,proto3" json:"baseTemperatureC,omitempty"`
+	// default to 0.4, 1.1, 0.6 and 0.6 m
+	KcInitial            float64 `protobuf:"fixed64,8,opt,name=kcInitial,proto3" json:"kcInitial,omitempty"`
+	KcMid                float64 `protobuf:"fixed64,9,opt,name=kcMid,proto3" json:"kcMid,omitempty"`
+	KcEnd                float64 `protobuf:"fixed64,10,opt,name=kcEnd,proto3" json:"kcEnd,omitempty"`
+	RootDepthM           float64 `protobuf:"fixed64,11,opt,name=rootDepthM,proto3" json:"rootDepthM,omitempty"`
+	DegreeDaysToMaturity float64 `protobuf:"fixed64,12,opt,name=degreeDaysToMaturity,proto3" json:"degreeDaysToMaturity,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateCropRequest) Reset() {
+	*x = CreateCropRequest{}
+	mi := &file_crop_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCropRequest) ProtoMessage() {}
+
+func (x *CreateCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCropRequest.ProtoReflect.Descriptor instead.
+func (*CreateCropRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCropRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *CreateCropRequest) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *CreateCropRequest) GetDaysToMaturity() int32 {
+	if x != nil {
+		return x.DaysToMaturity
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetRowSpacingCm() float64 {
+	if x != nil {
+		return x.RowSpacingCm
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetPlantSpacingCm() float64 {
+	if x != nil {
+		return x.PlantSpacingCm
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetWaterRequirementMm() float64 {
+	if x != nil {
+		return x.WaterRequirementMm
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetBaseTemperatureC() float64 {
+	if x != nil {
+		return x.BaseTemperatureC
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetKcInitial() float64 {
+	if x != nil {
+		return x.KcInitial
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetKcMid() float64 {
+	if x != nil {
+		return x.KcMid
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetKcEnd() float64 {
+	if x != nil {
+		return x.KcEnd
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetRootDepthM() float64 {
+	if x != nil {
+		return x.RootDepthM
+	}
+	return 0
+}
+
+func (x *CreateCropRequest) GetDegreeDaysToMaturity() float64 {
+	if x != nil {
+		return x.DegreeDaysToMaturity
+	}
+	return 0
+}
+
+type CreateCropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCropResponse) Reset() {
+	*x = CreateCropResponse{}
+	mi := &file_crop_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCropResponse) ProtoMessage() {}
+
+func (x *CreateCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCropResponse.ProtoReflect.Descriptor instead.
+func (*CreateCropResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCropResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCropResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCropRequest) Reset() {
+	*x = GetCropRequest{}
+	mi := &file_crop_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCropRequest) ProtoMessage() {}
+
+func (x *GetCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCropRequest.ProtoReflect.Descriptor instead.
+func (*GetCropRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCropRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crop          *Crop                  `protobuf:"bytes,1,opt,name=crop,proto3" json:"crop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCropResponse) Reset() {
+	*x = GetCropResponse{}
+	mi := &file_crop_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCropResponse) ProtoMessage() {}
+
+func (x *GetCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCropResponse.ProtoReflect.Descriptor instead.
+func (*GetCropResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCropResponse) GetCrop() *Crop {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+type ListCropsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpeciesPrefix string                 `protobuf:"bytes,1,opt,name=speciesPrefix,proto3" json:"speciesPrefix,omitempty"`
+	// defaults to 20
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of the previous response
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeTotal  bool   `protobuf:"varint,4,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCropsRequest) Reset() {
+	*x = ListCropsRequest{}
+	mi := &file_crop_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCropsRequest) ProtoMessage() {}
+
+func (x *ListCropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCropsRequest.ProtoReflect.Descriptor instead.
+func (*ListCropsRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCropsRequest) GetSpeciesPrefix() string {
+	if x != nil {
+		return x.SpeciesPrefix
+	}
+	return ""
+}
+
+func (x *ListCropsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCropsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCropsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+type ListCropsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Crops []*Crop                `protobuf:"bytes,1,rep,name=crops,proto3" json:"crops,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	// only set when includeTotal was requested
+	TotalCount    int64 `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCropsResponse) Reset() {
+	*x = ListCropsResponse{}
+	mi := &file_crop_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCropsResponse) ProtoMessage() {}
+
+func (x *ListCropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCropsResponse.ProtoReflect.Descriptor instead.
+func (*ListCropsResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCropsResponse) GetCrops() []*Crop {
+	if x != nil {
+		return x.Crops
+	}
+	return nil
+}
+
+func (x *ListCropsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCropsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Unset fields are left unchanged. Existing planting plans keep their
+// harvest dates when daysToMaturity changes.
+type UpdateCropRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Species              *string                `protobuf:"bytes,2,opt,name=species,proto3,oneof" json:"species,omitempty"`
+	Variety              *string                `protobuf:"bytes,3,opt,name=variety,proto3,oneof" json:"variety,omitempty"`
+	DaysToMaturity       *int32                 `protobuf:"varint,4,opt,name=daysToMaturity,proto3,oneof" json:"daysToMaturity,omitempty"`
+	RowSpacingCm         *float64               `protobuf:"fixed64,5,opt,name=rowSpacingCm,proto3,oneof" json:"rowSpacingCm,omitempty"`
+	PlantSpacingCm       *float64               `protobuf:"fixed64,6,opt,name=plantSpacingCm,proto3,oneof" json:"plantSpacingCm,omitempty"`
+	WaterRequirementMm   *float64               `protobuf:"fixed64,7,opt,name=waterRequirementMm,proto3,oneof" json:"waterRequirementMm,omitempty"`
+	BaseTemperatureC     *float64               `protobuf:"fixed64,8,opt,name=baseTemperatureC,proto3,oneof" json:"baseTemperatureC,omitempty"`
+	KcInitial            *float64               `protobuf:"fixed64,9,opt,name=kcInitial,proto3,oneof" json:"kcInitial,omitempty"`
+	KcMid                *float64               `protobuf:"fixed64,10,opt,name=kcMid,proto3,oneof" json:"kcMid,omitempty"`
+	KcEnd                *float64               `protobuf:"fixed64,11,opt,name=kcEnd,proto3,oneof" json:"kcEnd,omitempty"`
+	RootDepthM           *float64               `protobuf:"fixed64,12,opt,name=rootDepthM,proto3,oneof" json:"rootDepthM,omitempty"`
+	DegreeDaysToMaturity *float64               `protobuf:"fixed64,13,opt,name=degreeDaysToMaturity,proto3,oneof" json:"degreeDaysToMaturity,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateCropRequest) Reset() {
+	*x = UpdateCropRequest{}
+	mi := &file_crop_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCropRequest) ProtoMessage() {}
+
+func (x *UpdateCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCropRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCropRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateCropRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCropRequest) GetSpecies() string {
+	if x != nil && x.Species != nil {
+		return *x.Species
+	}
+	return ""
+}
+
+func (x *UpdateCropRequest) GetVariety() string {
+	if x != nil && x.Variety != nil {
+		return *x.Variety
+	}
+	return ""
+}
+
+func (x *UpdateCropRequest) GetDaysToMaturity() int32 {
+	if x != nil && x.DaysToMaturity != nil {
+		return *x.DaysToMaturity
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetRowSpacingCm() float64 {
+	if x != nil && x.RowSpacingCm != nil {
+		return *x.RowSpacingCm
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetPlantSpacingCm() float64 {
+	if x != nil && x.PlantSpacingCm != nil {
+		return *x.PlantSpacingCm
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetWaterRequirementMm() float64 {
+	if x != nil && x.WaterRequirementMm != nil {
+		return *x.WaterRequirementMm
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetBaseTemperatureC() float64 {
+	if x != nil && x.BaseTemperatureC != nil {
+		return *x.BaseTemperatureC
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetKcInitial() float64 {
+	if x != nil && x.KcInitial != nil {
+		return *x.KcInitial
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetKcMid() float64 {
+	if x != nil && x.KcMid != nil {
+		return *x.KcMid
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetKcEnd() float64 {
+	if x != nil && x.KcEnd != nil {
+		return *x.KcEnd
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetRootDepthM() float64 {
+	if x != nil && x.RootDepthM != nil {
+		return *x.RootDepthM
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetDegreeDaysToMaturity() float64 {
+	if x != nil && x.DegreeDaysToMaturity != nil {
+		return *x.DegreeDaysToMaturity
+	}
+	return 0
+}
+
+type UpdateCropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCropResponse) Reset() {
+	*x = UpdateCropResponse{}
+	mi := &file_crop_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCropResponse) ProtoMessage() {}
+
+func (x *UpdateCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCropResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCropResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateCropResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteCropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCropRequest) Reset() {
+	*x = DeleteCropRequest{}
+	mi := &file_crop_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCropRequest) ProtoMessage() {}
+
+func (x *DeleteCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCropRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCropRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteCropRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCropResponse) Reset() {
+	*x = DeleteCropResponse{}
+	mi := &file_crop_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCropResponse) ProtoMessage() {}
+
+func (x *DeleteCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCropResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCropResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCropResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreatePlantingPlanRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FieldId string                 `protobuf:"bytes,1,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	CropId  string                 `protobuf:"bytes,2,opt,name=cropId,proto3" json:"cropId,omitempty"`
+	Season  string                 `protobuf:"bytes,3,opt,name=season,proto3" json:"season,omitempty"`
+	// YYYY-MM-DD; exactly one of sowingDate and harvestDate is given and the
+	// other one is computed from the crop's days to maturity
+	SowingDate    string `protobuf:"bytes,4,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	HarvestDate   string `protobuf:"bytes,5,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	Notes         string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlantingPlanRequest) Reset() {
+	*x = CreatePlantingPlanRequest{}
+	mi := &file_crop_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlantingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlantingPlanRequest) ProtoMessage() {}
+
+func (x *CreatePlantingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlantingPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlantingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreatePlantingPlanRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanRequest) GetCropId() string {
+	if x != nil {
+		return x.CropId
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanRequest) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanRequest) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CreatePlantingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SowingDate    string                 `protobuf:"bytes,2,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	HarvestDate   string                 `protobuf:"bytes,3,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlantingPlanResponse) Reset() {
+	*x = CreatePlantingPlanResponse{}
+	mi := &file_crop_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlantingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlantingPlanResponse) ProtoMessage() {}
+
+func (x *CreatePlantingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlantingPlanResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlantingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreatePlantingPlanResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanResponse) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanResponse) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *CreatePlantingPlanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPlantingPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlantingPlanRequest) Reset() {
+	*x = GetPlantingPlanRequest{}
+	mi := &file_crop_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlantingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlantingPlanRequest) ProtoMessage() {}
+
+func (x *GetPlantingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlantingPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPlantingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPlantingPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPlantingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlantingPlan  *PlantingPlan          `protobuf:"bytes,1,opt,name=plantingPlan,proto3" json:"plantingPlan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlantingPlanResponse) Reset() {
+	*x = GetPlantingPlanResponse{}
+	mi := &file_crop_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlantingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlantingPlanResponse) ProtoMessage() {}
+
+func (x *GetPlantingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlantingPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetPlantingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPlantingPlanResponse) GetPlantingPlan() *PlantingPlan {
+	if x != nil {
+		return x.PlantingPlan
+	}
+	return nil
+}
+
+type ListPlantingPlansRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FieldId string                 `protobuf:"bytes,1,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	// only plans of this season
+	Season           string `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	IncludeCancelled bool   `protobuf:"varint,3,opt,name=includeCancelled,proto3" json:"includeCancelled,omitempty"`
+	// defaults to 20
+	PageSize int32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of the previous response
+	PageToken     string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeTotal  bool   `protobuf:"varint,6,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlantingPlansRequest) Reset() {
+	*x = ListPlantingPlansRequest{}
+	mi := &file_crop_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlantingPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlantingPlansRequest) ProtoMessage() {}
+
+func (x *ListPlantingPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlantingPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlantingPlansRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPlantingPlansRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *ListPlantingPlansRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *ListPlantingPlansRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+func (x *ListPlantingPlansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlantingPlansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlantingPlansRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+type ListPlantingPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ordered by sowing date
+	PlantingPlans []*PlantingPlan `protobuf:"bytes,1,rep,name=plantingPlans,proto3" json:"plantingPlans,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	// only set when includeTotal was requested
+	TotalCount    int64 `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlantingPlansResponse) Reset() {
+	*x = ListPlantingPlansResponse{}
+	mi := &file_crop_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlantingPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlantingPlansResponse) ProtoMessage() {}
+
+func (x *ListPlantingPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlantingPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlantingPlansResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPlantingPlansResponse) GetPlantingPlans() []*PlantingPlan {
+	if x != nil {
+		return x.PlantingPlans
+	}
+	return nil
+}
+
+func (x *ListPlantingPlansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPlantingPlansResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Empty fields are left unchanged. A new sowingDate moves the harvest date
+// along, a new harvestDate moves the sowing date.
+type UpdatePlantingPlanRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Season      string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	SowingDate  string                 `protobuf:"bytes,3,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	HarvestDate string                 `protobuf:"bytes,4,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	Status      PlantingStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=crop.PlantingStatus" json:"status,omitempty"`
+	// unset keeps the notes, empty clears them
+	Notes         *string `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlantingPlanRequest) Reset() {
+	*x = UpdatePlantingPlanRequest{}
+	mi := &file_crop_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlantingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlantingPlanRequest) ProtoMessage() {}
+
+func (x *UpdatePlantingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlantingPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlantingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdatePlantingPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanRequest) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanRequest) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanRequest) GetStatus() PlantingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PlantingStatus_PLANTING_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePlantingPlanRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type UpdatePlantingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SowingDate    string                 `protobuf:"bytes,1,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	HarvestDate   string                 `protobuf:"bytes,2,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlantingPlanResponse) Reset() {
+	*x = UpdatePlantingPlanResponse{}
+	mi := &file_crop_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlantingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlantingPlanResponse) ProtoMessage() {}
+
+func (x *UpdatePlantingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlantingPlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlantingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdatePlantingPlanResponse) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanResponse) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *UpdatePlantingPlanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeletePlantingPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlantingPlanRequest) Reset() {
+	*x = DeletePlantingPlanRequest{}
+	mi := &file_crop_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlantingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlantingPlanRequest) ProtoMessage() {}
+
+func (x *DeletePlantingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlantingPlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlantingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeletePlantingPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePlantingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlantingPlanResponse) Reset() {
+	*x = DeletePlantingPlanResponse{}
+	mi := &file_crop_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlantingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlantingPlanResponse) ProtoMessage() {}
+
+func (x *DeletePlantingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crop_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlantingPlanResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlantingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_crop_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeletePlantingPlanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_crop_proto protoreflect.FileDescriptor
+
+var file_crop_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x72,
+	0x6f, 0x70, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x03, 0x0a, 0x04, 0x43, 0x72,
+	0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x43, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x43, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x12, 0x2e, 0x0a, 0x12, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x77, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x63, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6b, 0x63, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63,
+	0x45, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6b, 0x63, 0x45, 0x6e, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4d, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4d,
+	0x12, 0x32, 0x0a, 0x14, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x72, 0x6f, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x72, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x70, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43, 0x72, 0x6f, 0x70, 0x52,
+	0x04, 0x63, 0x72, 0x6f, 0x70, 0x22, 0xa4, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3,
+	0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74,
+	0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f,
+	0x40, 0x52, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x49, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x43, 0x6d, 0x12, 0x35, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0d, 0xca, 0xf3,
+	0x18, 0x09, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x12, 0x3d, 0x0a, 0x12, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x49, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x12, 0x77, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x34, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40, 0x52, 0x10, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x12, 0x36,
+	0x0a, 0x09, 0x6b, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0xb9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x52, 0x09, 0x6b, 0x63, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x9a, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x52,
+	0x05, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6b, 0x63, 0x45, 0x6e, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x9a, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x52,
+	0x05, 0x6b, 0x63, 0x45, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x4d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14,
+	0x10, 0x01, 0x49, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xa9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x14, 0x40, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4d,
+	0x12, 0x4a, 0x0a, 0x14, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16,
+	0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x51, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x88, 0xc3, 0x40, 0x52, 0x14, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61,
+	0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3e, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02,
+	0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x72, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43,
+	0x72, 0x6f, 0x70, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x70, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x0d, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0d, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x34, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x18,
+	0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x20, 0x80, 0x08, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x7b, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x05, 0x63,
+	0x72, 0x6f, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcd, 0x07, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3,
+	0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xf3, 0x18, 0x06, 0x10,
+	0x01, 0x18, 0x01, 0x20, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x20, 0x64, 0x48, 0x01,
+	0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0e,
+	0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f, 0x40, 0x48, 0x02,
+	0x52, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x43, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x10,
+	0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x03, 0x52, 0x0c, 0x72, 0x6f,
+	0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x10, 0x01, 0x49, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x04, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x53,
+	0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x12, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x10, 0x01, 0x49,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x05, 0x52, 0x12, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x49, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x43, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18,
+	0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0xc0, 0x51, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x44, 0x40, 0x48, 0x06, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x09,
+	0x6b, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f,
+	0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x48, 0x07, 0x52, 0x09, 0x6b, 0x63, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x05, 0x6b, 0x63, 0x4d,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01,
+	0x49, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x40, 0x48, 0x08, 0x52, 0x05, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x05, 0x6b, 0x63, 0x45, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca,
+	0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f, 0x51, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x48, 0x09, 0x52, 0x05, 0x6b, 0x63, 0x45, 0x6e, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68,
+	0x4d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49,
+	0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xa9, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14,
+	0x40, 0x48, 0x0a, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x4d, 0x88,
+	0x01, 0x01, 0x12, 0x51, 0x0a, 0x14, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x88, 0xc3, 0x40, 0x48, 0x0b, 0x52, 0x14, 0x64, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x65, 0x74, 0x79, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x6f, 0x77, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43,
+	0x6d, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x43, 0x6d, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6d, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6b, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6b, 0x63, 0x4d, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6b, 0x63, 0x45,
+	0x6e, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68,
+	0x4d, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x54, 0x6f, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2e, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18,
+	0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x07,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x72, 0x6f, 0x70, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52,
+	0x06, 0x63, 0x72, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20,
+	0x32, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca,
+	0xf3, 0x18, 0x20, 0x10, 0x01, 0x2a, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x7d, 0x24, 0x52, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x46, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x10, 0x01, 0x2a, 0x1c, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x52, 0x0b, 0x68, 0x61, 0x72, 0x76,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x20, 0xe8, 0x07, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x72, 0x76,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x89, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x07, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x32, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03,
+	0x20, 0x80, 0x08, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xc7, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02,
+	0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x32, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20,
+	0x10, 0x01, 0x2a, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24,
+	0x52, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x10, 0x01, 0x2a, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x52, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04,
+	0x10, 0x01, 0x58, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18,
+	0x03, 0x20, 0xe8, 0x07, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x76,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68,
+	0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca,
+	0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2a, 0xa6, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x4c, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x48, 0x41, 0x52, 0x56, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x50,
+	0x4c, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xab, 0x08, 0x0a, 0x0b, 0x43,
+	0x72, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x70,
+	0x73, 0x12, 0x4e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x12, 0x14, 0x2e, 0x63,
+	0x72, 0x6f, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x4f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x16,
+	0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f,
+	0x70, 0x73, 0x12, 0x5a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70,
+	0x12, 0x17, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x72, 0x6f, 0x70,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x32, 0x0e,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x57,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x12, 0x17, 0x2e, 0x63,
+	0x72, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f,
+	0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f,
+	0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49,
+	0x64, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x12, 0x6e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x80, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
+	0x22, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c,
+	0x61, 0x6e, 0x73, 0x12, 0x7a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x6f, 0x70,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x6f,
+	0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x32, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x77, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x6f, 0x70, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c,
+	0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x75, 0x72, 0x69, 0x66, 0x61, 0x74, 0x2f,
+	0x67, 0x6f, 0x2d, 0x61, 0x67, 0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x6f,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_crop_proto_rawDescOnce sync.Once
+	file_crop_proto_rawDescData = file_crop_proto_rawDesc
+)
+
+func file_crop_proto_rawDescGZIP() []byte {
+	file_crop_proto_rawDescOnce.Do(func() {
+		file_crop_proto_rawDescData = protoimpl.X.CompressGZIP(file_crop_proto_rawDescData)
+	})
+	return file_crop_proto_rawDescData
+}
+
+var file_crop_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_crop_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_crop_proto_goTypes = []any{
+	(PlantingStatus)(0),                // 0: crop.PlantingStatus
+	(*Crop)(nil),                       // 1: crop.Crop
+	(*PlantingPlan)(nil),               // 2: crop.PlantingPlan
+	(*CreateCropRequest)(nil),          // 3: crop.CreateCropRequest
+	(*CreateCropResponse)(nil),         // 4: crop.CreateCropResponse
+	(*GetCropRequest)(nil),             // 5: crop.GetCropRequest
+	(*GetCropResponse)(nil),            // 6: crop.GetCropResponse
+	(*ListCropsRequest)(nil),           // 7: crop.ListCropsRequest
+	(*ListCropsResponse)(nil),          // 8: crop.ListCropsResponse
+	(*UpdateCropRequest)(nil),          // 9: crop.UpdateCropRequest
+	(*UpdateCropResponse)(nil),         // 10: crop.UpdateCropResponse
+	(*DeleteCropRequest)(nil),          // 11: crop.DeleteCropRequest
+	(*DeleteCropResponse)(nil),         // 12: crop.DeleteCropResponse
+	(*CreatePlantingPlanRequest)(nil),  // 13: crop.CreatePlantingPlanRequest
+	(*CreatePlantingPlanResponse)(nil), // 14: crop.CreatePlantingPlanResponse
+	(*GetPlantingPlanRequest)(nil),     // 15: crop.GetPlantingPlanRequest
+	(*GetPlantingPlanResponse)(nil),    // 16: crop.GetPlantingPlanResponse
+	(*ListPlantingPlansRequest)(nil),   // 17: crop.ListPlantingPlansRequest
+	(*ListPlantingPlansResponse)(nil),  // 18: crop.ListPlantingPlansResponse
+	(*UpdatePlantingPlanRequest)(nil),  // 19: crop.UpdatePlantingPlanRequest
+	(*UpdatePlantingPlanResponse)(nil), // 20: crop.UpdatePlantingPlanResponse
+	(*DeletePlantingPlanRequest)(nil),  // 21: crop.DeletePlantingPlanRequest
+	(*DeletePlantingPlanResponse)(nil), // 22: crop.DeletePlantingPlanResponse
+}
+var file_crop_proto_depIdxs = []int32{
+	0,  // 0: crop.PlantingPlan.status:type_name -> crop.PlantingStatus
+	1,  // 1: crop.PlantingPlan.crop:type_name -> crop.Crop
+	1,  // 2: crop.GetCropResponse.crop:type_name -> crop.Crop
+	1,  // 3: crop.ListCropsResponse.crops:type_name -> crop.Crop
+	2,  // 4: crop.GetPlantingPlanResponse.plantingPlan:type_name -> crop.PlantingPlan
+	2,  // 5: crop.ListPlantingPlansResponse.plantingPlans:type_name -> crop.PlantingPlan
+	0,  // 6: crop.UpdatePlantingPlanRequest.status:type_name -> crop.PlantingStatus
+	3,  // 7: crop.CropService.CreateCrop:input_type -> crop.CreateCropRequest
+	5,  // 8: crop.CropService.GetCrop:input_type -> crop.GetCropRequest
+	7,  // 9: crop.CropService.ListCrops:input_type -> crop.ListCropsRequest
+	9,  // 10: crop.CropService.UpdateCrop:input_type -> crop.UpdateCropRequest
+	11, // 11: crop.CropService.DeleteCrop:input_type -> crop.DeleteCropRequest
+	13, // 12: crop.CropService.CreatePlantingPlan:input_type -> crop.CreatePlantingPlanRequest
+	15, // 13: crop.CropService.GetPlantingPlan:input_type -> crop.GetPlantingPlanRequest
+	17, // 14: crop.CropService.ListPlantingPlans:input_type -> crop.ListPlantingPlansRequest
+	19, // 15: crop.CropService.UpdatePlantingPlan:input_type -> crop.UpdatePlantingPlanRequest
+	21, // 16: crop.CropService.DeletePlantingPlan:input_type -> crop.DeletePlantingPlanRequest
+	4,  // 17: crop.CropService.CreateCrop:output_type -> crop.CreateCropResponse
+	6,  // 18: crop.CropService.GetCrop:output_type -> crop.GetCropResponse
+	8,  // 19: crop.CropService.ListCrops:output_type -> crop.ListCropsResponse
+	10, // 20: crop.CropService.UpdateCrop:output_type -> crop.UpdateCropResponse
+	12, // 21: crop.CropService.DeleteCrop:output_type -> crop.DeleteCropResponse
+	14, // 22: crop.CropService.CreatePlantingPlan:output_type -> crop.CreatePlantingPlanResponse
+	16, // 23: crop.CropService.GetPlantingPlan:output_type -> crop.GetPlantingPlanResponse
+	18, // 24: crop.CropService.ListPlantingPlans:output_type -> crop.ListPlantingPlansResponse
+	20, // 25: crop.CropService.UpdatePlantingPlan:output_type -> crop.UpdatePlantingPlanResponse
+	22, // 26: crop.CropService.DeletePlantingPlan:output_type -> crop.DeletePlantingPlanResponse
+	17, // [17:27] is the sub-list for method output_type
+	7,  // [7:17] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_crop_proto_init() }
+func file_crop_proto_init() {
+	if File_crop_proto != nil {
+		return
+	}
+	file_crop_proto_msgTypes[8].OneofWrappers = []any{}
+	file_crop_proto_msgTypes[18].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_crop_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_crop_proto_goTypes,
+		DependencyIndexes: file_crop_proto_depIdxs,
+		EnumInfos:         file_crop_proto_enumTypes,
+		MessageInfos:      file_crop_proto_msgTypes,
+	}.Build()
+	File_crop_proto = out.File
+	file_crop_proto_rawDesc = nil
+	file_crop_proto_goTypes = nil
+	file_crop_proto_depIdxs = nil
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: crop.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CropService_CreateCrop_FullMethodName         = "/crop.CropService/CreateCrop"
+	CropService_GetCrop_FullMethodName            = "/crop.CropService/GetCrop"
+	CropService_ListCrops_FullMethodName          = "/crop.CropService/ListCrops"
+	CropService_UpdateCrop_FullMethodName         = "/crop.CropService/UpdateCrop"
+	CropService_DeleteCrop_FullMethodName         = "/crop.CropService/DeleteCrop"
+	CropService_CreatePlantingPlan_FullMethodName = "/crop.CropService/CreatePlantingPlan"
+	CropService_GetPlantingPlan_FullMethodName    = "/crop.CropService/GetPlantingPlan"
+	CropService_ListPlantingPlans_FullMethodName  = "/crop.CropService/ListPlantingPlans"
+	CropService_UpdatePlantingPlan_FullMethodName = "/crop.CropService/UpdatePlantingPlan"
+	CropService_DeletePlantingPlan_FullMethodName = "/crop.CropService/DeletePlantingPlan"
+)
+
+// CropServiceClient is the client API for CropService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CropServiceClient interface {
+	CreateCrop(ctx context.Context, in *CreateCropRequest, opts ...grpc.CallOption) (*CreateCropResponse, error)
+	GetCrop(ctx context.Context, in *GetCropRequest, opts ...grpc.CallOption) (*GetCropResponse, error)
+	ListCrops(ctx context.Context, in *ListCropsRequest, opts ...grpc.CallOption) (*ListCropsResponse, error)
+	UpdateCrop(ctx context.Context, in *UpdateCropRequest, opts ...grpc.CallOption) (*UpdateCropResponse, error)
+	DeleteCrop(ctx context.Context, in *DeleteCropRequest, opts ...grpc.CallOption) (*DeleteCropResponse, error)
+	CreatePlantingPlan(ctx context.Context, in *CreatePlantingPlanRequest, opts ...grpc.CallOption) (*CreatePlantingPlanResponse, error)
+	GetPlantingPlan(ctx context.Context, in *GetPlantingPlanRequest, opts ...grpc.CallOption) (*GetPlantingPlanResponse, error)
+	ListPlantingPlans(ctx context.Context, in *ListPlantingPlansRequest, opts ...grpc.CallOption) (*ListPlantingPlansResponse, error)
+	UpdatePlantingPlan(ctx context.Context, in *UpdatePlantingPlanRequest, opts ...grpc.CallOption) (*UpdatePlantingPlanResponse, error)
+	DeletePlantingPlan(ctx context.Context, in *DeletePlantingPlanRequest, opts ...grpc.CallOption) (*DeletePlantingPlanResponse, error)
+}
+
+type cropServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCropServiceClient(cc grpc.ClientConnInterface) CropServiceClient {
+	return &cropServiceClient{cc}
+}
+
+func (c *cropServiceClient) CreateCrop(ctx context.Context, in *CreateCropRequest, opts ...grpc.CallOption) (*CreateCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCropResponse)
+	err := c.cc.Invoke(ctx, CropService_CreateCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) GetCrop(ctx context.Context, in *GetCropRequest, opts ...grpc.CallOption) (*GetCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCropResponse)
+	err := c.cc.Invoke(ctx, CropService_GetCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) ListCrops(ctx context.Context, in *ListCropsRequest, opts ...grpc.CallOption) (*ListCropsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCropsResponse)
+	err := c.cc.Invoke(ctx, CropService_ListCrops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) UpdateCrop(ctx context.Context, in *UpdateCropRequest, opts ...grpc.CallOption) (*UpdateCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCropResponse)
+	err := c.cc.Invoke(ctx, CropService_UpdateCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) DeleteCrop(ctx context.Context, in *DeleteCropRequest, opts ...grpc.CallOption) (*DeleteCropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCropResponse)
+	err := c.cc.Invoke(ctx, CropService_DeleteCrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) CreatePlantingPlan(ctx context.Context, in *CreatePlantingPlanRequest, opts ...grpc.CallOption) (*CreatePlantingPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlantingPlanResponse)
+	err := c.cc.Invoke(ctx, CropService_CreatePlantingPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) GetPlantingPlan(ctx context.Context, in *GetPlantingPlanRequest, opts ...grpc.CallOption) (*GetPlantingPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlantingPlanResponse)
+	err := c.cc.Invoke(ctx, CropService_GetPlantingPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) ListPlantingPlans(ctx context.Context, in *ListPlantingPlansRequest, opts ...grpc.CallOption) (*ListPlantingPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlantingPlansResponse)
+	err := c.cc.Invoke(ctx, CropService_ListPlantingPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) UpdatePlantingPlan(ctx context.Context, in *UpdatePlantingPlanRequest, opts ...grpc.CallOption) (*UpdatePlantingPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlantingPlanResponse)
+	err := c.cc.Invoke(ctx, CropService_UpdatePlantingPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cropServiceClient) DeletePlantingPlan(ctx context.Context, in *DeletePlantingPlanRequest, opts ...grpc.CallOption) (*DeletePlantingPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlantingPlanResponse)
+	err := c.cc.Invoke(ctx, CropService_DeletePlantingPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CropServiceServer is the server API for CropService service.
+// All implementations must embed UnimplementedCropServiceServer
+// for forward compatibility.
+type CropServiceServer interface {
+	CreateCrop(context.Context, *CreateCropRequest) (*CreateCropResponse, error)
+	GetCrop(context.Context, *GetCropRequest) (*GetCropResponse, error)
+	ListCrops(context.Context, *ListCropsRequest) (*ListCropsResponse, error)
+	UpdateCrop(context.Context, *UpdateCropRequest) (*UpdateCropResponse, error)
+	DeleteCrop(context.Context, *DeleteCropRequest) (*DeleteCropResponse, error)
+	CreatePlantingPlan(context.Context, *CreatePlantingPlanRequest) (*CreatePlantingPlanResponse, error)
+	GetPlantingPlan(context.Context, *GetPlantingPlanRequest) (*GetPlantingPlanResponse, error)
+	ListPlantingPlans(context.Context, *ListPlantingPlansRequest) (*ListPlantingPlansResponse, error)
+	UpdatePlantingPlan(context.Context, *UpdatePlantingPlanRequest) (*UpdatePlantingPlanResponse, error)
+	DeletePlantingPlan(context.Context, *DeletePlantingPlanRequest) (*DeletePlantingPlanResponse, error)
+	mustEmbedUnimplementedCropServiceServer()
+}
+
+// UnimplementedCropServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCropServiceServer struct{}
+
+func (UnimplementedCropServiceServer) CreateCrop(context.Context, *CreateCropRequest) (*CreateCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCrop not implemented")
+}
+func (UnimplementedCropServiceServer) GetCrop(context.Context, *GetCropRequest) (*GetCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrop not implemented")
+}
+func (UnimplementedCropServiceServer) ListCrops(context.Context, *ListCropsRequest) (*ListCropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrops not implemented")
+}
+func (UnimplementedCropServiceServer) UpdateCrop(context.Context, *UpdateCropRequest) (*UpdateCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrop not implemented")
+}
+func (UnimplementedCropServiceServer) DeleteCrop(context.Context, *DeleteCropRequest) (*DeleteCropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCrop not implemented")
+}
+func (UnimplementedCropServiceServer) CreatePlantingPlan(context.Context, *CreatePlantingPlanRequest) (*CreatePlantingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlantingPlan not implemented")
+}
+func (UnimplementedCropServiceServer) GetPlantingPlan(context.Context, *GetPlantingPlanRequest) (*GetPlantingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlantingPlan not implemented")
+}
+func (UnimplementedCropServiceServer) ListPlantingPlans(context.Context, *ListPlantingPlansRequest) (*ListPlantingPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlantingPlans not implemented")
+}
+func (UnimplementedCropServiceServer) UpdatePlantingPlan(context.Context, *UpdatePlantingPlanRequest) (*UpdatePlantingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlantingPlan not implemented")
+}
+func (UnimplementedCropServiceServer) DeletePlantingPlan(context.Context, *DeletePlantingPlanRequest) (*DeletePlantingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlantingPlan not implemented")
+}
+func (UnimplementedCropServiceServer) mustEmbedUnimplementedCropServiceServer() {}
+func (UnimplementedCropServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCropServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CropServiceServer will
+// result in compilation errors.
+type UnsafeCropServiceServer interface {
+	mustEmbedUnimplementedCropServiceServer()
+}
+
+func RegisterCropServiceServer(s grpc.ServiceRegistrar, srv CropServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCropServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CropService_ServiceDesc, srv)
+}
+
+func _CropService_CreateCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).CreateCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_CreateCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).CreateCrop(ctx, req.(*CreateCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_GetCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).GetCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_GetCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).GetCrop(ctx, req.(*GetCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_ListCrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).ListCrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_ListCrops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).ListCrops(ctx, req.(*ListCropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_UpdateCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).UpdateCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_UpdateCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).UpdateCrop(ctx, req.(*UpdateCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_DeleteCrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).DeleteCrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_DeleteCrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).DeleteCrop(ctx, req.(*DeleteCropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_CreatePlantingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlantingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).CreatePlantingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_CreatePlantingPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).CreatePlantingPlan(ctx, req.(*CreatePlantingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_GetPlantingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlantingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).GetPlantingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_GetPlantingPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).GetPlantingPlan(ctx, req.(*GetPlantingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_ListPlantingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlantingPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).ListPlantingPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_ListPlantingPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).ListPlantingPlans(ctx, req.(*ListPlantingPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_UpdatePlantingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlantingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).UpdatePlantingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_UpdatePlantingPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).UpdatePlantingPlan(ctx, req.(*UpdatePlantingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CropService_DeletePlantingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlantingPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CropServiceServer).DeletePlantingPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CropService_DeletePlantingPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CropServiceServer).DeletePlantingPlan(ctx, req.(*DeletePlantingPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CropService_ServiceDesc is the grpc.ServiceDesc for CropService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CropService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crop.CropService",
+	HandlerType: (*CropServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCrop",
+			Handler:    _CropService_CreateCrop_Handler,
+		},
+		{
+			MethodName: "GetCrop",
+			Handler:    _CropService_GetCrop_Handler,
+		},
+		{
+			MethodName: "ListCrops",
+			Handler:    _CropService_ListCrops_Handler,
+		},
+		{
+			MethodName: "UpdateCrop",
+			Handler:    _CropService_UpdateCrop_Handler,
+		},
+		{
+			MethodName: "DeleteCrop",
+			Handler:    _CropService_DeleteCrop_Handler,
+		},
+		{
+			MethodName: "CreatePlantingPlan",
+			Handler:    _CropService_CreatePlantingPlan_Handler,
+		},
+		{
+			MethodName: "GetPlantingPlan",
+			Handler:    _CropService_GetPlantingPlan_Handler,
+		},
+		{
+			MethodName: "ListPlantingPlans",
+			Handler:    _CropService_ListPlantingPlans_Handler,
+		},
+		{
+			MethodName: "UpdatePlantingPlan",
+			Handler:    _CropService_UpdatePlantingPlan_Handler,
+		},
+		{
+			MethodName: "DeletePlantingPlan",
+			Handler:    _CropService_DeletePlantingPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crop.proto",
+}
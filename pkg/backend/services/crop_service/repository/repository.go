@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DateLayout is the format of planting dates in requests and page tokens.
+const DateLayout = "2006-01-02"
+
+func CreateCrop(db *gorm.DB, crop *api.Crop) (string, error) {
+	result := db.Create(crop)
+	if result.Error != nil {
+		return "", repoerr.FromGorm(result.Error, "failed to insert crop")
+	}
+	return crop.ID, nil
+}
+
+func GetCrop(db *gorm.DB, id string) (*api.Crop, error) {
+	if err := validateID("id", id); err != nil {
+		return nil, err
+	}
+	var crop api.Crop
+	result := db.First(&crop, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no crop found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch crop")
+	}
+	return &crop, nil
+}
+
+// ListCrops returns the crops whose species starts with speciesPrefix, in
+// catalogue order.
+func ListCrops(db *gorm.DB, speciesPrefix string, q pagination.Query) (*pagination.Page[api.Crop], error) {
+	q.Sort = pagination.Sort{Column: "species"}
+	tx := db.Model(&api.Crop{})
+	if speciesPrefix != "" {
+		tx = tx.Where("species ILIKE ?", pagination.EscapeLike(speciesPrefix)+"%")
+	}
+	return pagination.List(tx, q, func(c *api.Crop) (string, string) {
+		return c.Species, c.ID
+	})
+}
+
+// UpdateCrop writes the given columns of crop to the crop with the given id,
+// including zero values.
+func UpdateCrop(db *gorm.DB, id string, crop *api.Crop, columns []string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	result := db.Model(&api.Crop{}).Where("id = ?", id).Select(columns).Updates(crop)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to update crop")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no crop found with ID: %s", id)
+	}
+	return nil
+}
+
+func DeleteCrop(db *gorm.DB, id string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	var plans int64
+	if err := db.Model(&api.PlantingPlan{}).Where("crop_id = ?", id).Count(&plans).Error; err != nil {
+		return repoerr.FromGorm(err, "failed to count planting plans")
+	}
+	if plans > 0 {
+		return repoerr.Conflict("id", "crop %s is used by %d planting plans", id, plans)
+	}
+	result := db.Where("id = ?", id).Delete(&api.Crop{})
+	if err := result.Error; err != nil {
+		return repoerr.FromGorm(err, "failed to delete crop")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no crop found with ID: %s", id)
+	}
+	return nil
+}
+
+// CreatePlantingPlan stores plan unless it overlaps another plan of the
+// field that is not cancelled.
+func CreatePlantingPlan(db *gorm.DB, plan *api.PlantingPlan) (string, error) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := checkOverlap(tx, plan); err != nil {
+			return err
+		}
+		if err := tx.Omit(clause.Associations).Create(plan).Error; err != nil {
+			return repoerr.FromGorm(err, "failed to insert planting plan")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return plan.ID, nil
+}
+
+func GetPlantingPlan(db *gorm.DB, id string) (*api.PlantingPlan, error) {
+	if err := validateID("id", id); err != nil {
+		return nil, err
+	}
+	var plan api.PlantingPlan
+	result := db.Preload("Crop").First(&plan, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no planting plan found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch planting plan")
+	}
+	return &plan, nil
+}
+
+// PlanFilter narrows a planting plan listing. Zero fields are ignored.
+type PlanFilter struct {
+	Season           string
+	IncludeCancelled bool
+}
+
+// ListPlantingPlans returns the plans of fieldID ordered by sowing date.
+func ListPlantingPlans(db *gorm.DB, fieldID string, filter PlanFilter, q pagination.Query) (*pagination.Page[api.PlantingPlan], error) {
+	if err := validateID("fieldId", fieldID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "sowing_date"}
+	tx := db.Model(&api.PlantingPlan{}).Preload("Crop").Where("field_id = ?", fieldID)
+	if filter.Season != "" {
+		tx = tx.Where("season = ?", filter.Season)
+	}
+	if !filter.IncludeCancelled {
+		tx = tx.Where("status <> ?", api.PlantingStatusCancelled)
+	}
+	return pagination.List(tx, q, func(p *api.PlantingPlan) (string, string) {
+		return p.SowingDate.Format(DateLayout), p.ID
+	})
+}
+
+// UpdatePlantingPlan saves plan, which must have been loaded with
+// GetPlantingPlan, unless its new dates overlap another plan of the field.
+func UpdatePlantingPlan(db *gorm.DB, plan *api.PlantingPlan) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if plan.Status != api.PlantingStatusCancelled {
+			if err := checkOverlap(tx, plan); err != nil {
+				return err
+			}
+		}
+		result := tx.Model(plan).
+			Select("season", "sowing_date", "harvest_date", "status", "notes").
+			Updates(plan)
+		if result.Error != nil {
+			return repoerr.FromGorm(result.Error, "failed to update planting plan")
+		}
+		if result.RowsAffected == 0 {
+			return repoerr.NotFound("id", "no planting plan found with ID: %s", plan.ID)
+		}
+		return nil
+	})
+}
+
+// GetActivePlantingPlan returns the first plan of fieldID that is planned or
+// sown and on the field between from and to.
+func GetActivePlantingPlan(db *gorm.DB, fieldID string, from, to time.Time) (*api.PlantingPlan, error) {
+	if err := validateID("fieldId", fieldID); err != nil {
+		return nil, err
+	}
+	var plan api.PlantingPlan
+	result := activePlans(db, from, to).Preload("Crop").Where("field_id = ?", fieldID).Order("sowing_date").First(&plan)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("fieldId", "no planting plan of field %s between %s and %s",
+			fieldID, from.Format(DateLayout), to.Format(DateLayout))
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch planting plan")
+	}
+	return &plan, nil
+}
+
+// ListActivePlantingFields returns the fields with a plan that is planned or
+// sown and on the field between from and to.
+func ListActivePlantingFields(db *gorm.DB, from, to time.Time) ([]string, error) {
+	var fieldIDs []string
+	if err := activePlans(db, from, to).Distinct("field_id").Pluck("field_id", &fieldIDs).Error; err != nil {
+		return nil, repoerr.FromGorm(err, "failed to list planted fields")
+	}
+	return fieldIDs, nil
+}
+
+// ListActivePlantingPlans returns the plans of fieldID that are planned or
+// sown and on the field between from and to, ordered by sowing date.
+func ListActivePlantingPlans(db *gorm.DB, fieldID string, from, to time.Time) ([]api.PlantingPlan, error) {
+	if err := validateID("fieldId", fieldID); err != nil {
+		return nil, err
+	}
+	var plans []api.PlantingPlan
+	result := activePlans(db, from, to).Where("field_id = ?", fieldID).Order("sowing_date, id").Find(&plans)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to list planting plans")
+	}
+	return plans, nil
+}
+
+// ListActiveFarmPlantingPlans returns the plans of the fields of farmID that
+// are planned or sown and on the field between from and to, ordered by
+// sowing date.
+func ListActiveFarmPlantingPlans(db *gorm.DB, farmID string, from, to time.Time) ([]api.PlantingPlan, error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	var plans []api.PlantingPlan
+	result := activePlans(db, from, to).Select("planting_plans.*").Preload("Crop").
+		Joins("JOIN fields ON fields.id = planting_plans.field_id").Where("fields.farm_id = ?", farmID).
+		Order("sowing_date, planting_plans.id").Find(&plans)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to list planting plans")
+	}
+	return plans, nil
+}
+
+func activePlans(db *gorm.DB, from, to time.Time) *gorm.DB {
+	return db.Model(&api.PlantingPlan{}).
+		Where("status IN ?", []string{api.PlantingStatusPlanned, api.PlantingStatusSown}).
+		Where("sowing_date < ? AND harvest_date > ?", to.Format(DateLayout), from.Format(DateLayout))
+}
+
+func DeletePlantingPlan(db *gorm.DB, id string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	result := db.Where("id = ?", id).Delete(&api.PlantingPlan{})
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to delete planting plan")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no planting plan found with ID: %s", id)
+	}
+	return nil
+}
+
+// checkOverlap reports the first plan of the field that overlaps plan. The
+// exclusion constraint on planting_plans guards against concurrent writers,
+// this check names the conflicting plan.
+func checkOverlap(tx *gorm.DB, plan *api.PlantingPlan) error {
+	if err := validateID("fieldId", plan.FieldID); err != nil {
+		return err
+	}
+	var other api.PlantingPlan
+	query := tx.Where("field_id = ? AND status <> ?", plan.FieldID, api.PlantingStatusCancelled).
+		Where("sowing_date < ? AND harvest_date > ?", plan.HarvestDate.Format(DateLayout), plan.SowingDate.Format(DateLayout))
+	if plan.ID != "" {
+		query = query.Where("id <> ?", plan.ID)
+	}
+	result := query.Order("sowing_date").Limit(1).Find(&other)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to check planting plans")
+	}
+	if result.RowsAffected > 0 {
+		return repoerr.Conflict("sowingDate", "field already has planting plan %s from %s to %s",
+			other.ID, other.SowingDate.Format(DateLayout), other.HarvestDate.Format(DateLayout))
+	}
+	return nil
+}
+
+func validateID(field, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return repoerr.InvalidArgument(field, "invalid uid format: %v", err)
+	}
+	return nil
+}
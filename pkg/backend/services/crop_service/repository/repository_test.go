@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const boundary = `{"type":"Polygon","coordinates":[[[10,50],[10.01,50],[10.01,50.01],[10,50.01],[10,50]]]}`
+
+// fixture creates a field and a crop taking 100 days to mature.
+func fixture(t *testing.T, db *gorm.DB) (fieldID string, crop *api.Crop) {
+	t.Helper()
+	owner := &api.User{Username: "ada", Email: "ada@example.com", Password: "x"}
+	if err := db.Create(owner).Error; err != nil {
+		t.Fatal(err)
+	}
+	farm := &api.Farm{OwnerID: owner.ID, Name: "Home"}
+	if err := db.Create(farm).Error; err != nil {
+		t.Fatal(err)
+	}
+	field := &api.Field{FarmID: farm.ID, Name: "North", Boundary: boundary, AreaHectares: 1}
+	if err := db.Create(field).Error; err != nil {
+		t.Fatal(err)
+	}
+	crop = &api.Crop{Species: "Wheat", DaysToMaturity: 100}
+	if _, err := CreateCrop(db, crop); err != nil {
+		t.Fatal(err)
+	}
+	return field.ID, crop
+}
+
+func date(s string) time.Time {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func plan(fieldID string, crop *api.Crop, sowing, harvest string) *api.PlantingPlan {
+	return &api.PlantingPlan{
+		FieldID:     fieldID,
+		CropID:      crop.ID,
+		Season:      "2025",
+		Status:      api.PlantingStatusPlanned,
+		SowingDate:  date(sowing),
+		HarvestDate: date(harvest),
+	}
+}
+
+func wantConflict(t *testing.T, err error, otherID string) {
+	t.Helper()
+	if repoerr.KindOf(err) != repoerr.KindConflict {
+		t.Fatalf("error = %v, want a conflict", err)
+	}
+	if otherID != "" && !strings.Contains(err.Error(), otherID) {
+		t.Errorf("error = %v, want it to name plan %s", err, otherID)
+	}
+}
+
+func TestCreatePlantingPlanOverlap(t *testing.T) {
+	db := dbtest.Postgres(t)
+	fieldID, crop := fixture(t, db)
+	spring, err := CreatePlantingPlan(db, plan(fieldID, crop, "2025-03-01", "2025-06-09"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		sowing, harvest string
+		overlaps        bool
+	}{
+		{"inside", "2025-04-01", "2025-05-01", true},
+		{"around", "2025-02-01", "2025-07-01", true},
+		{"ends after sowing", "2025-01-01", "2025-03-02", true},
+		{"starts before harvest", "2025-06-08", "2025-09-01", true},
+		{"ends on sowing", "2024-11-21", "2025-03-01", false},
+		{"starts on harvest", "2025-06-09", "2025-09-17", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.Begin()
+			defer tx.Rollback()
+			_, err := CreatePlantingPlan(tx, plan(fieldID, crop, tt.sowing, tt.harvest))
+			if tt.overlaps {
+				wantConflict(t, err, spring)
+			} else if err != nil {
+				t.Errorf("CreatePlantingPlan() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdatePlantingPlanOverlap(t *testing.T) {
+	db := dbtest.Postgres(t)
+	fieldID, crop := fixture(t, db)
+	spring, err := CreatePlantingPlan(db, plan(fieldID, crop, "2025-03-01", "2025-06-09"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	autumnID, err := CreatePlantingPlan(db, plan(fieldID, crop, "2025-09-01", "2025-12-10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// moving a plan over its own dates is no overlap
+	autumn, err := GetPlantingPlan(db, autumnID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	autumn.SowingDate, autumn.HarvestDate = date("2025-08-15"), date("2025-11-23")
+	if err := UpdatePlantingPlan(db, autumn); err != nil {
+		t.Fatalf("UpdatePlantingPlan() error = %v", err)
+	}
+
+	autumn.SowingDate, autumn.HarvestDate = date("2025-06-01"), date("2025-09-09")
+	wantConflict(t, UpdatePlantingPlan(db, autumn), spring)
+
+	// cancelling frees the dates, reinstating takes them back
+	autumn, err = GetPlantingPlan(db, autumnID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	autumn.Status = api.PlantingStatusCancelled
+	if err := UpdatePlantingPlan(db, autumn); err != nil {
+		t.Fatalf("UpdatePlantingPlan() error = %v", err)
+	}
+	replacement, err := CreatePlantingPlan(db, plan(fieldID, crop, "2025-08-01", "2025-11-09"))
+	if err != nil {
+		t.Fatalf("CreatePlantingPlan() over a cancelled plan error = %v", err)
+	}
+	autumn.Status = api.PlantingStatusPlanned
+	wantConflict(t, UpdatePlantingPlan(db, autumn), replacement)
+
+	// a cancelled plan may be moved anywhere
+	autumn.Status = api.PlantingStatusCancelled
+	autumn.SowingDate, autumn.HarvestDate = date("2025-03-01"), date("2025-06-09")
+	if err := UpdatePlantingPlan(db, autumn); err != nil {
+		t.Errorf("UpdatePlantingPlan() of a cancelled plan error = %v", err)
+	}
+}
+
+func TestNoOverlapConstraint(t *testing.T) {
+	db := dbtest.Postgres(t)
+	fieldID, crop := fixture(t, db)
+	if _, err := CreatePlantingPlan(db, plan(fieldID, crop, "2025-03-01", "2025-06-09")); err != nil {
+		t.Fatal(err)
+	}
+
+	// as a writer racing past checkOverlap would
+	overlapping := plan(fieldID, crop, "2025-04-01", "2025-07-10")
+	err := db.Omit(clause.Associations).Create(overlapping).Error
+	wantConflict(t, repoerr.FromGorm(err, "failed to insert planting plan"), "")
+
+	cancelled := plan(fieldID, crop, "2025-04-01", "2025-07-10")
+	cancelled.Status = api.PlantingStatusCancelled
+	if err := db.Omit(clause.Associations).Create(cancelled).Error; err != nil {
+		t.Fatalf("inserting a cancelled plan error = %v", err)
+	}
+	err = db.Model(cancelled).Update("status", api.PlantingStatusPlanned).Error
+	wantConflict(t, repoerr.FromGorm(err, "failed to update planting plan"), "")
+}
@@ -0,0 +1,340 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/spatial"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// OwnerRole is bound to the creator of a farm in the farm's scope.
+const OwnerRole = "owner"
+
+type FarmHandler struct {
+	proto.UnimplementedFarmServiceServer
+	db      *gorm.DB
+	checker *rbac.Checker
+	// index is nil when MongoDB is not configured
+	index  *spatial.Index
+	logger *zap.Logger
+}
+
+func NewFarmHandler(db *gorm.DB, checker *rbac.Checker, index *spatial.Index, logger *zap.Logger) *FarmHandler {
+	farmHandler := FarmHandler{
+		db:      db,
+		checker: checker,
+		index:   index,
+		logger:  logger,
+	}
+	return &farmHandler
+}
+
+func (h *FarmHandler) CreateFarm(ctx context.Context, req *proto.CreateFarmRequest) (*proto.CreateFarmResponse, error) {
+	principal := auth.FromContext(ctx)
+	if principal == nil {
+		return nil, grpcerr.InvalidArgument("ownerId", "no owner given")
+	}
+	farm := &api.Farm{
+		OwnerID:        principal.UserID,
+		OrganisationID: req.GetOrganisationId(),
+		Name:           req.GetName(),
+		Address:        req.GetAddress(),
+	}
+	if req.GetBoundary() != "" {
+		boundary, err := parseBoundary(req.GetBoundary())
+		if err != nil {
+			return nil, err
+		}
+		raw := boundary.GeoJSON()
+		farm.Boundary = &raw
+		farm.AreaHectares = boundary.Hectares()
+	}
+
+	id, err := repository.CreateFarm(h.db.WithContext(ctx), farm, OwnerRole)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create farm")
+	}
+	logging.WithContext(ctx, h.logger).Info("Farm created", zap.String("farm_id", id))
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return index.PutFarm(ctx, farm)
+	})
+
+	return &proto.CreateFarmResponse{
+		Id:           id,
+		AreaHectares: farm.AreaHectares,
+		Message:      "Farm created successfully",
+	}, nil
+}
+
+func (h *FarmHandler) GetFarm(ctx context.Context, req *proto.GetFarmRequest) (*proto.GetFarmResponse, error) {
+	farm, err := repository.GetFarm(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get farm")
+	}
+	return &proto.GetFarmResponse{Farm: farmToProto(farm)}, nil
+}
+
+func (h *FarmHandler) ListFarms(ctx context.Context, req *proto.ListFarmsRequest) (*proto.ListFarmsResponse, error) {
+	ownerID := req.GetOwnerId()
+	if ownerID == "" {
+		// no owner means the caller lists their own farms
+		principal := auth.FromContext(ctx)
+		if principal == nil {
+			return nil, grpcerr.InvalidArgument("ownerId", "no owner id given")
+		}
+		ownerID = principal.UserID
+	}
+
+	page, err := repository.ListFarms(h.db.WithContext(ctx), ownerID, pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list farms")
+	}
+
+	var farmList []*proto.Farm
+	for _, f := range page.Items {
+		farmList = append(farmList, farmToProto(f))
+	}
+	return &proto.ListFarmsResponse{
+		Farms:         farmList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *FarmHandler) UpdateFarm(ctx context.Context, req *proto.UpdateFarmRequest) (*proto.UpdateFarmResponse, error) {
+	updatedFarm := &api.Farm{
+		Name:    req.GetName(),
+		Address: req.GetAddress(),
+	}
+	if req.GetBoundary() != "" {
+		boundary, err := parseBoundary(req.GetBoundary())
+		if err != nil {
+			return nil, err
+		}
+		raw := boundary.GeoJSON()
+		updatedFarm.Boundary = &raw
+		updatedFarm.AreaHectares = boundary.Hectares()
+	}
+
+	db := h.db.WithContext(ctx)
+	if err := repository.UpdateFarm(db, req.GetId(), updatedFarm, req.GetClearBoundary()); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update farm")
+	}
+	farm, err := repository.GetFarm(db, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get farm")
+	}
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return index.PutFarm(ctx, farm)
+	})
+
+	return &proto.UpdateFarmResponse{
+		AreaHectares: farm.AreaHectares,
+		Message:      "Farm updated successfully",
+	}, nil
+}
+
+func (h *FarmHandler) DeleteFarm(ctx context.Context, req *proto.DeleteFarmRequest) (*proto.DeleteFarmResponse, error) {
+	err := repository.DeleteFarm(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete farm")
+	}
+	logging.WithContext(ctx, h.logger).Info("Farm deleted", zap.String("farm_id", req.GetId()))
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return index.DeleteFarm(ctx, req.GetId())
+	})
+	return &proto.DeleteFarmResponse{
+		Message: "Farm deleted successfully",
+	}, nil
+}
+
+func (h *FarmHandler) CreateField(ctx context.Context, req *proto.CreateFieldRequest) (*proto.CreateFieldResponse, error) {
+	boundary, err := parseBoundary(req.GetBoundary())
+	if err != nil {
+		return nil, err
+	}
+	field := &api.Field{
+		FarmID:       req.GetFarmId(),
+		Name:         req.GetName(),
+		SoilType:     soilTypeName(req.GetSoilType()),
+		Boundary:     boundary.GeoJSON(),
+		AreaHectares: boundary.Hectares(),
+	}
+
+	id, err := repository.CreateField(h.db.WithContext(ctx), field)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create field")
+	}
+	logging.WithContext(ctx, h.logger).Info("Field created", zap.String("field_id", id), zap.String("farm_id", field.FarmID))
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return h.indexField(ctx, index, field)
+	})
+
+	return &proto.CreateFieldResponse{
+		Id:           id,
+		AreaHectares: field.AreaHectares,
+		Message:      "Field created successfully",
+	}, nil
+}
+
+func (h *FarmHandler) GetField(ctx context.Context, req *proto.GetFieldRequest) (*proto.GetFieldResponse, error) {
+	field, err := repository.GetField(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get field")
+	}
+	return &proto.GetFieldResponse{Field: fieldToProto(field)}, nil
+}
+
+func (h *FarmHandler) ListFields(ctx context.Context, req *proto.ListFieldsRequest) (*proto.ListFieldsResponse, error) {
+	page, err := repository.ListFields(h.db.WithContext(ctx), req.GetFarmId(), pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list fields")
+	}
+
+	var fieldList []*proto.Field
+	for _, f := range page.Items {
+		fieldList = append(fieldList, fieldToProto(f))
+	}
+	return &proto.ListFieldsResponse{
+		Fields:        fieldList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *FarmHandler) UpdateField(ctx context.Context, req *proto.UpdateFieldRequest) (*proto.UpdateFieldResponse, error) {
+	updatedField := &api.Field{
+		Name:     req.GetName(),
+		SoilType: soilTypeName(req.GetSoilType()),
+	}
+	if req.GetBoundary() != "" {
+		boundary, err := parseBoundary(req.GetBoundary())
+		if err != nil {
+			return nil, err
+		}
+		updatedField.Boundary = boundary.GeoJSON()
+		updatedField.AreaHectares = boundary.Hectares()
+	}
+
+	db := h.db.WithContext(ctx)
+	if err := repository.UpdateField(db, req.GetId(), updatedField); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update field")
+	}
+	field, err := repository.GetField(db, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get field")
+	}
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return h.indexField(ctx, index, field)
+	})
+
+	return &proto.UpdateFieldResponse{
+		AreaHectares: field.AreaHectares,
+		Message:      "Field updated successfully",
+	}, nil
+}
+
+func (h *FarmHandler) DeleteField(ctx context.Context, req *proto.DeleteFieldRequest) (*proto.DeleteFieldResponse, error) {
+	err := repository.DeleteField(h.db.WithContext(ctx), req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete field")
+	}
+	logging.WithContext(ctx, h.logger).Info("Field deleted", zap.String("field_id", req.GetId()))
+	h.updateIndex(ctx, func(index *spatial.Index) error {
+		return index.DeleteField(ctx, req.GetId())
+	})
+	return &proto.DeleteFieldResponse{
+		Message: "Field deleted successfully",
+	}, nil
+}
+
+// updateIndex applies a change to the spatial index after it was committed
+// to Postgres. Failures are only logged since the index is rebuilt from
+// Postgres on start.
+func (h *FarmHandler) updateIndex(ctx context.Context, update func(index *spatial.Index) error) {
+	if h.index == nil {
+		return
+	}
+	if err := update(h.index); err != nil {
+		logging.WithContext(ctx, h.logger).Warn("Failed to update spatial index", zap.Error(err))
+	}
+}
+
+func (h *FarmHandler) indexField(ctx context.Context, index *spatial.Index, field *api.Field) error {
+	farm, err := repository.GetFarm(h.db.WithContext(ctx), field.FarmID)
+	if err != nil {
+		return err
+	}
+	return index.PutField(ctx, field, farm.OrganisationID)
+}
+
+func parseBoundary(raw string) (geo.MultiPolygon, error) {
+	boundary, err := geo.Parse(raw)
+	if err != nil {
+		return nil, grpcerr.InvalidArgument("boundary", err.Error())
+	}
+	return boundary, nil
+}
+
+func farmToProto(f *api.Farm) *proto.Farm {
+	farm := &proto.Farm{
+		Id:             f.ID,
+		OwnerId:        f.OwnerID,
+		OrganisationId: f.OrganisationID,
+		Name:           f.Name,
+		Address:        f.Address,
+		AreaHectares:   f.AreaHectares,
+		CreatedAt:      f.CreatedAt.Unix(),
+		UpdatedAt:      f.UpdatedAt.Unix(),
+	}
+	if f.Boundary != nil {
+		farm.Boundary = *f.Boundary
+	}
+	return farm
+}
+
+func fieldToProto(f *api.Field) *proto.Field {
+	return &proto.Field{
+		Id:           f.ID,
+		FarmId:       f.FarmID,
+		Name:         f.Name,
+		SoilType:     soilTypeFromName(f.SoilType),
+		Boundary:     f.Boundary,
+		AreaHectares: f.AreaHectares,
+		CreatedAt:    f.CreatedAt.Unix(),
+		UpdatedAt:    f.UpdatedAt.Unix(),
+	}
+}
+
+// soilTypeName returns the name stored for t, e.g. "silt_loam", and the
+// empty string for SOIL_TYPE_UNSPECIFIED.
+func soilTypeName(t proto.SoilType) string {
+	if t == proto.SoilType_SOIL_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(t.String(), "SOIL_TYPE_"))
+}
+
+func soilTypeFromName(name string) proto.SoilType {
+	return proto.SoilType(proto.SoilType_value["SOIL_TYPE_"+strings.ToUpper(name)])
+}
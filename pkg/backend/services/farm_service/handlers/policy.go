@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of FarmService. Access to a farm and its
+// fields is granted by role bindings in the farm's scope or in the scope of
+// the organisation it belongs to. Spatial searches are open to every caller
+// and only return what the caller may read.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	owner := auth.SelfUser(func(req any) string {
+		return req.(interface{ GetOwnerId() string }).GetOwnerId()
+	})
+	farm := FarmResource(db, func(req any) string {
+		return req.(interface{ GetId() string }).GetId()
+	})
+	fieldFarm := FarmResource(db, func(req any) string {
+		return req.(interface{ GetFarmId() string }).GetFarmId()
+	})
+	field := FieldResource(db, func(req any) string {
+		return req.(interface{ GetId() string }).GetId()
+	})
+
+	return auth.Policy{
+		proto.FarmService_CreateFarm_FullMethodName:  createFarm(checker),
+		proto.FarmService_GetFarm_FullMethodName:     checker.RequireLookup("farm.read", farm),
+		proto.FarmService_ListFarms_FullMethodName:   auth.AnyOf(owner, auth.Admin),
+		proto.FarmService_UpdateFarm_FullMethodName:  checker.RequireLookup("farm.write", farm),
+		proto.FarmService_DeleteFarm_FullMethodName:  checker.RequireLookup("farm.delete", farm),
+		proto.FarmService_CreateField_FullMethodName: checker.RequireLookup("field.write", fieldFarm),
+		proto.FarmService_GetField_FullMethodName:    checker.RequireLookup("field.read", field),
+		proto.FarmService_ListFields_FullMethodName:  checker.RequireLookup("field.read", fieldFarm),
+		proto.FarmService_UpdateField_FullMethodName: checker.RequireLookup("field.write", field),
+		proto.FarmService_DeleteField_FullMethodName: checker.RequireLookup("field.delete", field),
+
+		proto.FarmService_SearchNearby_FullMethodName:       auth.Authenticated,
+		proto.FarmService_SearchContaining_FullMethodName:   auth.Authenticated,
+		proto.FarmService_SearchIntersecting_FullMethodName: auth.Authenticated,
+		proto.FarmService_SearchBox_FullMethodName:          auth.Authenticated,
+	}
+}
+
+// createFarm lets anybody create a farm of their own. Farms of an
+// organisation require farm.write in the organisation's scope.
+func createFarm(checker *rbac.Checker) auth.Rule {
+	inOrganisation := checker.Require("farm.write", func(req any) rbac.Resource {
+		return rbac.Resource{OrganisationID: req.(*proto.CreateFarmRequest).GetOrganisationId()}
+	})
+	return func(ctx context.Context, p *auth.Principal, req any) error {
+		if r, ok := req.(*proto.CreateFarmRequest); ok && r.GetOrganisationId() == "" {
+			return auth.Authenticated(ctx, p, req)
+		}
+		return inOrganisation(ctx, p, req)
+	}
+}
+
+// FarmResource returns a lookup of the farm whose id farmID extracts from
+// the request.
+func FarmResource(db *gorm.DB, farmID func(req any) string) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		farm, err := repository.GetFarm(db.WithContext(ctx), farmID(req))
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		return rbac.Resource{OrganisationID: farm.OrganisationID, FarmID: farm.ID}, nil
+	}
+}
+
+// FieldResource returns a lookup of the farm owning the field whose id
+// fieldID extracts from the request, for services attaching data to fields.
+func FieldResource(db *gorm.DB, fieldID func(req any) string) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		farm, err := repository.GetFieldFarm(db.WithContext(ctx), fieldID(req))
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		return rbac.Resource{OrganisationID: farm.OrganisationID, FarmID: farm.ID}, nil
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/spatial"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (h *FarmHandler) SearchNearby(ctx context.Context, req *proto.SearchNearbyRequest) (*proto.FeatureCollection, error) {
+	q, err := h.spatialQuery(ctx, req.GetLayer(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	point := geo.Position{req.GetLongitude(), req.GetLatitude()}
+	features, err := h.index.Nearby(ctx, q, point, req.GetMaxDistanceMeters())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to search nearby")
+	}
+	return featureCollection(features)
+}
+
+func (h *FarmHandler) SearchContaining(ctx context.Context, req *proto.SearchContainingRequest) (*proto.FeatureCollection, error) {
+	q, err := h.spatialQuery(ctx, req.GetLayer(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	point := geo.Position{req.GetLongitude(), req.GetLatitude()}
+	features, err := h.index.Containing(ctx, q, point)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to search containing")
+	}
+	return featureCollection(features)
+}
+
+func (h *FarmHandler) SearchIntersecting(ctx context.Context, req *proto.SearchIntersectingRequest) (*proto.FeatureCollection, error) {
+	q, err := h.spatialQuery(ctx, req.GetLayer(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	geometry, err := geo.Parse(req.GetGeometry())
+	if err != nil {
+		return nil, grpcerr.InvalidArgument("geometry", err.Error())
+	}
+	features, err := h.index.Intersecting(ctx, q, geometry)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to search intersecting")
+	}
+	return featureCollection(features)
+}
+
+func (h *FarmHandler) SearchBox(ctx context.Context, req *proto.SearchBoxRequest) (*proto.FeatureCollection, error) {
+	q, err := h.spatialQuery(ctx, req.GetLayer(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	min := geo.Position{req.GetMinLongitude(), req.GetMinLatitude()}
+	max := geo.Position{req.GetMaxLongitude(), req.GetMaxLatitude()}
+	features, err := h.index.Box(ctx, q, min, max)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to search box")
+	}
+	return featureCollection(features)
+}
+
+// spatialQuery restricts a search of layer to the farms and organisations
+// in which the caller may read it.
+func (h *FarmHandler) spatialQuery(ctx context.Context, layer proto.Layer, limit int32) (spatial.Query, error) {
+	if h.index == nil {
+		return spatial.Query{}, status.Error(codes.FailedPrecondition, "spatial queries require MongoDB to be configured")
+	}
+	q := spatial.Query{Layer: spatial.LayerFields, Limit: int(limit)}
+	action := "field.read"
+	if layer == proto.Layer_LAYER_FARMS {
+		q.Layer = spatial.LayerFarms
+		action = "farm.read"
+	}
+	grants, err := h.checker.Grants(ctx, auth.FromContext(ctx), action)
+	if err != nil {
+		return spatial.Query{}, grpcerr.FromError(err, "failed to authorize")
+	}
+	q.Grants = grants
+	return q, nil
+}
+
+func featureCollection(features []*spatial.Feature) (*proto.FeatureCollection, error) {
+	collection := &proto.FeatureCollection{Type: "FeatureCollection"}
+	for _, f := range features {
+		feature, err := featureToProto(f)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render feature %s: %v", f.ID, err)
+		}
+		collection.Features = append(collection.Features, feature)
+	}
+	return collection, nil
+}
+
+func featureToProto(f *spatial.Feature) (*proto.Feature, error) {
+	raw, err := bson.MarshalExtJSON(f.Geometry, false, false)
+	if err != nil {
+		return nil, err
+	}
+	geometry := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, geometry); err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+
+	properties := map[string]any{
+		"name":         f.Name,
+		"areaHectares": f.AreaHectares,
+	}
+	if f.Layer == spatial.LayerFields {
+		properties["farmId"] = f.FarmID
+		properties["soilType"] = soilTypeFromName(f.SoilType).String()
+	}
+	if f.OrganisationID != "" {
+		properties["organisationId"] = f.OrganisationID
+	}
+	if f.DistanceMeters != nil {
+		properties["distanceMeters"] = *f.DistanceMeters
+	}
+	props, err := structpb.NewStruct(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Feature{
+		Type:       "Feature",
+		Id:         f.ID,
+		Geometry:   geometry,
+		Properties: props,
+	}, nil
+}
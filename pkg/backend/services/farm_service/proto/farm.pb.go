@@ -0,0 +1,2405 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.19.6
+// source: farm.proto
+
+package proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/aburifat/go-agro/pkg/backend/common/validate/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SoilType int32
+
+const (
+	SoilType_SOIL_TYPE_UNSPECIFIED     SoilType = 0
+	SoilType_SOIL_TYPE_SAND            SoilType = 1
+	SoilType_SOIL_TYPE_LOAMY_SAND      SoilType = 2
+	SoilType_SOIL_TYPE_SANDY_LOAM      SoilType = 3
+	SoilType_SOIL_TYPE_LOAM            SoilType = 4
+	SoilType_SOIL_TYPE_SILT_LOAM       SoilType = 5
+	SoilType_SOIL_TYPE_SILT            SoilType = 6
+	SoilType_SOIL_TYPE_SANDY_CLAY_LOAM SoilType = 7
+	SoilType_SOIL_TYPE_CLAY_LOAM       SoilType = 8
+	SoilType_SOIL_TYPE_SILTY_CLAY_LOAM SoilType = 9
+	SoilType_SOIL_TYPE_SANDY_CLAY      SoilType = 10
+	SoilType_SOIL_TYPE_SILTY_CLAY      SoilType = 11
+	SoilType_SOIL_TYPE_CLAY            SoilType = 12
+	SoilType_SOIL_TYPE_PEAT            SoilType = 13
+)
+
+// Enum value maps for SoilType.
+var (
+	SoilType_name = map[int32]string{
+		0:  "SOIL_TYPE_UNSPECIFIED",
+		1:  "SOIL_TYPE_SAND",
+		2:  "SOIL_TYPE_LOAMY_SAND",
+		3:  "SOIL_TYPE_SANDY_LOAM",
+		4:  "SOIL_TYPE_LOAM",
+		5:  "SOIL_TYPE_SILT_LOAM",
+		6:  "SOIL_TYPE_SILT",
+		7:  "SOIL_TYPE_SANDY_CLAY_LOAM",
+		8:  "SOIL_TYPE_CLAY_LOAM",
+		9:  "SOIL_TYPE_SILTY_CLAY_LOAM",
+		10: "SOIL_TYPE_SANDY_CLAY",
+		11: "SOIL_TYPE_SILTY_CLAY",
+		12: "SOIL_TYPE_CLAY",
+		13: "SOIL_TYPE_PEAT",
+	}
+	SoilType_value = map[string]int32{
+		"SOIL_TYPE_UNSPECIFIED":     0,
+		"SOIL_TYPE_SAND":            1,
+		"SOIL_TYPE_LOAMY_SAND":      2,
+		"SOIL_TYPE_SANDY_LOAM":      3,
+		"SOIL_TYPE_LOAM":            4,
+		"SOIL_TYPE_SILT_LOAM":       5,
+		"SOIL_TYPE_SILT":            6,
+		"SOIL_TYPE_SANDY_CLAY_LOAM": 7,
+		"SOIL_TYPE_CLAY_LOAM":       8,
+		"SOIL_TYPE_SILTY_CLAY_LOAM": 9,
+		"SOIL_TYPE_SANDY_CLAY":      10,
+		"SOIL_TYPE_SILTY_CLAY":      11,
+		"SOIL_TYPE_CLAY":            12,
+		"SOIL_TYPE_PEAT":            13,
+	}
+)
+
+func (x SoilType) Enum() *SoilType {
+	p := new(SoilType)
+	*p = x
+	return p
+}
+
+func (x SoilType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SoilType) Descriptor() protoreflect.EnumDescriptor {
+	return file_farm_proto_enumTypes[0].Descriptor()
+}
+
+func (SoilType) Type() protoreflect.EnumType {
+	return &file_farm_proto_enumTypes[0]
+}
+
+func (x SoilType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SoilType.Descriptor instead.
+func (SoilType) EnumDescriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{0}
+}
+
+type Layer int32
+
+const (
+	// same as LAYER_FIELDS
+	Layer_LAYER_UNSPECIFIED Layer = 0
+	Layer_LAYER_FIELDS      Layer = 1
+	Layer_LAYER_FARMS       Layer = 2
+)
+
+// Enum value maps for Layer.
+var (
+	Layer_name = map[int32]string{
+		0: "LAYER_UNSPECIFIED",
+		1: "LAYER_FIELDS",
+		2: "LAYER_FARMS",
+	}
+	Layer_value = map[string]int32{
+		"LAYER_UNSPECIFIED": 0,
+		"LAYER_FIELDS":      1,
+		"LAYER_FARMS":       2,
+	}
+)
+
+func (x Layer) Enum() *Layer {
+	p := new(Layer)
+	*p = x
+	return p
+}
+
+func (x Layer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Layer) Descriptor() protoreflect.EnumDescriptor {
+	return file_farm_proto_enumTypes[1].Descriptor()
+}
+
+func (Layer) Type() protoreflect.EnumType {
+	return &file_farm_proto_enumTypes[1]
+}
+
+func (x Layer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Layer.Descriptor instead.
+func (Layer) EnumDescriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{1}
+}
+
+type Farm struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId        string                 `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	OrganisationId string                 `protobuf:"bytes,3,opt,name=organisationId,proto3" json:"organisationId,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Address        string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// GeoJSON Polygon or MultiPolygon, empty when the farm has no boundary
+	Boundary string `protobuf:"bytes,6,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	// computed from the boundary
+	AreaHectares float64 `protobuf:"fixed64,7,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	// unix seconds
+	CreatedAt     int64 `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Farm) Reset() {
+	*x = Farm{}
+	mi := &file_farm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Farm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Farm) ProtoMessage() {}
+
+func (x *Farm) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Farm.ProtoReflect.Descriptor instead.
+func (*Farm) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Farm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Farm) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Farm) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+func (x *Farm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Farm) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Farm) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+func (x *Farm) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *Farm) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Farm) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type Field struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FarmId   string                 `protobuf:"bytes,2,opt,name=farmId,proto3" json:"farmId,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SoilType SoilType               `protobuf:"varint,4,opt,name=soilType,proto3,enum=farm.SoilType" json:"soilType,omitempty"`
+	// GeoJSON Polygon or MultiPolygon
+	Boundary string `protobuf:"bytes,5,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	// computed from the boundary
+	AreaHectares float64 `protobuf:"fixed64,6,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	// unix seconds
+	CreatedAt     int64 `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_farm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Field) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Field) GetFarmId() string {
+	if x != nil {
+		return x.FarmId
+	}
+	return ""
+}
+
+func (x *Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Field) GetSoilType() SoilType {
+	if x != nil {
+		return x.SoilType
+	}
+	return SoilType_SOIL_TYPE_UNSPECIFIED
+}
+
+func (x *Field) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+func (x *Field) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *Field) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Field) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateFarmRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address  string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Boundary string                 `protobuf:"bytes,3,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	// the farm is created in the organisation's scope, which requires
+	// farm.write there
+	OrganisationId string `protobuf:"bytes,4,opt,name=organisationId,proto3" json:"organisationId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateFarmRequest) Reset() {
+	*x = CreateFarmRequest{}
+	mi := &file_farm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFarmRequest) ProtoMessage() {}
+
+func (x *CreateFarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFarmRequest.ProtoReflect.Descriptor instead.
+func (*CreateFarmRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFarmRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFarmRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateFarmRequest) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+func (x *CreateFarmRequest) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+type CreateFarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AreaHectares  float64                `protobuf:"fixed64,2,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFarmResponse) Reset() {
+	*x = CreateFarmResponse{}
+	mi := &file_farm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFarmResponse) ProtoMessage() {}
+
+func (x *CreateFarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFarmResponse.ProtoReflect.Descriptor instead.
+func (*CreateFarmResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFarmResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateFarmResponse) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *CreateFarmResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetFarmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFarmRequest) Reset() {
+	*x = GetFarmRequest{}
+	mi := &file_farm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFarmRequest) ProtoMessage() {}
+
+func (x *GetFarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFarmRequest.ProtoReflect.Descriptor instead.
+func (*GetFarmRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFarmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Farm          *Farm                  `protobuf:"bytes,1,opt,name=farm,proto3" json:"farm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFarmResponse) Reset() {
+	*x = GetFarmResponse{}
+	mi := &file_farm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFarmResponse) ProtoMessage() {}
+
+func (x *GetFarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFarmResponse.ProtoReflect.Descriptor instead.
+func (*GetFarmResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFarmResponse) GetFarm() *Farm {
+	if x != nil {
+		return x.Farm
+	}
+	return nil
+}
+
+type ListFarmsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty lists the caller's farms
+	OwnerId string `protobuf:"bytes,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	// defaults to 20
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of the previous response
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeTotal  bool   `protobuf:"varint,4,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFarmsRequest) Reset() {
+	*x = ListFarmsRequest{}
+	mi := &file_farm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFarmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFarmsRequest) ProtoMessage() {}
+
+func (x *ListFarmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFarmsRequest.ProtoReflect.Descriptor instead.
+func (*ListFarmsRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFarmsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListFarmsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFarmsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFarmsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+type ListFarmsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Farms []*Farm                `protobuf:"bytes,1,rep,name=farms,proto3" json:"farms,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	// only set when includeTotal was requested
+	TotalCount    int64 `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFarmsResponse) Reset() {
+	*x = ListFarmsResponse{}
+	mi := &file_farm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFarmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFarmsResponse) ProtoMessage() {}
+
+func (x *ListFarmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFarmsResponse.ProtoReflect.Descriptor instead.
+func (*ListFarmsResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFarmsResponse) GetFarms() []*Farm {
+	if x != nil {
+		return x.Farms
+	}
+	return nil
+}
+
+func (x *ListFarmsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFarmsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateFarmRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address  string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Boundary string                 `protobuf:"bytes,4,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	// removes the boundary, ignored when a boundary is given
+	ClearBoundary bool `protobuf:"varint,5,opt,name=clearBoundary,proto3" json:"clearBoundary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFarmRequest) Reset() {
+	*x = UpdateFarmRequest{}
+	mi := &file_farm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFarmRequest) ProtoMessage() {}
+
+func (x *UpdateFarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFarmRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFarmRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateFarmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFarmRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFarmRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateFarmRequest) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+func (x *UpdateFarmRequest) GetClearBoundary() bool {
+	if x != nil {
+		return x.ClearBoundary
+	}
+	return false
+}
+
+type UpdateFarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AreaHectares  float64                `protobuf:"fixed64,1,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFarmResponse) Reset() {
+	*x = UpdateFarmResponse{}
+	mi := &file_farm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFarmResponse) ProtoMessage() {}
+
+func (x *UpdateFarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFarmResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFarmResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateFarmResponse) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *UpdateFarmResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteFarmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFarmRequest) Reset() {
+	*x = DeleteFarmRequest{}
+	mi := &file_farm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFarmRequest) ProtoMessage() {}
+
+func (x *DeleteFarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFarmRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFarmRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteFarmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFarmResponse) Reset() {
+	*x = DeleteFarmResponse{}
+	mi := &file_farm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFarmResponse) ProtoMessage() {}
+
+func (x *DeleteFarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFarmResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFarmResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteFarmResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FarmId        string                 `protobuf:"bytes,1,opt,name=farmId,proto3" json:"farmId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SoilType      SoilType               `protobuf:"varint,3,opt,name=soilType,proto3,enum=farm.SoilType" json:"soilType,omitempty"`
+	Boundary      string                 `protobuf:"bytes,4,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFieldRequest) Reset() {
+	*x = CreateFieldRequest{}
+	mi := &file_farm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFieldRequest) ProtoMessage() {}
+
+func (x *CreateFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateFieldRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateFieldRequest) GetFarmId() string {
+	if x != nil {
+		return x.FarmId
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetSoilType() SoilType {
+	if x != nil {
+		return x.SoilType
+	}
+	return SoilType_SOIL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateFieldRequest) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+type CreateFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AreaHectares  float64                `protobuf:"fixed64,2,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFieldResponse) Reset() {
+	*x = CreateFieldResponse{}
+	mi := &file_farm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFieldResponse) ProtoMessage() {}
+
+func (x *CreateFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateFieldResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateFieldResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateFieldResponse) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *CreateFieldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFieldRequest) Reset() {
+	*x = GetFieldRequest{}
+	mi := &file_farm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFieldRequest) ProtoMessage() {}
+
+func (x *GetFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFieldRequest.ProtoReflect.Descriptor instead.
+func (*GetFieldRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *Field                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFieldResponse) Reset() {
+	*x = GetFieldResponse{}
+	mi := &file_farm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFieldResponse) ProtoMessage() {}
+
+func (x *GetFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFieldResponse.ProtoReflect.Descriptor instead.
+func (*GetFieldResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetFieldResponse) GetField() *Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type ListFieldsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FarmId string                 `protobuf:"bytes,1,opt,name=farmId,proto3" json:"farmId,omitempty"`
+	// defaults to 20
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of the previous response
+	PageToken     string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeTotal  bool   `protobuf:"varint,4,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFieldsRequest) Reset() {
+	*x = ListFieldsRequest{}
+	mi := &file_farm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFieldsRequest) ProtoMessage() {}
+
+func (x *ListFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFieldsRequest) GetFarmId() string {
+	if x != nil {
+		return x.FarmId
+	}
+	return ""
+}
+
+func (x *ListFieldsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFieldsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFieldsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+type ListFieldsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Fields []*Field               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	// only set when includeTotal was requested
+	TotalCount    int64 `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFieldsResponse) Reset() {
+	*x = ListFieldsResponse{}
+	mi := &file_farm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFieldsResponse) ProtoMessage() {}
+
+func (x *ListFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFieldsResponse) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListFieldsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFieldsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SoilType      SoilType               `protobuf:"varint,3,opt,name=soilType,proto3,enum=farm.SoilType" json:"soilType,omitempty"`
+	Boundary      string                 `protobuf:"bytes,4,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFieldRequest) Reset() {
+	*x = UpdateFieldRequest{}
+	mi := &file_farm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFieldRequest) ProtoMessage() {}
+
+func (x *UpdateFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFieldRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetSoilType() SoilType {
+	if x != nil {
+		return x.SoilType
+	}
+	return SoilType_SOIL_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateFieldRequest) GetBoundary() string {
+	if x != nil {
+		return x.Boundary
+	}
+	return ""
+}
+
+type UpdateFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AreaHectares  float64                `protobuf:"fixed64,1,opt,name=areaHectares,proto3" json:"areaHectares,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFieldResponse) Reset() {
+	*x = UpdateFieldResponse{}
+	mi := &file_farm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFieldResponse) ProtoMessage() {}
+
+func (x *UpdateFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFieldResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFieldResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateFieldResponse) GetAreaHectares() float64 {
+	if x != nil {
+		return x.AreaHectares
+	}
+	return 0
+}
+
+func (x *UpdateFieldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFieldRequest) Reset() {
+	*x = DeleteFieldRequest{}
+	mi := &file_farm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFieldRequest) ProtoMessage() {}
+
+func (x *DeleteFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFieldRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFieldResponse) Reset() {
+	*x = DeleteFieldResponse{}
+	mi := &file_farm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFieldResponse) ProtoMessage() {}
+
+func (x *DeleteFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFieldResponse) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteFieldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SearchNearbyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Layer     Layer                  `protobuf:"varint,1,opt,name=layer,proto3,enum=farm.Layer" json:"layer,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// distance to the nearest point of the boundary, which is 0 inside it;
+	// 0 for no limit
+	MaxDistanceMeters float64 `protobuf:"fixed64,4,opt,name=maxDistanceMeters,proto3" json:"maxDistanceMeters,omitempty"`
+	// defaults to 20
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNearbyRequest) Reset() {
+	*x = SearchNearbyRequest{}
+	mi := &file_farm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNearbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNearbyRequest) ProtoMessage() {}
+
+func (x *SearchNearbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNearbyRequest.ProtoReflect.Descriptor instead.
+func (*SearchNearbyRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchNearbyRequest) GetLayer() Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return Layer_LAYER_UNSPECIFIED
+}
+
+func (x *SearchNearbyRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchNearbyRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchNearbyRequest) GetMaxDistanceMeters() float64 {
+	if x != nil {
+		return x.MaxDistanceMeters
+	}
+	return 0
+}
+
+func (x *SearchNearbyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchContainingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Layer     Layer                  `protobuf:"varint,1,opt,name=layer,proto3,enum=farm.Layer" json:"layer,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// defaults to 20
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContainingRequest) Reset() {
+	*x = SearchContainingRequest{}
+	mi := &file_farm_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContainingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContainingRequest) ProtoMessage() {}
+
+func (x *SearchContainingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContainingRequest.ProtoReflect.Descriptor instead.
+func (*SearchContainingRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchContainingRequest) GetLayer() Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return Layer_LAYER_UNSPECIFIED
+}
+
+func (x *SearchContainingRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchContainingRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchContainingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchIntersectingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Layer Layer                  `protobuf:"varint,1,opt,name=layer,proto3,enum=farm.Layer" json:"layer,omitempty"`
+	// GeoJSON Polygon or MultiPolygon
+	Geometry string `protobuf:"bytes,2,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	// defaults to 20
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIntersectingRequest) Reset() {
+	*x = SearchIntersectingRequest{}
+	mi := &file_farm_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIntersectingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIntersectingRequest) ProtoMessage() {}
+
+func (x *SearchIntersectingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIntersectingRequest.ProtoReflect.Descriptor instead.
+func (*SearchIntersectingRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchIntersectingRequest) GetLayer() Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return Layer_LAYER_UNSPECIFIED
+}
+
+func (x *SearchIntersectingRequest) GetGeometry() string {
+	if x != nil {
+		return x.Geometry
+	}
+	return ""
+}
+
+func (x *SearchIntersectingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchBoxRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Layer        Layer                  `protobuf:"varint,1,opt,name=layer,proto3,enum=farm.Layer" json:"layer,omitempty"`
+	MinLongitude float64                `protobuf:"fixed64,2,opt,name=minLongitude,proto3" json:"minLongitude,omitempty"`
+	MinLatitude  float64                `protobuf:"fixed64,3,opt,name=minLatitude,proto3" json:"minLatitude,omitempty"`
+	MaxLongitude float64                `protobuf:"fixed64,4,opt,name=maxLongitude,proto3" json:"maxLongitude,omitempty"`
+	MaxLatitude  float64                `protobuf:"fixed64,5,opt,name=maxLatitude,proto3" json:"maxLatitude,omitempty"`
+	// defaults to 20
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBoxRequest) Reset() {
+	*x = SearchBoxRequest{}
+	mi := &file_farm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBoxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBoxRequest) ProtoMessage() {}
+
+func (x *SearchBoxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBoxRequest.ProtoReflect.Descriptor instead.
+func (*SearchBoxRequest) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchBoxRequest) GetLayer() Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return Layer_LAYER_UNSPECIFIED
+}
+
+func (x *SearchBoxRequest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *SearchBoxRequest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *SearchBoxRequest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+func (x *SearchBoxRequest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *SearchBoxRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FeatureCollection is a GeoJSON FeatureCollection.
+type FeatureCollection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// always "FeatureCollection"
+	Type          string     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Features      []*Feature `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureCollection) Reset() {
+	*x = FeatureCollection{}
+	mi := &file_farm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureCollection) ProtoMessage() {}
+
+func (x *FeatureCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureCollection.ProtoReflect.Descriptor instead.
+func (*FeatureCollection) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FeatureCollection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FeatureCollection) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Feature is a GeoJSON Feature. Its id is the farm or field id; properties
+// hold the name, area and, for fields, farmId and soilType. Nearby searches
+// add distanceMeters.
+type Feature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// always "Feature"
+	Type          string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Geometry      *structpb.Struct `protobuf:"bytes,3,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	Properties    *structpb.Struct `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feature) Reset() {
+	*x = Feature{}
+	mi := &file_farm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feature) ProtoMessage() {}
+
+func (x *Feature) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
+func (*Feature) Descriptor() ([]byte, []int) {
+	return file_farm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Feature) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Feature) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Feature) GetGeometry() *structpb.Struct {
+	if x != nil {
+		return x.Geometry
+	}
+	return nil
+}
+
+func (x *Feature) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+var File_farm_proto protoreflect.FileDescriptor
+
+var file_farm_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x66, 0x61,
+	0x72, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a, 0x04, 0x46, 0x61, 0x72, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a,
+	0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x53, 0x6f, 0x69, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72,
+	0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xca, 0xf3, 0x18, 0x03, 0x20, 0xff, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x20, 0xc0, 0x84, 0x3d, 0x52, 0x08, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x40, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61, 0x72, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x61,
+	0x72, 0x6d, 0x52, 0x04, 0x66, 0x61, 0x72, 0x6d, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x61, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x38, 0x01, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03,
+	0x20, 0x80, 0x08, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x7b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x61, 0x72, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x61,
+	0x72, 0x6d, 0x52, 0x05, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xb6, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18,
+	0x02, 0x20, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03,
+	0x20, 0xff, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x08,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x20, 0xc0, 0x84, 0x3d, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x22, 0x52, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca,
+	0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x73, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x53, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x58, 0x01, 0x52, 0x08, 0x73, 0x6f, 0x69, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xf3, 0x18, 0x06, 0x08, 0x01, 0x20, 0xc0,
+	0x84, 0x3d, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x22, 0x63, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x72, 0x65, 0x61, 0x48,
+	0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x61, 0x72,
+	0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38,
+	0x01, 0x52, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18,
+	0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x25, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xca, 0xf3, 0x18, 0x03, 0x20, 0x80, 0x08, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6f, 0x69, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e,
+	0x53, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x01,
+	0x58, 0x01, 0x52, 0x08, 0x73, 0x6f, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x08,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xf3, 0x18, 0x04, 0x20, 0xc0, 0x84, 0x3d, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x79, 0x22, 0x53, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x65,
+	0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x61, 0x72, 0x65, 0x61, 0x48, 0x65, 0x63, 0x74, 0x61, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04,
+	0x10, 0x01, 0x58, 0x01, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16,
+	0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x51, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x56, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04,
+	0x10, 0x01, 0x58, 0x01, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16,
+	0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x51, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x56, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42,
+	0x08, 0xca, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x58, 0x01, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x08, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xca, 0xf3, 0x18, 0x06, 0x08, 0x01, 0x20, 0xc0, 0x84, 0x3d, 0x52, 0x08,
+	0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59,
+	0x40, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x66,
+	0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x08, 0xca, 0xf3, 0x18, 0x04, 0x10,
+	0x01, 0x58, 0x01, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x51,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xca, 0xf3, 0x18,
+	0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x80, 0x56, 0x40, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x80, 0x66, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0,
+	0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x10, 0x01, 0x49, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x07, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x67, 0x65,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12,
+	0x37, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2a, 0xe1, 0x02, 0x0a, 0x08, 0x53, 0x6f, 0x69,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x59, 0x5f, 0x53, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41, 0x4e, 0x44,
+	0x59, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x49, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4c, 0x54, 0x5f, 0x4c,
+	0x4f, 0x41, 0x4d, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x49, 0x4c, 0x54, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x49,
+	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41, 0x4e, 0x44, 0x59, 0x5f, 0x43, 0x4c, 0x41,
+	0x59, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x49, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x59, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x10,
+	0x08, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x49, 0x4c, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x59, 0x5f, 0x4c, 0x4f, 0x41, 0x4d, 0x10, 0x09,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41,
+	0x4e, 0x44, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x59, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f,
+	0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4c, 0x54, 0x59, 0x5f, 0x43, 0x4c,
+	0x41, 0x59, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x49, 0x4c, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x4c, 0x41, 0x59, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x49, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x41, 0x54, 0x10, 0x0d, 0x2a, 0x41, 0x0a, 0x05,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x53, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x52, 0x4d, 0x53, 0x10, 0x02, 0x32,
+	0xa1, 0x0a, 0x0a, 0x0b, 0x46, 0x61, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x55, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x12, 0x17, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31,
+	0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x61, 0x72,
+	0x6d, 0x12, 0x14, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x72, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x72, 0x6d,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61,
+	0x72, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x61, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61,
+	0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x72, 0x6d, 0x12, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x3a, 0x01, 0x2a, 0x32, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x57, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x72,
+	0x6d, 0x12, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x66, 0x61,
+	0x72, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x2f, 0x7b, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x7d, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x66, 0x61, 0x72, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0a, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x73, 0x2f, 0x7b,
+	0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x5e,
+	0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x32, 0x0f, 0x2f,
+	0x76, 0x31, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5b,
+	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5a, 0x0a, 0x0c, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x12, 0x19, 0x2e, 0x66, 0x61,
+	0x72, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6f,
+	0x3a, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x12, 0x66, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x66, 0x61,
+	0x72, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x6f, 0x3a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x6f, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x65, 0x6f, 0x3a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x51, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x78, 0x12, 0x16, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6f, 0x3a,
+	0x62, 0x6f, 0x78, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x62, 0x75, 0x72, 0x69, 0x66, 0x61, 0x74, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x67,
+	0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_farm_proto_rawDescOnce sync.Once
+	file_farm_proto_rawDescData = file_farm_proto_rawDesc
+)
+
+func file_farm_proto_rawDescGZIP() []byte {
+	file_farm_proto_rawDescOnce.Do(func() {
+		file_farm_proto_rawDescData = protoimpl.X.CompressGZIP(file_farm_proto_rawDescData)
+	})
+	return file_farm_proto_rawDescData
+}
+
+var file_farm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_farm_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_farm_proto_goTypes = []any{
+	(SoilType)(0),                     // 0: farm.SoilType
+	(Layer)(0),                        // 1: farm.Layer
+	(*Farm)(nil),                      // 2: farm.Farm
+	(*Field)(nil),                     // 3: farm.Field
+	(*CreateFarmRequest)(nil),         // 4: farm.CreateFarmRequest
+	(*CreateFarmResponse)(nil),        // 5: farm.CreateFarmResponse
+	(*GetFarmRequest)(nil),            // 6: farm.GetFarmRequest
+	(*GetFarmResponse)(nil),           // 7: farm.GetFarmResponse
+	(*ListFarmsRequest)(nil),          // 8: farm.ListFarmsRequest
+	(*ListFarmsResponse)(nil),         // 9: farm.ListFarmsResponse
+	(*UpdateFarmRequest)(nil),         // 10: farm.UpdateFarmRequest
+	(*UpdateFarmResponse)(nil),        // 11: farm.UpdateFarmResponse
+	(*DeleteFarmRequest)(nil),         // 12: farm.DeleteFarmRequest
+	(*DeleteFarmResponse)(nil),        // 13: farm.DeleteFarmResponse
+	(*CreateFieldRequest)(nil),        // 14: farm.CreateFieldRequest
+	(*CreateFieldResponse)(nil),       // 15: farm.CreateFieldResponse
+	(*GetFieldRequest)(nil),           // 16: farm.GetFieldRequest
+	(*GetFieldResponse)(nil),          // 17: farm.GetFieldResponse
+	(*ListFieldsRequest)(nil),         // 18: farm.ListFieldsRequest
+	(*ListFieldsResponse)(nil),        // 19: farm.ListFieldsResponse
+	(*UpdateFieldRequest)(nil),        // 20: farm.UpdateFieldRequest
+	(*UpdateFieldResponse)(nil),       // 21: farm.UpdateFieldResponse
+	(*DeleteFieldRequest)(nil),        // 22: farm.DeleteFieldRequest
+	(*DeleteFieldResponse)(nil),       // 23: farm.DeleteFieldResponse
+	(*SearchNearbyRequest)(nil),       // 24: farm.SearchNearbyRequest
+	(*SearchContainingRequest)(nil),   // 25: farm.SearchContainingRequest
+	(*SearchIntersectingRequest)(nil), // 26: farm.SearchIntersectingRequest
+	(*SearchBoxRequest)(nil),          // 27: farm.SearchBoxRequest
+	(*FeatureCollection)(nil),         // 28: farm.FeatureCollection
+	(*Feature)(nil),                   // 29: farm.Feature
+	(*structpb.Struct)(nil),           // 30: google.protobuf.Struct
+}
+var file_farm_proto_depIdxs = []int32{
+	0,  // 0: farm.Field.soilType:type_name -> farm.SoilType
+	2,  // 1: farm.GetFarmResponse.farm:type_name -> farm.Farm
+	2,  // 2: farm.ListFarmsResponse.farms:type_name -> farm.Farm
+	0,  // 3: farm.CreateFieldRequest.soilType:type_name -> farm.SoilType
+	3,  // 4: farm.GetFieldResponse.field:type_name -> farm.Field
+	3,  // 5: farm.ListFieldsResponse.fields:type_name -> farm.Field
+	0,  // 6: farm.UpdateFieldRequest.soilType:type_name -> farm.SoilType
+	1,  // 7: farm.SearchNearbyRequest.layer:type_name -> farm.Layer
+	1,  // 8: farm.SearchContainingRequest.layer:type_name -> farm.Layer
+	1,  // 9: farm.SearchIntersectingRequest.layer:type_name -> farm.Layer
+	1,  // 10: farm.SearchBoxRequest.layer:type_name -> farm.Layer
+	29, // 11: farm.FeatureCollection.features:type_name -> farm.Feature
+	30, // 12: farm.Feature.geometry:type_name -> google.protobuf.Struct
+	30, // 13: farm.Feature.properties:type_name -> google.protobuf.Struct
+	4,  // 14: farm.FarmService.CreateFarm:input_type -> farm.CreateFarmRequest
+	6,  // 15: farm.FarmService.GetFarm:input_type -> farm.GetFarmRequest
+	8,  // 16: farm.FarmService.ListFarms:input_type -> farm.ListFarmsRequest
+	10, // 17: farm.FarmService.UpdateFarm:input_type -> farm.UpdateFarmRequest
+	12, // 18: farm.FarmService.DeleteFarm:input_type -> farm.DeleteFarmRequest
+	14, // 19: farm.FarmService.CreateField:input_type -> farm.CreateFieldRequest
+	16, // 20: farm.FarmService.GetField:input_type -> farm.GetFieldRequest
+	18, // 21: farm.FarmService.ListFields:input_type -> farm.ListFieldsRequest
+	20, // 22: farm.FarmService.UpdateField:input_type -> farm.UpdateFieldRequest
+	22, // 23: farm.FarmService.DeleteField:input_type -> farm.DeleteFieldRequest
+	24, // 24: farm.FarmService.SearchNearby:input_type -> farm.SearchNearbyRequest
+	25, // 25: farm.FarmService.SearchContaining:input_type -> farm.SearchContainingRequest
+	26, // 26: farm.FarmService.SearchIntersecting:input_type -> farm.SearchIntersectingRequest
+	27, // 27: farm.FarmService.SearchBox:input_type -> farm.SearchBoxRequest
+	5,  // 28: farm.FarmService.CreateFarm:output_type -> farm.CreateFarmResponse
+	7,  // 29: farm.FarmService.GetFarm:output_type -> farm.GetFarmResponse
+	9,  // 30: farm.FarmService.ListFarms:output_type -> farm.ListFarmsResponse
+	11, // 31: farm.FarmService.UpdateFarm:output_type -> farm.UpdateFarmResponse
+	13, // 32: farm.FarmService.DeleteFarm:output_type -> farm.DeleteFarmResponse
+	15, // 33: farm.FarmService.CreateField:output_type -> farm.CreateFieldResponse
+	17, // 34: farm.FarmService.GetField:output_type -> farm.GetFieldResponse
+	19, // 35: farm.FarmService.ListFields:output_type -> farm.ListFieldsResponse
+	21, // 36: farm.FarmService.UpdateField:output_type -> farm.UpdateFieldResponse
+	23, // 37: farm.FarmService.DeleteField:output_type -> farm.DeleteFieldResponse
+	28, // 38: farm.FarmService.SearchNearby:output_type -> farm.FeatureCollection
+	28, // 39: farm.FarmService.SearchContaining:output_type -> farm.FeatureCollection
+	28, // 40: farm.FarmService.SearchIntersecting:output_type -> farm.FeatureCollection
+	28, // 41: farm.FarmService.SearchBox:output_type -> farm.FeatureCollection
+	28, // [28:42] is the sub-list for method output_type
+	14, // [14:28] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_farm_proto_init() }
+func file_farm_proto_init() {
+	if File_farm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_farm_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_farm_proto_goTypes,
+		DependencyIndexes: file_farm_proto_depIdxs,
+		EnumInfos:         file_farm_proto_enumTypes,
+		MessageInfos:      file_farm_proto_msgTypes,
+	}.Build()
+	File_farm_proto = out.File
+	file_farm_proto_rawDesc = nil
+	file_farm_proto_goTypes = nil
+	file_farm_proto_depIdxs = nil
+}
@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: farm.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FarmService_CreateFarm_FullMethodName         = "/farm.FarmService/CreateFarm"
+	FarmService_GetFarm_FullMethodName            = "/farm.FarmService/GetFarm"
+	FarmService_ListFarms_FullMethodName          = "/farm.FarmService/ListFarms"
+	FarmService_UpdateFarm_FullMethodName         = "/farm.FarmService/UpdateFarm"
+	FarmService_DeleteFarm_FullMethodName         = "/farm.FarmService/DeleteFarm"
+	FarmService_CreateField_FullMethodName        = "/farm.FarmService/CreateField"
+	FarmService_GetField_FullMethodName           = "/farm.FarmService/GetField"
+	FarmService_ListFields_FullMethodName         = "/farm.FarmService/ListFields"
+	FarmService_UpdateField_FullMethodName        = "/farm.FarmService/UpdateField"
+	FarmService_DeleteField_FullMethodName        = "/farm.FarmService/DeleteField"
+	FarmService_SearchNearby_FullMethodName       = "/farm.FarmService/SearchNearby"
+	FarmService_SearchContaining_FullMethodName   = "/farm.FarmService/SearchContaining"
+	FarmService_SearchIntersecting_FullMethodName = "/farm.FarmService/SearchIntersecting"
+	FarmService_SearchBox_FullMethodName          = "/farm.FarmService/SearchBox"
+)
+
+// FarmServiceClient is the client API for FarmService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FarmServiceClient interface {
+	CreateFarm(ctx context.Context, in *CreateFarmRequest, opts ...grpc.CallOption) (*CreateFarmResponse, error)
+	GetFarm(ctx context.Context, in *GetFarmRequest, opts ...grpc.CallOption) (*GetFarmResponse, error)
+	ListFarms(ctx context.Context, in *ListFarmsRequest, opts ...grpc.CallOption) (*ListFarmsResponse, error)
+	UpdateFarm(ctx context.Context, in *UpdateFarmRequest, opts ...grpc.CallOption) (*UpdateFarmResponse, error)
+	DeleteFarm(ctx context.Context, in *DeleteFarmRequest, opts ...grpc.CallOption) (*DeleteFarmResponse, error)
+	CreateField(ctx context.Context, in *CreateFieldRequest, opts ...grpc.CallOption) (*CreateFieldResponse, error)
+	GetField(ctx context.Context, in *GetFieldRequest, opts ...grpc.CallOption) (*GetFieldResponse, error)
+	ListFields(ctx context.Context, in *ListFieldsRequest, opts ...grpc.CallOption) (*ListFieldsResponse, error)
+	UpdateField(ctx context.Context, in *UpdateFieldRequest, opts ...grpc.CallOption) (*UpdateFieldResponse, error)
+	DeleteField(ctx context.Context, in *DeleteFieldRequest, opts ...grpc.CallOption) (*DeleteFieldResponse, error)
+	// Spatial queries return the matching farms or fields the caller may read
+	// as a GeoJSON FeatureCollection. They require MongoDB.
+	SearchNearby(ctx context.Context, in *SearchNearbyRequest, opts ...grpc.CallOption) (*FeatureCollection, error)
+	SearchContaining(ctx context.Context, in *SearchContainingRequest, opts ...grpc.CallOption) (*FeatureCollection, error)
+	SearchIntersecting(ctx context.Context, in *SearchIntersectingRequest, opts ...grpc.CallOption) (*FeatureCollection, error)
+	SearchBox(ctx context.Context, in *SearchBoxRequest, opts ...grpc.CallOption) (*FeatureCollection, error)
+}
+
+type farmServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFarmServiceClient(cc grpc.ClientConnInterface) FarmServiceClient {
+	return &farmServiceClient{cc}
+}
+
+func (c *farmServiceClient) CreateFarm(ctx context.Context, in *CreateFarmRequest, opts ...grpc.CallOption) (*CreateFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFarmResponse)
+	err := c.cc.Invoke(ctx, FarmService_CreateFarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) GetFarm(ctx context.Context, in *GetFarmRequest, opts ...grpc.CallOption) (*GetFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFarmResponse)
+	err := c.cc.Invoke(ctx, FarmService_GetFarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) ListFarms(ctx context.Context, in *ListFarmsRequest, opts ...grpc.CallOption) (*ListFarmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFarmsResponse)
+	err := c.cc.Invoke(ctx, FarmService_ListFarms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) UpdateFarm(ctx context.Context, in *UpdateFarmRequest, opts ...grpc.CallOption) (*UpdateFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFarmResponse)
+	err := c.cc.Invoke(ctx, FarmService_UpdateFarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) DeleteFarm(ctx context.Context, in *DeleteFarmRequest, opts ...grpc.CallOption) (*DeleteFarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFarmResponse)
+	err := c.cc.Invoke(ctx, FarmService_DeleteFarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) CreateField(ctx context.Context, in *CreateFieldRequest, opts ...grpc.CallOption) (*CreateFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFieldResponse)
+	err := c.cc.Invoke(ctx, FarmService_CreateField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) GetField(ctx context.Context, in *GetFieldRequest, opts ...grpc.CallOption) (*GetFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFieldResponse)
+	err := c.cc.Invoke(ctx, FarmService_GetField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) ListFields(ctx context.Context, in *ListFieldsRequest, opts ...grpc.CallOption) (*ListFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFieldsResponse)
+	err := c.cc.Invoke(ctx, FarmService_ListFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) UpdateField(ctx context.Context, in *UpdateFieldRequest, opts ...grpc.CallOption) (*UpdateFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFieldResponse)
+	err := c.cc.Invoke(ctx, FarmService_UpdateField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) DeleteField(ctx context.Context, in *DeleteFieldRequest, opts ...grpc.CallOption) (*DeleteFieldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFieldResponse)
+	err := c.cc.Invoke(ctx, FarmService_DeleteField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SearchNearby(ctx context.Context, in *SearchNearbyRequest, opts ...grpc.CallOption) (*FeatureCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureCollection)
+	err := c.cc.Invoke(ctx, FarmService_SearchNearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SearchContaining(ctx context.Context, in *SearchContainingRequest, opts ...grpc.CallOption) (*FeatureCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureCollection)
+	err := c.cc.Invoke(ctx, FarmService_SearchContaining_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SearchIntersecting(ctx context.Context, in *SearchIntersectingRequest, opts ...grpc.CallOption) (*FeatureCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureCollection)
+	err := c.cc.Invoke(ctx, FarmService_SearchIntersecting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SearchBox(ctx context.Context, in *SearchBoxRequest, opts ...grpc.CallOption) (*FeatureCollection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureCollection)
+	err := c.cc.Invoke(ctx, FarmService_SearchBox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FarmServiceServer is the server API for FarmService service.
+// All implementations must embed UnimplementedFarmServiceServer
+// for forward compatibility.
+type FarmServiceServer interface {
+	CreateFarm(context.Context, *CreateFarmRequest) (*CreateFarmResponse, error)
+	GetFarm(context.Context, *GetFarmRequest) (*GetFarmResponse, error)
+	ListFarms(context.Context, *ListFarmsRequest) (*ListFarmsResponse, error)
+	UpdateFarm(context.Context, *UpdateFarmRequest) (*UpdateFarmResponse, error)
+	DeleteFarm(context.Context, *DeleteFarmRequest) (*DeleteFarmResponse, error)
+	CreateField(context.Context, *CreateFieldRequest) (*CreateFieldResponse, error)
+	GetField(context.Context, *GetFieldRequest) (*GetFieldResponse, error)
+	ListFields(context.Context, *ListFieldsRequest) (*ListFieldsResponse, error)
+	UpdateField(context.Context, *UpdateFieldRequest) (*UpdateFieldResponse, error)
+	DeleteField(context.Context, *DeleteFieldRequest) (*DeleteFieldResponse, error)
+	// Spatial queries return the matching farms or fields the caller may read
+	// as a GeoJSON FeatureCollection. They require MongoDB.
+	SearchNearby(context.Context, *SearchNearbyRequest) (*FeatureCollection, error)
+	SearchContaining(context.Context, *SearchContainingRequest) (*FeatureCollection, error)
+	SearchIntersecting(context.Context, *SearchIntersectingRequest) (*FeatureCollection, error)
+	SearchBox(context.Context, *SearchBoxRequest) (*FeatureCollection, error)
+	mustEmbedUnimplementedFarmServiceServer()
+}
+
+// UnimplementedFarmServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFarmServiceServer struct{}
+
+func (UnimplementedFarmServiceServer) CreateFarm(context.Context, *CreateFarmRequest) (*CreateFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFarm not implemented")
+}
+func (UnimplementedFarmServiceServer) GetFarm(context.Context, *GetFarmRequest) (*GetFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFarm not implemented")
+}
+func (UnimplementedFarmServiceServer) ListFarms(context.Context, *ListFarmsRequest) (*ListFarmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFarms not implemented")
+}
+func (UnimplementedFarmServiceServer) UpdateFarm(context.Context, *UpdateFarmRequest) (*UpdateFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFarm not implemented")
+}
+func (UnimplementedFarmServiceServer) DeleteFarm(context.Context, *DeleteFarmRequest) (*DeleteFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFarm not implemented")
+}
+func (UnimplementedFarmServiceServer) CreateField(context.Context, *CreateFieldRequest) (*CreateFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateField not implemented")
+}
+func (UnimplementedFarmServiceServer) GetField(context.Context, *GetFieldRequest) (*GetFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetField not implemented")
+}
+func (UnimplementedFarmServiceServer) ListFields(context.Context, *ListFieldsRequest) (*ListFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFields not implemented")
+}
+func (UnimplementedFarmServiceServer) UpdateField(context.Context, *UpdateFieldRequest) (*UpdateFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateField not implemented")
+}
+func (UnimplementedFarmServiceServer) DeleteField(context.Context, *DeleteFieldRequest) (*DeleteFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteField not implemented")
+}
+func (UnimplementedFarmServiceServer) SearchNearby(context.Context, *SearchNearbyRequest) (*FeatureCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNearby not implemented")
+}
+func (UnimplementedFarmServiceServer) SearchContaining(context.Context, *SearchContainingRequest) (*FeatureCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContaining not implemented")
+}
+func (UnimplementedFarmServiceServer) SearchIntersecting(context.Context, *SearchIntersectingRequest) (*FeatureCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIntersecting not implemented")
+}
+func (UnimplementedFarmServiceServer) SearchBox(context.Context, *SearchBoxRequest) (*FeatureCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBox not implemented")
+}
+func (UnimplementedFarmServiceServer) mustEmbedUnimplementedFarmServiceServer() {}
+func (UnimplementedFarmServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeFarmServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FarmServiceServer will
+// result in compilation errors.
+type UnsafeFarmServiceServer interface {
+	mustEmbedUnimplementedFarmServiceServer()
+}
+
+func RegisterFarmServiceServer(s grpc.ServiceRegistrar, srv FarmServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFarmServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FarmService_ServiceDesc, srv)
+}
+
+func _FarmService_CreateFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).CreateFarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_CreateFarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).CreateFarm(ctx, req.(*CreateFarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_GetFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).GetFarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_GetFarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).GetFarm(ctx, req.(*GetFarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_ListFarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFarmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).ListFarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_ListFarms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).ListFarms(ctx, req.(*ListFarmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_UpdateFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).UpdateFarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_UpdateFarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).UpdateFarm(ctx, req.(*UpdateFarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_DeleteFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).DeleteFarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_DeleteFarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).DeleteFarm(ctx, req.(*DeleteFarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_CreateField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).CreateField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_CreateField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).CreateField(ctx, req.(*CreateFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_GetField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).GetField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_GetField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).GetField(ctx, req.(*GetFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_ListFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).ListFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_ListFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).ListFields(ctx, req.(*ListFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_UpdateField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).UpdateField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_UpdateField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).UpdateField(ctx, req.(*UpdateFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_DeleteField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).DeleteField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_DeleteField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).DeleteField(ctx, req.(*DeleteFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SearchNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SearchNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_SearchNearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SearchNearby(ctx, req.(*SearchNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SearchContaining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SearchContaining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_SearchContaining_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SearchContaining(ctx, req.(*SearchContainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SearchIntersecting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIntersectingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SearchIntersecting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_SearchIntersecting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SearchIntersecting(ctx, req.(*SearchIntersectingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SearchBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SearchBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_SearchBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SearchBox(ctx, req.(*SearchBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FarmService_ServiceDesc is the grpc.ServiceDesc for FarmService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FarmService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "farm.FarmService",
+	HandlerType: (*FarmServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFarm",
+			Handler:    _FarmService_CreateFarm_Handler,
+		},
+		{
+			MethodName: "GetFarm",
+			Handler:    _FarmService_GetFarm_Handler,
+		},
+		{
+			MethodName: "ListFarms",
+			Handler:    _FarmService_ListFarms_Handler,
+		},
+		{
+			MethodName: "UpdateFarm",
+			Handler:    _FarmService_UpdateFarm_Handler,
+		},
+		{
+			MethodName: "DeleteFarm",
+			Handler:    _FarmService_DeleteFarm_Handler,
+		},
+		{
+			MethodName: "CreateField",
+			Handler:    _FarmService_CreateField_Handler,
+		},
+		{
+			MethodName: "GetField",
+			Handler:    _FarmService_GetField_Handler,
+		},
+		{
+			MethodName: "ListFields",
+			Handler:    _FarmService_ListFields_Handler,
+		},
+		{
+			MethodName: "UpdateField",
+			Handler:    _FarmService_UpdateField_Handler,
+		},
+		{
+			MethodName: "DeleteField",
+			Handler:    _FarmService_DeleteField_Handler,
+		},
+		{
+			MethodName: "SearchNearby",
+			Handler:    _FarmService_SearchNearby_Handler,
+		},
+		{
+			MethodName: "SearchContaining",
+			Handler:    _FarmService_SearchContaining_Handler,
+		},
+		{
+			MethodName: "SearchIntersecting",
+			Handler:    _FarmService_SearchIntersecting_Handler,
+		},
+		{
+			MethodName: "SearchBox",
+			Handler:    _FarmService_SearchBox_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "farm.proto",
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	rbacrepository "github.com/aburifat/go-agro/pkg/backend/services/rbac_service/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateFarm stores farm and binds its owner to ownerRole in the farm's
+// scope, so that the owner's access is checked like everybody else's.
+func CreateFarm(db *gorm.DB, farm *api.Farm, ownerRole string) (string, error) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(farm).Error; err != nil {
+			return repoerr.FromGorm(err, "failed to insert farm")
+		}
+		role, err := rbacrepository.GetRoleByName(tx, ownerRole)
+		if err != nil {
+			return err
+		}
+		_, err = rbacrepository.Grant(tx, &api.RoleBinding{
+			UserID:    farm.OwnerID,
+			RoleID:    role.ID,
+			ScopeType: api.ScopeFarm,
+			ScopeID:   farm.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return farm.ID, nil
+}
+
+func GetFarm(db *gorm.DB, id string) (*api.Farm, error) {
+	if err := validateID("id", id); err != nil {
+		return nil, err
+	}
+	var farm api.Farm
+	result := db.First(&farm, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no farm found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch farm")
+	}
+	return &farm, nil
+}
+
+// ListFarms returns the farms owned by ownerID, oldest first.
+func ListFarms(db *gorm.DB, ownerID string, q pagination.Query) (*pagination.Page[api.Farm], error) {
+	if err := validateID("ownerId", ownerID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "created_at"}
+	tx := db.Model(&api.Farm{}).Where("owner_id = ?", ownerID)
+	return pagination.List(tx, q, func(f *api.Farm) (string, string) {
+		return f.CreatedAt.UTC().Format(time.RFC3339Nano), f.ID
+	})
+}
+
+// UpdateFarm applies the non-empty fields of farm to the farm with the given
+// id. The boundary is removed when clearBoundary is set and farm has none.
+func UpdateFarm(db *gorm.DB, id string, farm *api.Farm, clearBoundary bool) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	updates := map[string]any{}
+	if farm.Name != "" {
+		updates["name"] = farm.Name
+	}
+	if farm.Address != "" {
+		updates["address"] = farm.Address
+	}
+	if farm.Boundary != nil {
+		updates["boundary"] = *farm.Boundary
+		updates["area_hectares"] = farm.AreaHectares
+	} else if clearBoundary {
+		updates["boundary"] = nil
+		updates["area_hectares"] = 0
+	}
+
+	result := db.Model(&api.Farm{}).Where("id = ?", id).Updates(updates)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to update farm")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no farm found with ID: %s", id)
+	}
+	return nil
+}
+
+// DeleteFarm deletes the farm with its fields and the role bindings scoped
+// to it.
+func DeleteFarm(db *gorm.DB, id string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", id).Delete(&api.Farm{})
+		if result.Error != nil {
+			return repoerr.FromGorm(result.Error, "failed to delete farm")
+		}
+		if result.RowsAffected == 0 {
+			return repoerr.NotFound("id", "no farm found with ID: %s", id)
+		}
+		result = tx.Where("scope_type = ? AND scope_id = ?", api.ScopeFarm, id).Delete(&api.RoleBinding{})
+		if result.Error != nil {
+			return repoerr.FromGorm(result.Error, "failed to delete farm role bindings")
+		}
+		return nil
+	})
+}
+
+func CreateField(db *gorm.DB, field *api.Field) (string, error) {
+	result := db.Create(field)
+	if result.Error != nil {
+		return "", repoerr.FromGorm(result.Error, "failed to insert field")
+	}
+	return field.ID, nil
+}
+
+func GetField(db *gorm.DB, id string) (*api.Field, error) {
+	if err := validateID("id", id); err != nil {
+		return nil, err
+	}
+	var field api.Field
+	result := db.First(&field, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no field found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch field")
+	}
+	return &field, nil
+}
+
+// GetFieldFarm returns the farm the field with the given id belongs to.
+func GetFieldFarm(db *gorm.DB, fieldID string) (*api.Farm, error) {
+	if err := validateID("fieldId", fieldID); err != nil {
+		return nil, err
+	}
+	var farm api.Farm
+	result := db.Select("farms.*").Joins("JOIN fields ON fields.farm_id = farms.id").Where("fields.id = ?", fieldID).First(&farm)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("fieldId", "no field found with ID: %s", fieldID)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch farm of field")
+	}
+	return &farm, nil
+}
+
+// ListFields returns the fields of farmID, oldest first.
+func ListFields(db *gorm.DB, farmID string, q pagination.Query) (*pagination.Page[api.Field], error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "created_at"}
+	tx := db.Model(&api.Field{}).Where("farm_id = ?", farmID)
+	return pagination.List(tx, q, func(f *api.Field) (string, string) {
+		return f.CreatedAt.UTC().Format(time.RFC3339Nano), f.ID
+	})
+}
+
+// UpdateField applies the non-empty fields of field to the field with the
+// given id.
+func UpdateField(db *gorm.DB, id string, field *api.Field) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	result := db.Model(&api.Field{}).Where("id = ?", id).Updates(field)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to update field")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no field found with ID: %s", id)
+	}
+	return nil
+}
+
+func DeleteField(db *gorm.DB, id string) error {
+	if err := validateID("id", id); err != nil {
+		return err
+	}
+	result := db.Where("id = ?", id).Delete(&api.Field{})
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to delete field")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("id", "no field found with ID: %s", id)
+	}
+	return nil
+}
+
+func validateID(field, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return repoerr.InvalidArgument(field, "invalid uid format: %v", err)
+	}
+	return nil
+}
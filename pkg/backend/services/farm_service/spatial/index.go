@@ -0,0 +1,447 @@
+// Package spatial keeps the boundaries of farms and fields in a MongoDB
+// collection with a 2dsphere index and answers spatial queries on them.
+// Postgres remains the source of truth; the collection is rebuilt from it on
+// start.
+package spatial
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+)
+
+const CollectionName = "spatial_features"
+
+const (
+	LayerFields = "fields"
+	LayerFarms  = "farms"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+
+	rebuildBatchSize = 500
+)
+
+// Index is the spatial index of farm and field boundaries.
+type Index struct {
+	collection *mongo.Collection
+}
+
+func NewIndex(store *storage.Storage) *Index {
+	index := Index{
+		collection: store.GetCollection(CollectionName),
+	}
+	return &index
+}
+
+type geometry struct {
+	Type        string `bson:"type"`
+	Coordinates any    `bson:"coordinates"`
+}
+
+// document is stored per farm and field. FarmID is the farm's own id for
+// farms so that farm scoped grants filter both layers alike.
+type document struct {
+	ID             string    `bson:"_id"`
+	Layer          string    `bson:"layer"`
+	FarmID         string    `bson:"farmId"`
+	OrganisationID string    `bson:"organisationId"`
+	Name           string    `bson:"name"`
+	SoilType       string    `bson:"soilType,omitempty"`
+	AreaHectares   float64   `bson:"areaHectares"`
+	Geometry       geometry  `bson:"geometry"`
+	IndexedAt      time.Time `bson:"indexedAt"`
+}
+
+// Feature is a farm or field matching a query.
+type Feature struct {
+	ID             string   `bson:"_id"`
+	Layer          string   `bson:"layer"`
+	FarmID         string   `bson:"farmId"`
+	OrganisationID string   `bson:"organisationId"`
+	Name           string   `bson:"name"`
+	SoilType       string   `bson:"soilType"`
+	AreaHectares   float64  `bson:"areaHectares"`
+	Geometry       bson.Raw `bson:"geometry"`
+	// DistanceMeters is only set by Nearby.
+	DistanceMeters *float64 `bson:"distance,omitempty"`
+}
+
+// EnsureIndexes creates the indexes the queries rely on.
+func (i *Index) EnsureIndexes(ctx context.Context) error {
+	_, err := i.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "layer", Value: 1}, {Key: "geometry", Value: "2dsphere"}}},
+		{Keys: bson.D{{Key: "farmId", Value: 1}}},
+		{Keys: bson.D{{Key: "indexedAt", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create spatial indexes: %w", err)
+	}
+	return nil
+}
+
+// PutField indexes field, which belongs to a farm of organisationID.
+func (i *Index) PutField(ctx context.Context, field *api.Field, organisationID string) error {
+	doc, err := fieldDocument(field, organisationID, time.Now())
+	if err != nil {
+		return err
+	}
+	return i.replace(ctx, doc)
+}
+
+// PutFarm indexes farm, or removes it when it has no boundary.
+func (i *Index) PutFarm(ctx context.Context, farm *api.Farm) error {
+	if farm.Boundary == nil {
+		return i.delete(ctx, bson.D{{Key: "_id", Value: farm.ID}})
+	}
+	doc, err := farmDocument(farm, time.Now())
+	if err != nil {
+		return err
+	}
+	return i.replace(ctx, doc)
+}
+
+func (i *Index) DeleteField(ctx context.Context, id string) error {
+	return i.delete(ctx, bson.D{{Key: "_id", Value: id}})
+}
+
+// DeleteFarm removes the farm and its fields.
+func (i *Index) DeleteFarm(ctx context.Context, id string) error {
+	return i.delete(ctx, bson.D{{Key: "farmId", Value: id}})
+}
+
+func (i *Index) replace(ctx context.Context, doc *document) error {
+	_, err := i.collection.ReplaceOne(ctx, bson.D{{Key: "_id", Value: doc.ID}}, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to index %s %s: %w", doc.Layer, doc.ID, err)
+	}
+	return nil
+}
+
+func (i *Index) delete(ctx context.Context, filter bson.D) error {
+	if _, err := i.collection.DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("failed to remove from spatial index: %w", err)
+	}
+	return nil
+}
+
+// Rebuild indexes every farm and field stored in db and removes documents
+// of farms and fields that no longer exist. Writes that race with a rebuild
+// may be lost until the next one.
+func (i *Index) Rebuild(ctx context.Context, db *gorm.DB) error {
+	// MongoDB stores milliseconds, documents written now must not compare
+	// as older than start
+	start := time.Now().Truncate(time.Millisecond)
+	db = db.WithContext(ctx)
+
+	var owned []struct {
+		ID             string
+		OrganisationID string
+	}
+	if err := db.Model(&api.Farm{}).Where("organisation_id <> ''").Find(&owned).Error; err != nil {
+		return fmt.Errorf("failed to load farm organisations: %w", err)
+	}
+	organisations := make(map[string]string, len(owned))
+	for _, farm := range owned {
+		organisations[farm.ID] = farm.OrganisationID
+	}
+
+	var farms []*api.Farm
+	err := db.Where("boundary IS NOT NULL").FindInBatches(&farms, rebuildBatchSize, func(tx *gorm.DB, batch int) error {
+		docs := make([]*document, 0, len(farms))
+		for _, farm := range farms {
+			doc, err := farmDocument(farm, start)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		return i.replaceMany(ctx, docs)
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	var fields []*api.Field
+	err = db.FindInBatches(&fields, rebuildBatchSize, func(tx *gorm.DB, batch int) error {
+		docs := make([]*document, 0, len(fields))
+		for _, field := range fields {
+			doc, err := fieldDocument(field, organisations[field.FarmID], start)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		return i.replaceMany(ctx, docs)
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return i.delete(ctx, bson.D{{Key: "indexedAt", Value: bson.D{{Key: "$lt", Value: start}}}})
+}
+
+func (i *Index) replaceMany(ctx context.Context, docs []*document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: doc.ID}}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+	if _, err := i.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to index boundaries: %w", err)
+	}
+	return nil
+}
+
+func fieldDocument(field *api.Field, organisationID string, now time.Time) (*document, error) {
+	boundary, err := geo.Parse(field.Boundary)
+	if err != nil {
+		return nil, fmt.Errorf("field %s has an invalid boundary: %w", field.ID, err)
+	}
+	return &document{
+		ID:             field.ID,
+		Layer:          LayerFields,
+		FarmID:         field.FarmID,
+		OrganisationID: organisationID,
+		Name:           field.Name,
+		SoilType:       field.SoilType,
+		AreaHectares:   field.AreaHectares,
+		Geometry:       toGeometry(boundary),
+		IndexedAt:      now,
+	}, nil
+}
+
+func farmDocument(farm *api.Farm, now time.Time) (*document, error) {
+	boundary, err := geo.Parse(*farm.Boundary)
+	if err != nil {
+		return nil, fmt.Errorf("farm %s has an invalid boundary: %w", farm.ID, err)
+	}
+	return &document{
+		ID:             farm.ID,
+		Layer:          LayerFarms,
+		FarmID:         farm.ID,
+		OrganisationID: farm.OrganisationID,
+		Name:           farm.Name,
+		AreaHectares:   farm.AreaHectares,
+		Geometry:       toGeometry(boundary),
+		IndexedAt:      now,
+	}, nil
+}
+
+func toGeometry(m geo.MultiPolygon) geometry {
+	if len(m) == 1 {
+		return geometry{Type: "Polygon", Coordinates: m[0]}
+	}
+	return geometry{Type: "MultiPolygon", Coordinates: m}
+}
+
+// Query selects the layer to search and restricts the result to the farms
+// and organisations in grants.
+type Query struct {
+	Layer  string
+	Grants rbac.Grants
+	// Limit defaults to DefaultLimit and is capped at MaxLimit.
+	Limit int
+}
+
+func (q Query) filter() (bson.D, bool) {
+	filter := bson.D{{Key: "layer", Value: q.Layer}}
+	if q.Grants.All {
+		return filter, true
+	}
+	if len(q.Grants.FarmIDs) == 0 && len(q.Grants.OrganisationIDs) == 0 {
+		return nil, false
+	}
+	return append(filter, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "farmId", Value: bson.D{{Key: "$in", Value: nonNil(q.Grants.FarmIDs)}}}},
+		bson.D{{Key: "organisationId", Value: bson.D{{Key: "$in", Value: nonNil(q.Grants.OrganisationIDs)}}}},
+	}}), true
+}
+
+func (q Query) limit() int64 {
+	switch {
+	case q.Limit <= 0:
+		return DefaultLimit
+	case q.Limit > MaxLimit:
+		return MaxLimit
+	}
+	return int64(q.Limit)
+}
+
+// nonNil keeps $in from being sent a null array.
+func nonNil(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
+// Nearby returns the features closest to point, nearest first. Distances
+// are measured to the nearest point of a boundary and are 0 inside it.
+// maxDistance limits the distance in metres unless it is 0.
+func (i *Index) Nearby(ctx context.Context, q Query, point geo.Position, maxDistance float64) ([]*Feature, error) {
+	pipeline, ok := q.nearbyPipeline(point, maxDistance)
+	if !ok {
+		return nil, nil
+	}
+	cursor, err := i.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search nearby features: %w", err)
+	}
+	return decode(ctx, cursor)
+}
+
+// NearbyIDs returns the ids of all features within maxDistance metres of
+// point, or of all features if it is 0, nearest first. Unlike Nearby it is
+// not limited to MaxLimit features.
+func (i *Index) NearbyIDs(ctx context.Context, q Query, point geo.Position, maxDistance float64) ([]string, error) {
+	pipeline, ok := q.nearbyIDsPipeline(point, maxDistance)
+	if !ok {
+		return nil, nil
+	}
+	cursor, err := i.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search nearby features: %w", err)
+	}
+	defer cursor.Close(ctx)
+	var ids []string
+	for cursor.Next(ctx) {
+		var f struct {
+			ID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&f); err != nil {
+			return nil, fmt.Errorf("failed to decode features: %w", err)
+		}
+		ids = append(ids, f.ID)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read nearby features: %w", err)
+	}
+	return ids, nil
+}
+
+func (q Query) nearbyPipeline(point geo.Position, maxDistance float64) (mongo.Pipeline, bool) {
+	geoNear, ok := q.geoNear(point, maxDistance)
+	if !ok {
+		return nil, false
+	}
+	return mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear}},
+		{{Key: "$limit", Value: q.limit()}},
+	}, true
+}
+
+func (q Query) nearbyIDsPipeline(point geo.Position, maxDistance float64) (mongo.Pipeline, bool) {
+	geoNear, ok := q.geoNear(point, maxDistance)
+	if !ok {
+		return nil, false
+	}
+	return mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear}},
+		{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}, true
+}
+
+func (q Query) geoNear(point geo.Position, maxDistance float64) (bson.D, bool) {
+	filter, ok := q.filter()
+	if !ok {
+		return nil, false
+	}
+	geoNear := bson.D{
+		{Key: "near", Value: bson.D{{Key: "type", Value: "Point"}, {Key: "coordinates", Value: point}}},
+		{Key: "key", Value: "geometry"},
+		{Key: "distanceField", Value: "distance"},
+		{Key: "spherical", Value: true},
+		{Key: "query", Value: filter},
+	}
+	if maxDistance > 0 {
+		geoNear = append(geoNear, bson.E{Key: "maxDistance", Value: maxDistance})
+	}
+	return geoNear, true
+}
+
+// Containing returns the features whose boundary contains point.
+func (i *Index) Containing(ctx context.Context, q Query, point geo.Position) ([]*Feature, error) {
+	return i.intersecting(ctx, q, geometry{Type: "Point", Coordinates: point})
+}
+
+// Intersecting returns the features whose boundary intersects m.
+func (i *Index) Intersecting(ctx context.Context, q Query, m geo.MultiPolygon) ([]*Feature, error) {
+	return i.intersecting(ctx, q, toGeometry(m))
+}
+
+// Box returns the features intersecting the box between min and max. The
+// edges of the box are geodesics, so boxes may span at most 180 degrees of
+// longitude and should stay well clear of the poles.
+func (i *Index) Box(ctx context.Context, q Query, min, max geo.Position) ([]*Feature, error) {
+	box, err := boxGeometry(min, max)
+	if err != nil {
+		return nil, err
+	}
+	return i.intersecting(ctx, q, box)
+}
+
+func boxGeometry(min, max geo.Position) (geometry, error) {
+	if min.Lon() >= max.Lon() || min.Lat() >= max.Lat() {
+		return geometry{}, repoerr.InvalidArgument("maxLongitude", "box minimum must lie south-west of its maximum")
+	}
+	if max.Lon()-min.Lon() >= 180 {
+		return geometry{}, repoerr.InvalidArgument("maxLongitude", "box must span less than 180 degrees of longitude")
+	}
+	box := geo.Polygon{geo.Ring{
+		min,
+		{max.Lon(), min.Lat()},
+		max,
+		{min.Lon(), max.Lat()},
+		min,
+	}}
+	return geometry{Type: "Polygon", Coordinates: box}, nil
+}
+
+func (i *Index) intersecting(ctx context.Context, q Query, g geometry) ([]*Feature, error) {
+	filter, ok := q.intersectsFilter(g)
+	if !ok {
+		return nil, nil
+	}
+	cursor, err := i.collection.Find(ctx, filter, options.Find().SetLimit(q.limit()).SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search features: %w", err)
+	}
+	return decode(ctx, cursor)
+}
+
+func (q Query) intersectsFilter(g geometry) (bson.D, bool) {
+	filter, ok := q.filter()
+	if !ok {
+		return nil, false
+	}
+	return append(filter, bson.E{Key: "geometry", Value: bson.D{
+		{Key: "$geoIntersects", Value: bson.D{{Key: "$geometry", Value: g}}},
+	}}), true
+}
+
+func decode(ctx context.Context, cursor *mongo.Cursor) ([]*Feature, error) {
+	var features []*Feature
+	if err := cursor.All(ctx, &features); err != nil {
+		return nil, fmt.Errorf("failed to decode features: %w", err)
+	}
+	return features, nil
+}
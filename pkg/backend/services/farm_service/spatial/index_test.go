@@ -0,0 +1,325 @@
+package spatial
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		grants rbac.Grants
+		want   bson.D
+		wantOK bool
+	}{
+		{
+			name:   "all",
+			grants: rbac.Grants{All: true, FarmIDs: []string{"f1"}},
+			want:   bson.D{{Key: "layer", Value: LayerFields}},
+			wantOK: true,
+		},
+		{
+			name:   "nothing",
+			grants: rbac.Grants{},
+		},
+		{
+			name:   "farms",
+			grants: rbac.Grants{FarmIDs: []string{"f1", "f2"}},
+			want: bson.D{
+				{Key: "layer", Value: LayerFields},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "farmId", Value: bson.D{{Key: "$in", Value: []string{"f1", "f2"}}}}},
+					bson.D{{Key: "organisationId", Value: bson.D{{Key: "$in", Value: []string{}}}}},
+				}},
+			},
+			wantOK: true,
+		},
+		{
+			name:   "organisations",
+			grants: rbac.Grants{OrganisationIDs: []string{"o1"}},
+			want: bson.D{
+				{Key: "layer", Value: LayerFields},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "farmId", Value: bson.D{{Key: "$in", Value: []string{}}}}},
+					bson.D{{Key: "organisationId", Value: bson.D{{Key: "$in", Value: []string{"o1"}}}}},
+				}},
+			},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Query{Layer: LayerFields, Grants: tt.grants}.filter()
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filter() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	for limit, want := range map[int]int64{-1: DefaultLimit, 0: DefaultLimit, 5: 5, MaxLimit: MaxLimit, MaxLimit + 1: MaxLimit} {
+		if got := (Query{Limit: limit}).limit(); got != want {
+			t.Errorf("limit() of %d = %d, want %d", limit, got, want)
+		}
+	}
+}
+
+func TestNearbyPipeline(t *testing.T) {
+	point := geo.Position{10, 50}
+	q := Query{Layer: LayerFarms, Grants: rbac.Grants{All: true}, Limit: 5}
+	geoNear := func(extra ...bson.E) bson.D {
+		return append(bson.D{
+			{Key: "near", Value: bson.D{{Key: "type", Value: "Point"}, {Key: "coordinates", Value: point}}},
+			{Key: "key", Value: "geometry"},
+			{Key: "distanceField", Value: "distance"},
+			{Key: "spherical", Value: true},
+			{Key: "query", Value: bson.D{{Key: "layer", Value: LayerFarms}}},
+		}, extra...)
+	}
+
+	got, ok := q.nearbyPipeline(point, 1000)
+	want := mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear(bson.E{Key: "maxDistance", Value: 1000.0})}},
+		{{Key: "$limit", Value: int64(5)}},
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("nearbyPipeline() = %v, want %v", got, want)
+	}
+
+	// unbounded
+	got, ok = q.nearbyPipeline(point, 0)
+	want = mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear()}},
+		{{Key: "$limit", Value: int64(5)}},
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("nearbyPipeline() = %v, want %v", got, want)
+	}
+
+	// every id, however many
+	got, ok = q.nearbyIDsPipeline(point, 1000)
+	want = mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear(bson.E{Key: "maxDistance", Value: 1000.0})}},
+		{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("nearbyIDsPipeline() = %v, want %v", got, want)
+	}
+
+	if _, ok := (Query{Layer: LayerFarms}).nearbyPipeline(point, 0); ok {
+		t.Error("nearbyPipeline() without grants is ok")
+	}
+	if _, ok := (Query{Layer: LayerFarms}).nearbyIDsPipeline(point, 0); ok {
+		t.Error("nearbyIDsPipeline() without grants is ok")
+	}
+}
+
+func TestIntersectsFilter(t *testing.T) {
+	g := geometry{Type: "Point", Coordinates: geo.Position{10, 50}}
+	got, ok := Query{Layer: LayerFields, Grants: rbac.Grants{FarmIDs: []string{"f1"}}}.intersectsFilter(g)
+	want := bson.D{
+		{Key: "layer", Value: LayerFields},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "farmId", Value: bson.D{{Key: "$in", Value: []string{"f1"}}}}},
+			bson.D{{Key: "organisationId", Value: bson.D{{Key: "$in", Value: []string{}}}}},
+		}},
+		{Key: "geometry", Value: bson.D{{Key: "$geoIntersects", Value: bson.D{{Key: "$geometry", Value: g}}}}},
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("intersectsFilter() = %v, want %v", got, want)
+	}
+	if _, ok := (Query{Layer: LayerFields}).intersectsFilter(g); ok {
+		t.Error("intersectsFilter() without grants is ok")
+	}
+}
+
+func TestBoxGeometry(t *testing.T) {
+	got, err := boxGeometry(geo.Position{10, 50}, geo.Position{11, 51})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// counter-clockwise, closed
+	want := geometry{Type: "Polygon", Coordinates: geo.Polygon{geo.Ring{{10, 50}, {11, 50}, {11, 51}, {10, 51}, {10, 50}}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("boxGeometry() = %v, want %v", got, want)
+	}
+
+	for name, box := range map[string][2]geo.Position{
+		"inverted":    {{11, 51}, {10, 50}},
+		"flat":        {{10, 50}, {11, 50}},
+		"half sphere": {{-90, 0}, {90, 1}},
+	} {
+		_, err := boxGeometry(box[0], box[1])
+		if repoerr.KindOf(err) != repoerr.KindInvalidArgument {
+			t.Errorf("%s: boxGeometry() error = %v, want an invalid argument", name, err)
+		}
+	}
+}
+
+func TestDocuments(t *testing.T) {
+	now := time.Now()
+	polygon := `{"type":"Polygon","coordinates":[[[10,50],[10.01,50],[10.01,50.01],[10,50.01],[10,50]]]}`
+	pair := `{"type":"MultiPolygon","coordinates":[[[[10,50],[10.01,50],[10.01,50.01],[10,50.01],[10,50]]],[[[11,50],[11.01,50],[11.01,50.01],[11,50.01],[11,50]]]]}`
+
+	doc, err := fieldDocument(&api.Field{ID: "fd1", FarmID: "f1", Name: "North", SoilType: "loam", Boundary: polygon}, "o1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Layer != LayerFields || doc.FarmID != "f1" || doc.OrganisationID != "o1" || doc.SoilType != "loam" || doc.Geometry.Type != "Polygon" || !doc.IndexedAt.Equal(now) {
+		t.Errorf("fieldDocument() = %+v", doc)
+	}
+
+	// farms are their own farm
+	doc, err = farmDocument(&api.Farm{ID: "f1", OrganisationID: "o1", Name: "Home", Boundary: &pair}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Layer != LayerFarms || doc.FarmID != "f1" || doc.Geometry.Type != "MultiPolygon" {
+		t.Errorf("farmDocument() = %+v", doc)
+	}
+
+	if _, err := fieldDocument(&api.Field{ID: "fd2", Boundary: `{"type":"Point","coordinates":[10,50]}`}, "", now); err == nil {
+		t.Error("fieldDocument() of a point = nil error")
+	}
+}
+
+// square returns a GeoJSON square of 0.01 degrees with its south-west
+// corner at lon, lat.
+func square(lon, lat float64) string {
+	raw, err := json.Marshal(map[string]any{
+		"type":        "Polygon",
+		"coordinates": geo.Polygon{geo.Ring{{lon, lat}, {lon + 0.01, lat}, {lon + 0.01, lat + 0.01}, {lon, lat + 0.01}, {lon, lat}}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return string(raw)
+}
+
+func ids(features []*Feature) []string {
+	ids := []string{}
+	for _, f := range features {
+		ids = append(ids, f.ID)
+	}
+	return ids
+}
+
+func TestRebuild(t *testing.T) {
+	db := dbtest.Postgres(t)
+	index := NewIndex(dbtest.Mongo(t))
+	ctx := context.Background()
+	if err := index.EnsureIndexes(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := &api.User{Username: "ada", Email: "ada@example.com", Password: "x"}
+	if err := db.Create(owner).Error; err != nil {
+		t.Fatal(err)
+	}
+	home := square(10, 50)
+	farm := &api.Farm{OwnerID: owner.ID, OrganisationID: "o1", Name: "Home", Boundary: &home}
+	// without a boundary, its fields are still indexed
+	bare := &api.Farm{OwnerID: owner.ID, Name: "Bare"}
+	if err := db.Create([]*api.Farm{farm, bare}).Error; err != nil {
+		t.Fatal(err)
+	}
+	north := &api.Field{FarmID: farm.ID, Name: "North", Boundary: square(10, 50)}
+	east := &api.Field{FarmID: bare.ID, Name: "East", Boundary: square(10.1, 50)}
+	if err := db.Create([]*api.Field{north, east}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// indexed before the field was renamed, the other field deleted since
+	old := time.Now().Add(-time.Hour)
+	renamed, err := fieldDocument(&api.Field{ID: north.ID, FarmID: farm.ID, Name: "Old name", Boundary: north.Boundary}, "", old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := fieldDocument(&api.Field{ID: "00000000-0000-0000-0000-000000000001", FarmID: farm.ID, Name: "Gone", Boundary: square(10.2, 50)}, "o1", old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := index.replaceMany(ctx, []*document{renamed, deleted}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := index.Rebuild(ctx, db); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+
+	var docs []*document
+	cursor, err := index.collection.Find(ctx, bson.D{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, doc := range docs {
+		got[doc.ID] = doc.Layer + " " + doc.Name + " " + doc.OrganisationID
+	}
+	want := map[string]string{
+		farm.ID:  "farms Home o1",
+		north.ID: "fields North o1",
+		east.ID:  "fields East ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("indexed %v, want %v", got, want)
+	}
+
+	all := Query{Layer: LayerFields, Grants: rbac.Grants{All: true}}
+	features, err := index.Containing(ctx, all, geo.Position{10.005, 50.005})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(features); !reflect.DeepEqual(got, []string{north.ID}) {
+		t.Errorf("Containing() = %v, want %v", got, []string{north.ID})
+	}
+
+	// only the fields of the farms and organisations granted
+	for name, grants := range map[string]rbac.Grants{
+		"farm":         {FarmIDs: []string{bare.ID}},
+		"organisation": {OrganisationIDs: []string{"o2"}, FarmIDs: []string{bare.ID}},
+	} {
+		features, err := index.Box(ctx, Query{Layer: LayerFields, Grants: grants}, geo.Position{9, 49}, geo.Position{11, 51})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(features); !reflect.DeepEqual(got, []string{east.ID}) {
+			t.Errorf("%s: Box() = %v, want %v", name, got, []string{east.ID})
+		}
+	}
+
+	features, err = index.Nearby(ctx, all, geo.Position{10.2, 50.005}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(features); !reflect.DeepEqual(got, []string{east.ID, north.ID}) {
+		t.Errorf("Nearby() = %v, want nearest first", got)
+	}
+	if features[0].DistanceMeters == nil || *features[0].DistanceMeters >= *features[1].DistanceMeters {
+		t.Errorf("Nearby() distances are not ascending")
+	}
+
+	farmIDs, err := index.NearbyIDs(ctx, Query{Layer: LayerFarms, Grants: rbac.Grants{All: true}}, geo.Position{10.005, 50.005}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(farmIDs, []string{farm.ID}) {
+		t.Errorf("NearbyIDs() = %v, want %v", farmIDs, []string{farm.ID})
+	}
+}
@@ -0,0 +1,212 @@
+// Package growth tracks the development of plantings and keeps the latest
+// prediction of every planting in MongoDB. Predictions are recomputed when
+// they are read after the planting, its crop or the weather at its field
+// changed, and at least daily.
+package growth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+	farmrepository "github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/growth_service/phenology"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+)
+
+const CollectionName = "growth_predictions"
+
+// Tracker predicts and stores the growth of plantings.
+type Tracker struct {
+	db         *gorm.DB
+	weather    *weather.Store
+	collection *mongo.Collection
+}
+
+func NewTracker(db *gorm.DB, store *storage.Storage, weatherStore *weather.Store) *Tracker {
+	tracker := Tracker{
+		db:         db,
+		weather:    weatherStore,
+		collection: store.GetCollection(CollectionName),
+	}
+	return &tracker
+}
+
+// Growth is the predicted development of a planting on the day it was
+// computed. Degree days are in °C·d above BaseTemperature.
+type Growth struct {
+	PlantingPlanID string    `bson:"_id"`
+	FieldID        string    `bson:"fieldId"`
+	FarmID         string    `bson:"farmId"`
+	CropID         string    `bson:"cropId"`
+	SowingDate     time.Time `bson:"sowingDate"`
+	// StationID is the weather station closest to the field, empty when
+	// none is linked to its farm or the crop's thermal time is unknown.
+	StationID          string     `bson:"stationId,omitempty"`
+	Method             string     `bson:"method"`
+	Stage              string     `bson:"stage"`
+	Progress           float64    `bson:"progress"`
+	DegreeDays         float64    `bson:"degreeDays"`
+	RequiredDegreeDays float64    `bson:"requiredDegreeDays"`
+	BaseTemperature    float64    `bson:"baseTemperature"`
+	EstimatedDays      int        `bson:"estimatedDays"`
+	DailyRate          float64    `bson:"dailyRate"`
+	Harvest            *time.Time `bson:"harvest,omitempty"`
+	HarvestEarliest    *time.Time `bson:"harvestEarliest,omitempty"`
+	HarvestLatest      *time.Time `bson:"harvestLatest,omitempty"`
+	ComputedAt         time.Time  `bson:"computedAt"`
+}
+
+// Get returns the growth of the planting plan with the given id, computing
+// it unless the stored prediction is current.
+func (t *Tracker) Get(ctx context.Context, planID string, now time.Time) (*Growth, error) {
+	plan, err := croprepository.GetPlantingPlan(t.db.WithContext(ctx), planID)
+	if err != nil {
+		return nil, err
+	}
+	switch plan.Status {
+	case api.PlantingStatusHarvested, api.PlantingStatusCancelled:
+		return nil, repoerr.FailedPrecondition("status", "planting plan %s is %s", planID, plan.Status)
+	}
+	return t.get(ctx, plan, now)
+}
+
+// ListFarm returns the growth of the plantings planned or sown on the
+// fields of farmID today.
+func (t *Tracker) ListFarm(ctx context.Context, farmID string, now time.Time) ([]*Growth, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	plans, err := croprepository.ListActiveFarmPlantingPlans(t.db.WithContext(ctx), farmID, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	growths := make([]*Growth, 0, len(plans))
+	for i := range plans {
+		g, err := t.get(ctx, &plans[i], now)
+		if err != nil {
+			return nil, err
+		}
+		growths = append(growths, g)
+	}
+	return growths, nil
+}
+
+func (t *Tracker) get(ctx context.Context, plan *api.PlantingPlan, now time.Time) (*Growth, error) {
+	field, err := farmrepository.GetField(t.db.WithContext(ctx), plan.FieldID)
+	if err != nil {
+		return nil, err
+	}
+	var station *weather.Station
+	if plan.Crop.DegreeDaysToMaturity > 0 {
+		boundary, err := geo.Parse(field.Boundary)
+		if err != nil {
+			return nil, fmt.Errorf("field %s has an invalid boundary: %w", field.ID, err)
+		}
+		// without a station the calendar is used
+		station, err = t.weather.StationFor(ctx, field.FarmID, boundary.Center())
+		if err != nil && !errors.Is(err, repoerr.ErrFailedPrecondition) {
+			return nil, err
+		}
+	}
+
+	var stored Growth
+	err = t.collection.FindOne(ctx, bson.D{{Key: "_id", Value: plan.ID}}).Decode(&stored)
+	if err == nil && current(&stored, plan, station, now) {
+		return &stored, nil
+	}
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to fetch growth prediction: %w", err)
+	}
+
+	g, err := t.compute(ctx, plan, field, station, now)
+	if err != nil {
+		return nil, err
+	}
+	_, err = t.collection.ReplaceOne(ctx, bson.D{{Key: "_id", Value: plan.ID}}, g, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to store growth prediction: %w", err)
+	}
+	return g, nil
+}
+
+// current reports whether stored was computed today from the current plan,
+// crop and weather.
+func current(stored *Growth, plan *api.PlantingPlan, station *weather.Station, now time.Time) bool {
+	computedAt := stored.ComputedAt
+	if computedAt.Before(now.UTC().Truncate(24*time.Hour)) ||
+		plan.UpdatedAt.After(computedAt) || plan.Crop.UpdatedAt.After(computedAt) {
+		return false
+	}
+	if station == nil {
+		return stored.StationID == ""
+	}
+	// every import updates the station
+	return stored.StationID == station.ID && !station.UpdatedAt.After(computedAt)
+}
+
+// compute predicts the growth of plan from the degree days since sowing at
+// station, or from the calendar when there is no station, too little
+// weather or the crop's thermal time to maturity is unknown.
+func (t *Tracker) compute(ctx context.Context, plan *api.PlantingPlan, field *api.Field, station *weather.Station, now time.Time) (*Growth, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	crop := plan.Crop
+	g := &Growth{
+		PlantingPlanID:     plan.ID,
+		FieldID:            field.ID,
+		FarmID:             field.FarmID,
+		CropID:             crop.ID,
+		SowingDate:         plan.SowingDate,
+		RequiredDegreeDays: crop.DegreeDaysToMaturity,
+		BaseTemperature:    crop.BaseTemperatureC,
+		ComputedAt:         now.UTC().Truncate(time.Millisecond),
+	}
+
+	if station != nil {
+		g.StationID = station.ID
+	}
+	var prediction *phenology.Prediction
+	// the degree days of today are incomplete
+	seasonDays := int(today.Sub(plan.SowingDate).Hours() / 24)
+	if station != nil && seasonDays > 0 && seasonDays <= weather.MaxSummaryDays {
+		summaries, err := t.weather.Days(ctx, station.ID, plan.SowingDate, today)
+		if err != nil {
+			return nil, err
+		}
+		upper := weather.DefaultUpperTemperature
+		if upper <= crop.BaseTemperatureC {
+			upper = crop.BaseTemperatureC + weather.DefaultUpperTemperature - weather.DefaultBaseTemperature
+		}
+		indices := weather.Accumulate(summaries, plan.SowingDate, today, crop.BaseTemperatureC, upper)
+		prediction = phenology.DegreeDays(indices.Days, crop.DegreeDaysToMaturity)
+	}
+	if prediction == nil {
+		prediction = phenology.Calendar(plan.SowingDate, crop.DaysToMaturity, today)
+	}
+
+	g.Method = prediction.Method
+	g.Stage = prediction.Stage
+	g.Progress = round(prediction.Progress, 3)
+	g.DegreeDays = round(prediction.DegreeDays, 1)
+	g.EstimatedDays = prediction.EstimatedDays
+	g.DailyRate = round(prediction.DailyRate, 2)
+	g.Harvest = prediction.Harvest
+	g.HarvestEarliest = prediction.HarvestEarliest
+	g.HarvestLatest = prediction.HarvestLatest
+	return g, nil
+}
+
+func round(v float64, digits int) float64 {
+	scale := math.Pow10(digits)
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/growth_service/growth"
+	"github.com/aburifat/go-agro/pkg/backend/services/growth_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const dateLayout = "2006-01-02"
+
+var errNoTracker = status.Error(codes.FailedPrecondition, "growth tracking requires MongoDB to be configured")
+
+type GrowthHandler struct {
+	proto.UnimplementedGrowthServiceServer
+	tracker *growth.Tracker
+	logger  *zap.Logger
+}
+
+// NewGrowthHandler returns the GrowthService handler. tracker is nil when
+// MongoDB is not configured, every call then fails with FailedPrecondition.
+func NewGrowthHandler(tracker *growth.Tracker, logger *zap.Logger) *GrowthHandler {
+	growthHandler := GrowthHandler{
+		tracker: tracker,
+		logger:  logger,
+	}
+	return &growthHandler
+}
+
+func (h *GrowthHandler) GetPlantingGrowth(ctx context.Context, req *proto.GetPlantingGrowthRequest) (*proto.GetPlantingGrowthResponse, error) {
+	if h.tracker == nil {
+		return nil, errNoTracker
+	}
+	g, err := h.tracker.Get(ctx, req.GetId(), time.Now())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get planting growth")
+	}
+	return &proto.GetPlantingGrowthResponse{Growth: toProto(g)}, nil
+}
+
+func (h *GrowthHandler) ListFarmGrowth(ctx context.Context, req *proto.ListFarmGrowthRequest) (*proto.ListFarmGrowthResponse, error) {
+	if h.tracker == nil {
+		return nil, errNoTracker
+	}
+	growths, err := h.tracker.ListFarm(ctx, req.GetFarmId(), time.Now())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list farm growth")
+	}
+	response := &proto.ListFarmGrowthResponse{}
+	for _, g := range growths {
+		response.Growths = append(response.Growths, toProto(g))
+	}
+	return response, nil
+}
+
+func toProto(g *growth.Growth) *proto.Growth {
+	return &proto.Growth{
+		PlantingPlanId:     g.PlantingPlanID,
+		FieldId:            g.FieldID,
+		CropId:             g.CropID,
+		SowingDate:         g.SowingDate.Format(dateLayout),
+		StationId:          g.StationID,
+		Method:             proto.PredictionMethod(proto.PredictionMethod_value["PREDICTION_METHOD_"+strings.ToUpper(g.Method)]),
+		Stage:              proto.GrowthStage(proto.GrowthStage_value["GROWTH_STAGE_"+strings.ToUpper(g.Stage)]),
+		Progress:           g.Progress,
+		DegreeDays:         g.DegreeDays,
+		RequiredDegreeDays: g.RequiredDegreeDays,
+		BaseTemperature:    g.BaseTemperature,
+		EstimatedDays:      int32(g.EstimatedDays),
+		DailyRate:          g.DailyRate,
+		HarvestDate:        formatDate(g.Harvest),
+		HarvestEarliest:    formatDate(g.HarvestEarliest),
+		HarvestLatest:      formatDate(g.HarvestLatest),
+		ComputedAt:         g.ComputedAt.Format(time.RFC3339),
+	}
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	crophandlers "github.com/aburifat/go-agro/pkg/backend/services/crop_service/handlers"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/growth_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of GrowthService. The growth of a planting is
+// read like the planting plan itself, with crop.read on its farm.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	plan := crophandlers.PlantingPlanResource(db, func(req any) string {
+		return req.(*proto.GetPlantingGrowthRequest).GetId()
+	})
+	farm := farmhandlers.FarmResource(db, func(req any) string {
+		return req.(*proto.ListFarmGrowthRequest).GetFarmId()
+	})
+
+	return auth.Policy{
+		proto.GrowthService_GetPlantingGrowth_FullMethodName: checker.RequireLookup("crop.read", plan),
+		proto.GrowthService_ListFarmGrowth_FullMethodName:    checker.RequireLookup("crop.read", farm),
+	}
+}
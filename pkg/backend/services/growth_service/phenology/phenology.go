@@ -0,0 +1,201 @@
+// Package phenology estimates the growth stage of a planting and predicts
+// its harvest from thermal time: a crop reaches maturity once it has
+// accumulated its growing degree days to maturity, and passes through its
+// stages at fixed shares of them. Without a thermal time requirement the
+// stages are estimated from the days to maturity.
+package phenology
+
+import (
+	"math"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+)
+
+// Growth stages. The stages of annual crops are lumped into the few most
+// agronomists plan around.
+const (
+	StageNotSown     = "not_sown"
+	StageGermination = "germination"
+	StageVegetative  = "vegetative"
+	StageFlowering   = "flowering"
+	StageRipening    = "ripening"
+	StageMature      = "mature"
+)
+
+// stages start at these shares of the thermal time to maturity.
+var stages = []struct {
+	name  string
+	start float64
+}{
+	{StageGermination, 0},
+	{StageVegetative, 0.08},
+	{StageFlowering, 0.45},
+	{StageRipening, 0.60},
+	{StageMature, 1},
+}
+
+// Prediction methods.
+const (
+	MethodDegreeDays = "degree_days"
+	MethodCalendar   = "calendar"
+)
+
+const (
+	// z is the standard normal quantile of the 90% harvest window.
+	z = 1.645
+	// modelError is the relative error of a crop's thermal time to
+	// maturity, which varies with variety and management.
+	modelError = 0.05
+	// calendarError is the relative error of the days to maturity.
+	calendarError = 0.10
+	// rateDays is the number of the latest observed days whose degree days
+	// are projected forward.
+	rateDays = 21
+	// minRateDays is the number of observed days needed for a projection.
+	minRateDays = 3
+	// minRate in °C·d per day below which no harvest is predicted, the crop
+	// is dormant.
+	minRate = 0.5
+	// horizon in days bounds predictions from thermal time, later
+	// harvests are not predicted.
+	horizon = 365
+	// slack in days absorbs the rounding error of window bounds that
+	// fall on a whole day, 110.00000000000001 days is 110 days.
+	slack = 1e-9
+)
+
+// StageAt returns the stage of a crop at the given share of its development.
+func StageAt(progress float64) string {
+	stage := StageGermination
+	for _, s := range stages {
+		if progress >= s.start {
+			stage = s.name
+		}
+	}
+	return stage
+}
+
+// Prediction is the estimated development of a planting on a day.
+type Prediction struct {
+	Method string
+	Stage  string
+	// Progress is the share of the development to maturity, 1 at maturity.
+	Progress float64
+	// DegreeDays accumulated since sowing, including estimates for days
+	// without temperatures, and RequiredDegreeDays to maturity.
+	DegreeDays         float64
+	RequiredDegreeDays float64
+	// EstimatedDays is the number of days whose degree days were estimated.
+	EstimatedDays int
+	// DailyRate is the mean of the degree days of the latest observed days,
+	// projected forward, and RateStdDev their standard deviation.
+	DailyRate  float64
+	RateStdDev float64
+	// Harvest is the expected day of maturity within the 90% window from
+	// HarvestEarliest to HarvestLatest. All three are nil when the crop is
+	// dormant or would mature beyond a year.
+	Harvest         *time.Time
+	HarvestEarliest *time.Time
+	HarvestLatest   *time.Time
+}
+
+// Calendar estimates the development of a crop sown on sowing from the
+// share of its days to maturity that have passed by today. Crops sown after
+// today are not sown yet.
+func Calendar(sowing time.Time, daysToMaturity int, today time.Time) *Prediction {
+	p := &Prediction{Method: MethodCalendar, Stage: StageNotSown}
+	days := float64(daysToMaturity)
+	if !today.Before(sowing) {
+		p.Progress = math.Min(today.Sub(sowing).Hours()/24/days, 1)
+		p.Stage = StageAt(p.Progress)
+	}
+	p.window(sowing, days, days*(1-calendarError), days*(1+calendarError))
+	return p
+}
+
+// DegreeDays estimates the development of a crop needing required degree
+// days from the days since sowing, whose degree days come from the weather
+// indices of the field. Days without temperatures are assumed to have the
+// mean degree days of the latest observed days, which are also projected
+// forward from the day after the last one to predict the harvest. It
+// returns nil when too few days were observed.
+func DegreeDays(days []weather.IndexDay, required float64) *Prediction {
+	var observed []float64
+	for _, d := range days {
+		if !d.Missing {
+			observed = append(observed, d.DegreeDays)
+		}
+	}
+	if len(observed) < minRateDays {
+		return nil
+	}
+	recent := observed[max(0, len(observed)-rateDays):]
+	p := &Prediction{Method: MethodDegreeDays, RequiredDegreeDays: required}
+	p.DailyRate, p.RateStdDev = meanStdDev(recent)
+
+	var matured *time.Time
+	for _, d := range days {
+		if d.Missing {
+			p.DegreeDays += p.DailyRate
+			p.EstimatedDays++
+		} else {
+			p.DegreeDays += d.DegreeDays
+		}
+		if matured == nil && p.DegreeDays >= required {
+			day := d.Day
+			matured = &day
+		}
+	}
+	p.Progress = math.Min(p.DegreeDays/required, 1)
+	p.Stage = StageAt(p.Progress)
+	if matured != nil {
+		p.Harvest, p.HarvestEarliest, p.HarvestLatest = matured, matured, matured
+		return p
+	}
+	if p.DailyRate < minRate {
+		return p
+	}
+
+	// the sum of n days' degree days is taken as normal with mean n·rate
+	// and standard deviation √n·sd; the early and late bounds solve
+	// n·rate ± z·sd·√n = remaining for n
+	remaining := required - p.DegreeDays
+	daysFor := func(remaining, sign float64) float64 {
+		zs := z * p.RateStdDev
+		x := (sign*zs + math.Sqrt(zs*zs+4*p.DailyRate*remaining)) / (2 * p.DailyRate)
+		return x * x
+	}
+	latest := daysFor(remaining*(1+modelError), 1)
+	if latest > horizon {
+		return p
+	}
+	// the remaining days follow the last day of the indices
+	p.window(days[len(days)-1].Day, remaining/p.DailyRate, daysFor(remaining*(1-modelError), -1), latest)
+	return p
+}
+
+// window sets the harvest dates the given numbers of days after start.
+func (p *Prediction) window(start time.Time, expected, earliest, latest float64) {
+	at := func(days float64) *time.Time {
+		t := start.AddDate(0, 0, int(days))
+		return &t
+	}
+	p.Harvest, p.HarvestEarliest, p.HarvestLatest = at(math.Round(expected)), at(math.Floor(earliest+slack)), at(math.Ceil(latest-slack))
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	if len(values) > 1 {
+		variance /= float64(len(values) - 1)
+	}
+	return mean, math.Sqrt(variance)
+}
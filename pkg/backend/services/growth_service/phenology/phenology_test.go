@@ -0,0 +1,214 @@
+package phenology
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+)
+
+var sowing = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func after(days int) time.Time {
+	return sowing.AddDate(0, 0, days)
+}
+
+func TestStageAt(t *testing.T) {
+	tests := []struct {
+		progress float64
+		want     string
+	}{
+		{-0.1, StageGermination},
+		{0, StageGermination},
+		{0.079, StageGermination},
+		{0.08, StageVegetative},
+		{0.449, StageVegetative},
+		{0.45, StageFlowering},
+		{0.60, StageRipening},
+		{0.999, StageRipening},
+		{1, StageMature},
+		{1.5, StageMature},
+	}
+	for _, tt := range tests {
+		if got := StageAt(tt.progress); got != tt.want {
+			t.Errorf("StageAt(%v) = %q, want %q", tt.progress, got, tt.want)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	tests := []struct {
+		name         string
+		today        time.Time
+		wantStage    string
+		wantProgress float64
+	}{
+		{"before sowing", after(-1), StageNotSown, 0},
+		{"sowing day", sowing, StageGermination, 0},
+		{"vegetative", after(8), StageVegetative, 0.08},
+		{"flowering", after(50), StageFlowering, 0.5},
+		{"ripening", after(75), StageRipening, 0.75},
+		{"mature", after(100), StageMature, 1},
+		{"long after maturity", after(300), StageMature, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Calendar(sowing, 100, tt.today)
+			if p.Method != MethodCalendar || p.Stage != tt.wantStage || math.Abs(p.Progress-tt.wantProgress) > 1e-9 {
+				t.Errorf("Calendar() = %s %s at %v, want %s at %v", p.Method, p.Stage, p.Progress, tt.wantStage, tt.wantProgress)
+			}
+			// the window is ±10% of the days to maturity whatever the day
+			if !p.Harvest.Equal(after(100)) || !p.HarvestEarliest.Equal(after(90)) || !p.HarvestLatest.Equal(after(110)) {
+				t.Errorf("Calendar() harvest = %v from %v to %v", p.Harvest, p.HarvestEarliest, p.HarvestLatest)
+			}
+		})
+	}
+}
+
+// indexDays returns the days from sowing with the given degree days, NaN
+// for days without temperatures.
+func indexDays(degreeDays ...float64) []weather.IndexDay {
+	days := make([]weather.IndexDay, len(degreeDays))
+	for i, dd := range degreeDays {
+		days[i] = weather.IndexDay{Day: after(i), DegreeDays: dd}
+		if math.IsNaN(dd) {
+			days[i] = weather.IndexDay{Day: after(i), Missing: true}
+		}
+	}
+	return days
+}
+
+func repeat(dd float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = dd
+	}
+	return out
+}
+
+func TestDegreeDays(t *testing.T) {
+	missing := math.NaN()
+
+	tests := []struct {
+		name          string
+		days          []weather.IndexDay
+		required      float64
+		wantNil       bool
+		wantStage     string
+		wantDD        float64
+		wantEstimated int
+		// days after sowing, -1 for no harvest
+		wantHarvest, wantEarliest, wantLatest int
+	}{
+		{
+			name:     "too few observed days",
+			days:     indexDays(10, missing, 10, missing),
+			required: 1000,
+			wantNil:  true,
+		},
+		{
+			// 700 °C·d remain at 10 a day after day 29: 70 days, 66.5 to 73.5
+			// for a 5% model error
+			name:         "projected at a steady rate",
+			days:         indexDays(repeat(10, 30)...),
+			required:     1000,
+			wantStage:    StageVegetative,
+			wantDD:       300,
+			wantHarvest:  29 + 70,
+			wantEarliest: 29 + 66,
+			wantLatest:   29 + 74,
+		},
+		{
+			name:          "missing days take the mean rate",
+			days:          indexDays(10, missing, 10, 10, missing, 10),
+			required:      150,
+			wantStage:     StageVegetative,
+			wantDD:        60,
+			wantEstimated: 2,
+			wantHarvest:   5 + 9,
+			wantEarliest:  5 + 8,
+			wantLatest:    5 + 10,
+		},
+		{
+			name:         "matured on the day the requirement is met",
+			days:         indexDays(repeat(10, 8)...),
+			required:     50,
+			wantStage:    StageMature,
+			wantDD:       80,
+			wantHarvest:  4,
+			wantEarliest: 4,
+			wantLatest:   4,
+		},
+		{
+			name:         "dormant crop",
+			days:         indexDays(repeat(0.2, 10)...),
+			required:     1000,
+			wantStage:    StageGermination,
+			wantDD:       2,
+			wantHarvest:  -1,
+			wantEarliest: -1,
+			wantLatest:   -1,
+		},
+		{
+			name:         "harvest beyond a year",
+			days:         indexDays(repeat(1, 10)...),
+			required:     1000,
+			wantStage:    StageGermination,
+			wantDD:       10,
+			wantHarvest:  -1,
+			wantEarliest: -1,
+			wantLatest:   -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := DegreeDays(tt.days, tt.required)
+			if tt.wantNil {
+				if p != nil {
+					t.Errorf("DegreeDays() = %+v, want nil", p)
+				}
+				return
+			}
+			if p == nil {
+				t.Fatal("DegreeDays() = nil")
+			}
+			if p.Method != MethodDegreeDays || p.Stage != tt.wantStage || math.Abs(p.DegreeDays-tt.wantDD) > 1e-9 ||
+				p.EstimatedDays != tt.wantEstimated {
+				t.Errorf("DegreeDays() = %s %s at %v °C·d with %d estimated days, want %s at %v with %d",
+					p.Method, p.Stage, p.DegreeDays, p.EstimatedDays, tt.wantStage, tt.wantDD, tt.wantEstimated)
+			}
+			for _, h := range []struct {
+				name string
+				got  *time.Time
+				want int
+			}{
+				{"harvest", p.Harvest, tt.wantHarvest},
+				{"earliest", p.HarvestEarliest, tt.wantEarliest},
+				{"latest", p.HarvestLatest, tt.wantLatest},
+			} {
+				switch {
+				case h.want < 0 && h.got != nil:
+					t.Errorf("%s = %v, want none", h.name, h.got)
+				case h.want >= 0 && (h.got == nil || !h.got.Equal(after(h.want))):
+					t.Errorf("%s = %v, want %v", h.name, h.got, after(h.want))
+				}
+			}
+		})
+	}
+}
+
+func TestDegreeDaysWindowWidensWithVariability(t *testing.T) {
+	steady := DegreeDays(indexDays(repeat(10, 21)...), 1000)
+	variable := DegreeDays(indexDays(5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 5, 15, 10), 1000)
+	if steady.DailyRate != variable.DailyRate || variable.RateStdDev == 0 {
+		t.Fatalf("rates = %v±%v and %v±%v", steady.DailyRate, steady.RateStdDev, variable.DailyRate, variable.RateStdDev)
+	}
+	if !variable.Harvest.Equal(*steady.Harvest) {
+		t.Errorf("harvest = %v, want %v", variable.Harvest, steady.Harvest)
+	}
+	if !variable.HarvestEarliest.Before(*steady.HarvestEarliest) || !variable.HarvestLatest.After(*steady.HarvestLatest) {
+		t.Errorf("window %v to %v is not wider than %v to %v", variable.HarvestEarliest, variable.HarvestLatest,
+			steady.HarvestEarliest, steady.HarvestLatest)
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.19.6
+// source: growth.proto
+
+package proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/aburifat/go-agro/pkg/backend/common/validate/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GrowthStage int32
+
+const (
+	GrowthStage_GROWTH_STAGE_UNSPECIFIED GrowthStage = 0
+	GrowthStage_GROWTH_STAGE_NOT_SOWN    GrowthStage = 1
+	GrowthStage_GROWTH_STAGE_GERMINATION GrowthStage = 2
+	GrowthStage_GROWTH_STAGE_VEGETATIVE  GrowthStage = 3
+	GrowthStage_GROWTH_STAGE_FLOWERING   GrowthStage = 4
+	GrowthStage_GROWTH_STAGE_RIPENING    GrowthStage = 5
+	GrowthStage_GROWTH_STAGE_MATURE      GrowthStage = 6
+)
+
+// Enum value maps for GrowthStage.
+var (
+	GrowthStage_name = map[int32]string{
+		0: "GROWTH_STAGE_UNSPECIFIED",
+		1: "GROWTH_STAGE_NOT_SOWN",
+		2: "GROWTH_STAGE_GERMINATION",
+		3: "GROWTH_STAGE_VEGETATIVE",
+		4: "GROWTH_STAGE_FLOWERING",
+		5: "GROWTH_STAGE_RIPENING",
+		6: "GROWTH_STAGE_MATURE",
+	}
+	GrowthStage_value = map[string]int32{
+		"GROWTH_STAGE_UNSPECIFIED": 0,
+		"GROWTH_STAGE_NOT_SOWN":    1,
+		"GROWTH_STAGE_GERMINATION": 2,
+		"GROWTH_STAGE_VEGETATIVE":  3,
+		"GROWTH_STAGE_FLOWERING":   4,
+		"GROWTH_STAGE_RIPENING":    5,
+		"GROWTH_STAGE_MATURE":      6,
+	}
+)
+
+func (x GrowthStage) Enum() *GrowthStage {
+	p := new(GrowthStage)
+	*p = x
+	return p
+}
+
+func (x GrowthStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GrowthStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_growth_proto_enumTypes[0].Descriptor()
+}
+
+func (GrowthStage) Type() protoreflect.EnumType {
+	return &file_growth_proto_enumTypes[0]
+}
+
+func (x GrowthStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GrowthStage.Descriptor instead.
+func (GrowthStage) EnumDescriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{0}
+}
+
+type PredictionMethod int32
+
+const (
+	PredictionMethod_PREDICTION_METHOD_UNSPECIFIED PredictionMethod = 0
+	// from the degree days at the closest weather station
+	PredictionMethod_PREDICTION_METHOD_DEGREE_DAYS PredictionMethod = 1
+	// from the crop's days to maturity, used without a station, without
+	// enough weather or when the crop's degree days to maturity are unknown
+	PredictionMethod_PREDICTION_METHOD_CALENDAR PredictionMethod = 2
+)
+
+// Enum value maps for PredictionMethod.
+var (
+	PredictionMethod_name = map[int32]string{
+		0: "PREDICTION_METHOD_UNSPECIFIED",
+		1: "PREDICTION_METHOD_DEGREE_DAYS",
+		2: "PREDICTION_METHOD_CALENDAR",
+	}
+	PredictionMethod_value = map[string]int32{
+		"PREDICTION_METHOD_UNSPECIFIED": 0,
+		"PREDICTION_METHOD_DEGREE_DAYS": 1,
+		"PREDICTION_METHOD_CALENDAR":    2,
+	}
+)
+
+func (x PredictionMethod) Enum() *PredictionMethod {
+	p := new(PredictionMethod)
+	*p = x
+	return p
+}
+
+func (x PredictionMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PredictionMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_growth_proto_enumTypes[1].Descriptor()
+}
+
+func (PredictionMethod) Type() protoreflect.EnumType {
+	return &file_growth_proto_enumTypes[1]
+}
+
+func (x PredictionMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PredictionMethod.Descriptor instead.
+func (PredictionMethod) EnumDescriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{1}
+}
+
+// Growth is the predicted development of a planting. Degree days are in
+// °C·d above baseTemperature.
+type Growth struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlantingPlanId string                 `protobuf:"bytes,1,opt,name=plantingPlanId,proto3" json:"plantingPlanId,omitempty"`
+	FieldId        string                 `protobuf:"bytes,2,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	CropId         string                 `protobuf:"bytes,3,opt,name=cropId,proto3" json:"cropId,omitempty"`
+	// YYYY-MM-DD
+	SowingDate string `protobuf:"bytes,4,opt,name=sowingDate,proto3" json:"sowingDate,omitempty"`
+	// empty when no station is linked to the farm
+	StationId string           `protobuf:"bytes,5,opt,name=stationId,proto3" json:"stationId,omitempty"`
+	Method    PredictionMethod `protobuf:"varint,6,opt,name=method,proto3,enum=growth.PredictionMethod" json:"method,omitempty"`
+	Stage     GrowthStage      `protobuf:"varint,7,opt,name=stage,proto3,enum=growth.GrowthStage" json:"stage,omitempty"`
+	// share of the development to maturity, 1 at maturity
+	Progress           float64 `protobuf:"fixed64,8,opt,name=progress,proto3" json:"progress,omitempty"`
+	DegreeDays         float64 `protobuf:"fixed64,9,opt,name=degreeDays,proto3" json:"degreeDays,omitempty"`
+	RequiredDegreeDays float64 `protobuf:"fixed64,10,opt,name=requiredDegreeDays,proto3" json:"requiredDegreeDays,omitempty"`
+	BaseTemperature    float64 `protobuf:"fixed64,11,opt,name=baseTemperature,proto3" json:"baseTemperature,omitempty"`
+	// days since sowing without temperatures, whose degree days are estimated
+	EstimatedDays int32 `protobuf:"varint,12,opt,name=estimatedDays,proto3" json:"estimatedDays,omitempty"`
+	// mean degree days of the last three weeks, projected to harvest
+	DailyRate float64 `protobuf:"fixed64,13,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`
+	// YYYY-MM-DD, the expected harvest within the 90% window from
+	// harvestEarliest to harvestLatest; empty while the crop is dormant or
+	// when it would mature beyond a year
+	HarvestDate     string `protobuf:"bytes,14,opt,name=harvestDate,proto3" json:"harvestDate,omitempty"`
+	HarvestEarliest string `protobuf:"bytes,15,opt,name=harvestEarliest,proto3" json:"harvestEarliest,omitempty"`
+	HarvestLatest   string `protobuf:"bytes,16,opt,name=harvestLatest,proto3" json:"harvestLatest,omitempty"`
+	// RFC 3339
+	ComputedAt    string `protobuf:"bytes,17,opt,name=computedAt,proto3" json:"computedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Growth) Reset() {
+	*x = Growth{}
+	mi := &file_growth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Growth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Growth) ProtoMessage() {}
+
+func (x *Growth) ProtoReflect() protoreflect.Message {
+	mi := &file_growth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Growth.ProtoReflect.Descriptor instead.
+func (*Growth) Descriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Growth) GetPlantingPlanId() string {
+	if x != nil {
+		return x.PlantingPlanId
+	}
+	return ""
+}
+
+func (x *Growth) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *Growth) GetCropId() string {
+	if x != nil {
+		return x.CropId
+	}
+	return ""
+}
+
+func (x *Growth) GetSowingDate() string {
+	if x != nil {
+		return x.SowingDate
+	}
+	return ""
+}
+
+func (x *Growth) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+func (x *Growth) GetMethod() PredictionMethod {
+	if x != nil {
+		return x.Method
+	}
+	return PredictionMethod_PREDICTION_METHOD_UNSPECIFIED
+}
+
+func (x *Growth) GetStage() GrowthStage {
+	if x != nil {
+		return x.Stage
+	}
+	return GrowthStage_GROWTH_STAGE_UNSPECIFIED
+}
+
+func (x *Growth) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Growth) GetDegreeDays() float64 {
+	if x != nil {
+		return x.DegreeDays
+	}
+	return 0
+}
+
+func (x *Growth) GetRequiredDegreeDays() float64 {
+	if x != nil {
+		return x.RequiredDegreeDays
+	}
+	return 0
+}
+
+func (x *Growth) GetBaseTemperature() float64 {
+	if x != nil {
+		return x.BaseTemperature
+	}
+	return 0
+}
+
+func (x *Growth) GetEstimatedDays() int32 {
+	if x != nil {
+		return x.EstimatedDays
+	}
+	return 0
+}
+
+func (x *Growth) GetDailyRate() float64 {
+	if x != nil {
+		return x.DailyRate
+	}
+	return 0
+}
+
+func (x *Growth) GetHarvestDate() string {
+	if x != nil {
+		return x.HarvestDate
+	}
+	return ""
+}
+
+func (x *Growth) GetHarvestEarliest() string {
+	if x != nil {
+		return x.HarvestEarliest
+	}
+	return ""
+}
+
+func (x *Growth) GetHarvestLatest() string {
+	if x != nil {
+		return x.HarvestLatest
+	}
+	return ""
+}
+
+func (x *Growth) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+type GetPlantingGrowthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlantingGrowthRequest) Reset() {
+	*x = GetPlantingGrowthRequest{}
+	mi := &file_growth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlantingGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlantingGrowthRequest) ProtoMessage() {}
+
+func (x *GetPlantingGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_growth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlantingGrowthRequest.ProtoReflect.Descriptor instead.
+func (*GetPlantingGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPlantingGrowthRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPlantingGrowthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Growth        *Growth                `protobuf:"bytes,1,opt,name=growth,proto3" json:"growth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlantingGrowthResponse) Reset() {
+	*x = GetPlantingGrowthResponse{}
+	mi := &file_growth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlantingGrowthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlantingGrowthResponse) ProtoMessage() {}
+
+func (x *GetPlantingGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_growth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlantingGrowthResponse.ProtoReflect.Descriptor instead.
+func (*GetPlantingGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPlantingGrowthResponse) GetGrowth() *Growth {
+	if x != nil {
+		return x.Growth
+	}
+	return nil
+}
+
+// ListFarmGrowthRequest lists the plantings planned or sown on the fields of
+// a farm today.
+type ListFarmGrowthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FarmId        string                 `protobuf:"bytes,1,opt,name=farmId,proto3" json:"farmId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFarmGrowthRequest) Reset() {
+	*x = ListFarmGrowthRequest{}
+	mi := &file_growth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFarmGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFarmGrowthRequest) ProtoMessage() {}
+
+func (x *ListFarmGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_growth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFarmGrowthRequest.ProtoReflect.Descriptor instead.
+func (*ListFarmGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFarmGrowthRequest) GetFarmId() string {
+	if x != nil {
+		return x.FarmId
+	}
+	return ""
+}
+
+type ListFarmGrowthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Growths       []*Growth              `protobuf:"bytes,1,rep,name=growths,proto3" json:"growths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFarmGrowthResponse) Reset() {
+	*x = ListFarmGrowthResponse{}
+	mi := &file_growth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFarmGrowthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFarmGrowthResponse) ProtoMessage() {}
+
+func (x *ListFarmGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_growth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFarmGrowthResponse.ProtoReflect.Descriptor instead.
+func (*ListFarmGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_growth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFarmGrowthResponse) GetGrowths() []*Growth {
+	if x != nil {
+		return x.Growths
+	}
+	return nil
+}
+
+var File_growth_proto protoreflect.FileDescriptor
+
+var file_growth_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x04,
+	0x0a, 0x06, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72,
+	0x6f, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x6f, 0x70,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x77, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x61, 0x73,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x76, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61,
+	0x72, 0x76, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61, 0x72,
+	0x76, 0x65, 0x73, 0x74, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x45, 0x61, 0x72, 0x6c, 0x69,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x72, 0x76,
+	0x65, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x6f,
+	0x77, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x22, 0x37, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x66,
+	0x61, 0x72, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18,
+	0x02, 0x38, 0x01, 0x52, 0x06, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e,
+	0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x73, 0x2a,
+	0xd1, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52, 0x4f, 0x57,
+	0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x47, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x56, 0x45, 0x47, 0x45, 0x54, 0x41, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x19, 0x0a, 0x15, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x52, 0x49, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x52,
+	0x4f, 0x57, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x55, 0x52,
+	0x45, 0x10, 0x06, 0x2a, 0x78, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x45, 0x44, 0x49,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52,
+	0x45, 0x44, 0x49, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
+	0x44, 0x45, 0x47, 0x52, 0x45, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x1e, 0x0a,
+	0x1a, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x10, 0x02, 0x32, 0x84, 0x02,
+	0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x7f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x77, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68,
+	0x12, 0x72, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x77,
+	0x74, 0x68, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x61, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x61, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x61, 0x72, 0x6d, 0x73, 0x2f, 0x7b, 0x66, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x7d, 0x2f, 0x67, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x75, 0x72, 0x69, 0x66, 0x61, 0x74, 0x2f, 0x67, 0x6f, 0x2d, 0x61,
+	0x67, 0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_growth_proto_rawDescOnce sync.Once
+	file_growth_proto_rawDescData = file_growth_proto_rawDesc
+)
+
+func file_growth_proto_rawDescGZIP() []byte {
+	file_growth_proto_rawDescOnce.Do(func() {
+		file_growth_proto_rawDescData = protoimpl.X.CompressGZIP(file_growth_proto_rawDescData)
+	})
+	return file_growth_proto_rawDescData
+}
+
+var file_growth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_growth_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_growth_proto_goTypes = []any{
+	(GrowthStage)(0),                  // 0: growth.GrowthStage
+	(PredictionMethod)(0),             // 1: growth.PredictionMethod
+	(*Growth)(nil),                    // 2: growth.Growth
+	(*GetPlantingGrowthRequest)(nil),  // 3: growth.GetPlantingGrowthRequest
+	(*GetPlantingGrowthResponse)(nil), // 4: growth.GetPlantingGrowthResponse
+	(*ListFarmGrowthRequest)(nil),     // 5: growth.ListFarmGrowthRequest
+	(*ListFarmGrowthResponse)(nil),    // 6: growth.ListFarmGrowthResponse
+}
+var file_growth_proto_depIdxs = []int32{
+	1, // 0: growth.Growth.method:type_name -> growth.PredictionMethod
+	0, // 1: growth.Growth.stage:type_name -> growth.GrowthStage
+	2, // 2: growth.GetPlantingGrowthResponse.growth:type_name -> growth.Growth
+	2, // 3: growth.ListFarmGrowthResponse.growths:type_name -> growth.Growth
+	3, // 4: growth.GrowthService.GetPlantingGrowth:input_type -> growth.GetPlantingGrowthRequest
+	5, // 5: growth.GrowthService.ListFarmGrowth:input_type -> growth.ListFarmGrowthRequest
+	4, // 6: growth.GrowthService.GetPlantingGrowth:output_type -> growth.GetPlantingGrowthResponse
+	6, // 7: growth.GrowthService.ListFarmGrowth:output_type -> growth.ListFarmGrowthResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_growth_proto_init() }
+func file_growth_proto_init() {
+	if File_growth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_growth_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_growth_proto_goTypes,
+		DependencyIndexes: file_growth_proto_depIdxs,
+		EnumInfos:         file_growth_proto_enumTypes,
+		MessageInfos:      file_growth_proto_msgTypes,
+	}.Build()
+	File_growth_proto = out.File
+	file_growth_proto_rawDesc = nil
+	file_growth_proto_goTypes = nil
+	file_growth_proto_depIdxs = nil
+}
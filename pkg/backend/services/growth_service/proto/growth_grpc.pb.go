@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: growth.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GrowthService_GetPlantingGrowth_FullMethodName = "/growth.GrowthService/GetPlantingGrowth"
+	GrowthService_ListFarmGrowth_FullMethodName    = "/growth.GrowthService/ListFarmGrowth"
+)
+
+// GrowthServiceClient is the client API for GrowthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GrowthService estimates the growth stage of plantings and predicts their
+// harvest from the growing degree days accumulated since sowing. Predictions
+// are recomputed when the planting, its crop or the weather changes.
+type GrowthServiceClient interface {
+	GetPlantingGrowth(ctx context.Context, in *GetPlantingGrowthRequest, opts ...grpc.CallOption) (*GetPlantingGrowthResponse, error)
+	ListFarmGrowth(ctx context.Context, in *ListFarmGrowthRequest, opts ...grpc.CallOption) (*ListFarmGrowthResponse, error)
+}
+
+type growthServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGrowthServiceClient(cc grpc.ClientConnInterface) GrowthServiceClient {
+	return &growthServiceClient{cc}
+}
+
+func (c *growthServiceClient) GetPlantingGrowth(ctx context.Context, in *GetPlantingGrowthRequest, opts ...grpc.CallOption) (*GetPlantingGrowthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlantingGrowthResponse)
+	err := c.cc.Invoke(ctx, GrowthService_GetPlantingGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *growthServiceClient) ListFarmGrowth(ctx context.Context, in *ListFarmGrowthRequest, opts ...grpc.CallOption) (*ListFarmGrowthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFarmGrowthResponse)
+	err := c.cc.Invoke(ctx, GrowthService_ListFarmGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GrowthServiceServer is the server API for GrowthService service.
+// All implementations must embed UnimplementedGrowthServiceServer
+// for forward compatibility.
+//
+// GrowthService estimates the growth stage of plantings and predicts their
+// harvest from the growing degree days accumulated since sowing. Predictions
+// are recomputed when the planting, its crop or the weather changes.
+type GrowthServiceServer interface {
+	GetPlantingGrowth(context.Context, *GetPlantingGrowthRequest) (*GetPlantingGrowthResponse, error)
+	ListFarmGrowth(context.Context, *ListFarmGrowthRequest) (*ListFarmGrowthResponse, error)
+	mustEmbedUnimplementedGrowthServiceServer()
+}
+
+// UnimplementedGrowthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGrowthServiceServer struct{}
+
+func (UnimplementedGrowthServiceServer) GetPlantingGrowth(context.Context, *GetPlantingGrowthRequest) (*GetPlantingGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlantingGrowth not implemented")
+}
+func (UnimplementedGrowthServiceServer) ListFarmGrowth(context.Context, *ListFarmGrowthRequest) (*ListFarmGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFarmGrowth not implemented")
+}
+func (UnimplementedGrowthServiceServer) mustEmbedUnimplementedGrowthServiceServer() {}
+func (UnimplementedGrowthServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGrowthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GrowthServiceServer will
+// result in compilation errors.
+type UnsafeGrowthServiceServer interface {
+	mustEmbedUnimplementedGrowthServiceServer()
+}
+
+func RegisterGrowthServiceServer(s grpc.ServiceRegistrar, srv GrowthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGrowthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GrowthService_ServiceDesc, srv)
+}
+
+func _GrowthService_GetPlantingGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlantingGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrowthServiceServer).GetPlantingGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrowthService_GetPlantingGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrowthServiceServer).GetPlantingGrowth(ctx, req.(*GetPlantingGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrowthService_ListFarmGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFarmGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrowthServiceServer).ListFarmGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrowthService_ListFarmGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrowthServiceServer).ListFarmGrowth(ctx, req.(*ListFarmGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GrowthService_ServiceDesc is the grpc.ServiceDesc for GrowthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GrowthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "growth.GrowthService",
+	HandlerType: (*GrowthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlantingGrowth",
+			Handler:    _GrowthService_GetPlantingGrowth_Handler,
+		},
+		{
+			MethodName: "ListFarmGrowth",
+			Handler:    _GrowthService_ListFarmGrowth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "growth.proto",
+}
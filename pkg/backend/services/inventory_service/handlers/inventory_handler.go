@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	"github.com/aburifat/go-agro/pkg/backend/services/inventory_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/inventory_service/repository"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var errNoJournal = status.Error(codes.FailedPrecondition, "issuing stock to activities requires MongoDB to be configured")
+
+type InventoryHandler struct {
+	proto.UnimplementedInventoryServiceServer
+	db      *gorm.DB
+	journal *journal.Journal
+	logger  *zap.Logger
+}
+
+// NewInventoryHandler returns the InventoryService handler. j is nil when
+// MongoDB is not configured, stock then cannot be issued to activities.
+func NewInventoryHandler(db *gorm.DB, j *journal.Journal, logger *zap.Logger) *InventoryHandler {
+	inventoryHandler := InventoryHandler{
+		db:      db,
+		journal: j,
+		logger:  logger,
+	}
+	return &inventoryHandler
+}
+
+func (h *InventoryHandler) CreateWarehouse(ctx context.Context, req *proto.CreateWarehouseRequest) (*proto.CreateWarehouseResponse, error) {
+	warehouse := &api.Warehouse{
+		FarmID:   req.GetFarmId(),
+		Name:     req.GetName(),
+		Location: req.GetLocation(),
+	}
+	id, err := repository.CreateWarehouse(h.db.WithContext(ctx), warehouse)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create warehouse")
+	}
+	logging.WithContext(ctx, h.logger).Info("Warehouse created", zap.String("warehouse_id", id), zap.String("farm_id", warehouse.FarmID))
+
+	return &proto.CreateWarehouseResponse{
+		Id:      id,
+		Message: "Warehouse created successfully",
+	}, nil
+}
+
+func (h *InventoryHandler) ListWarehouses(ctx context.Context, req *proto.ListWarehousesRequest) (*proto.ListWarehousesResponse, error) {
+	page, err := repository.ListWarehouses(h.db.WithContext(ctx), req.GetFarmId(), pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list warehouses")
+	}
+
+	var warehouseList []*proto.Warehouse
+	for _, w := range page.Items {
+		warehouseList = append(warehouseList, &proto.Warehouse{
+			Id:       w.ID,
+			FarmId:   w.FarmID,
+			Name:     w.Name,
+			Location: w.Location,
+		})
+	}
+	return &proto.ListWarehousesResponse{
+		Warehouses:    warehouseList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *InventoryHandler) CreateSku(ctx context.Context, req *proto.CreateSkuRequest) (*proto.CreateSkuResponse, error) {
+	sku := &api.SKU{
+		FarmID:   req.GetFarmId(),
+		Code:     req.GetCode(),
+		Name:     req.GetName(),
+		Category: categoryName(req.GetCategory()),
+		Unit:     req.GetUnit(),
+	}
+	id, err := repository.CreateSKU(h.db.WithContext(ctx), sku)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create SKU")
+	}
+	logging.WithContext(ctx, h.logger).Info("SKU created", zap.String("sku_id", id), zap.String("farm_id", sku.FarmID))
+
+	return &proto.CreateSkuResponse{
+		Id:      id,
+		Message: "SKU created successfully",
+	}, nil
+}
+
+func (h *InventoryHandler) ListSkus(ctx context.Context, req *proto.ListSkusRequest) (*proto.ListSkusResponse, error) {
+	category := ""
+	if req.GetCategory() != proto.SkuCategory_SKU_CATEGORY_UNSPECIFIED {
+		category = categoryName(req.GetCategory())
+	}
+	page, err := repository.ListSKUs(h.db.WithContext(ctx), req.GetFarmId(), category, pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list SKUs")
+	}
+
+	var skuList []*proto.Sku
+	for _, s := range page.Items {
+		skuList = append(skuList, &proto.Sku{
+			Id:       s.ID,
+			FarmId:   s.FarmID,
+			Code:     s.Code,
+			Name:     s.Name,
+			Category: categoryFromName(s.Category),
+			Unit:     s.Unit,
+		})
+	}
+	return &proto.ListSkusResponse{
+		Skus:          skuList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (h *InventoryHandler) ReceiveStock(ctx context.Context, req *proto.ReceiveStockRequest) (*proto.ReceiveStockResponse, error) {
+	var expiry *time.Time
+	if req.GetExpiryDate() != "" {
+		date, err := time.Parse(repository.DateLayout, req.GetExpiryDate())
+		if err != nil {
+			return nil, grpcerr.InvalidArgument("expiryDate", "invalid date: "+req.GetExpiryDate())
+		}
+		expiry = &date
+	}
+	movement, err := h.newMovement(ctx, api.MovementReceipt, req.GetWarehouseId(), req.GetQuantity(), req.GetOccurredAt())
+	if err != nil {
+		return nil, err
+	}
+	movement.SKUID = req.GetSkuId()
+	movement.Reference = req.GetReference()
+
+	if err := repository.Receive(h.db.WithContext(ctx), movement, req.GetLotNumber(), expiry); err != nil {
+		return nil, grpcerr.FromError(err, "failed to receive stock")
+	}
+	h.logMovement(ctx, movement)
+	return &proto.ReceiveStockResponse{
+		Movement: movementToProto(movement),
+		Message:  "Stock received successfully",
+	}, nil
+}
+
+// IssueStock records stock used by a field activity. The activity must be
+// of a field of the warehouse's farm and neither corrected nor withdrawn.
+func (h *InventoryHandler) IssueStock(ctx context.Context, req *proto.IssueStockRequest) (*proto.IssueStockResponse, error) {
+	if h.journal == nil {
+		return nil, errNoJournal
+	}
+	activity, err := h.journal.Get(ctx, req.GetActivityId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get activity")
+	}
+	if activity.Void || activity.CorrectedBy != "" {
+		return nil, grpcerr.InvalidArgument("activityId", "activity "+activity.ID+" was corrected or withdraws another activity")
+	}
+	occurredAt := req.GetOccurredAt()
+	if occurredAt == "" {
+		occurredAt = activity.PerformedAt.Format(time.RFC3339)
+	}
+	movement, err := h.newMovement(ctx, api.MovementIssue, req.GetWarehouseId(), -req.GetQuantity(), occurredAt)
+	if err != nil {
+		return nil, err
+	}
+	if activity.FarmID != movement.FarmID {
+		return nil, grpcerr.InvalidArgument("activityId", "activity "+activity.ID+" is not of a field of the warehouse's farm")
+	}
+	movement.LotID = req.GetLotId()
+	movement.ActivityID = &activity.ID
+	movement.FieldID = &activity.FieldID
+	movement.Reference = req.GetReference()
+
+	if err := repository.Record(h.db.WithContext(ctx), movement); err != nil {
+		return nil, grpcerr.FromError(err, "failed to issue stock")
+	}
+	h.logMovement(ctx, movement)
+	return &proto.IssueStockResponse{
+		Movement: movementToProto(movement),
+		Message:  "Stock issued successfully",
+	}, nil
+}
+
+func (h *InventoryHandler) TransferStock(ctx context.Context, req *proto.TransferStockRequest) (*proto.TransferStockResponse, error) {
+	if req.GetToWarehouseId() == req.GetWarehouseId() {
+		return nil, grpcerr.InvalidArgument("toWarehouseId", "must differ from warehouseId")
+	}
+	movement, err := h.newMovement(ctx, api.MovementTransfer, req.GetWarehouseId(), -req.GetQuantity(), req.GetOccurredAt())
+	if err != nil {
+		return nil, err
+	}
+	to := req.GetToWarehouseId()
+	movement.ToWarehouseID = &to
+	movement.LotID = req.GetLotId()
+	movement.Reference = req.GetReference()
+
+	if err := repository.Record(h.db.WithContext(ctx), movement); err != nil {
+		return nil, grpcerr.FromError(err, "failed to transfer stock")
+	}
+	h.logMovement(ctx, movement)
+	return &proto.TransferStockResponse{
+		Movement: movementToProto(movement),
+		Message:  "Stock transferred successfully",
+	}, nil
+}
+
+func (h *InventoryHandler) AdjustStock(ctx context.Context, req *proto.AdjustStockRequest) (*proto.AdjustStockResponse, error) {
+	if req.GetQuantity() == 0 {
+		return nil, grpcerr.InvalidArgument("quantity", "must not be 0")
+	}
+	movement, err := h.newMovement(ctx, api.MovementAdjustment, req.GetWarehouseId(), req.GetQuantity(), req.GetOccurredAt())
+	if err != nil {
+		return nil, err
+	}
+	movement.LotID = req.GetLotId()
+	movement.Reason = req.GetReason()
+	movement.Reference = req.GetReference()
+
+	if err := repository.Record(h.db.WithContext(ctx), movement); err != nil {
+		return nil, grpcerr.FromError(err, "failed to adjust stock")
+	}
+	h.logMovement(ctx, movement)
+	return &proto.AdjustStockResponse{
+		Movement: movementToProto(movement),
+		Message:  "Stock adjusted successfully",
+	}, nil
+}
+
+func (h *InventoryHandler) ListStockBalances(ctx context.Context, req *proto.ListStockBalancesRequest) (*proto.ListStockBalancesResponse, error) {
+	balances, err := repository.ListBalances(h.db.WithContext(ctx), req.GetFarmId(), repository.BalanceFilter{
+		WarehouseID:  req.GetWarehouseId(),
+		SKUID:        req.GetSkuId(),
+		IncludeEmpty: req.GetIncludeEmpty(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list stock balances")
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	response := &proto.ListStockBalancesResponse{}
+	for _, b := range balances {
+		balance := &proto.StockBalance{
+			WarehouseId: b.WarehouseID,
+			SkuId:       b.SKUID,
+			LotId:       b.LotID,
+			LotNumber:   b.Lot.LotNumber,
+			Quantity:    b.Quantity,
+		}
+		if b.Lot.ExpiryDate != nil {
+			balance.ExpiryDate = b.Lot.ExpiryDate.Format(repository.DateLayout)
+			balance.Expired = b.Lot.ExpiryDate.Before(today)
+		}
+		response.Balances = append(response.Balances, balance)
+	}
+	return response, nil
+}
+
+func (h *InventoryHandler) ListStockMovements(ctx context.Context, req *proto.ListStockMovementsRequest) (*proto.ListStockMovementsResponse, error) {
+	filter := repository.MovementFilter{
+		WarehouseID: req.GetWarehouseId(),
+		SKUID:       req.GetSkuId(),
+		LotID:       req.GetLotId(),
+		ActivityID:  req.GetActivityId(),
+	}
+	if req.GetKind() != proto.MovementKind_MOVEMENT_KIND_UNSPECIFIED {
+		filter.Kind = kindName(req.GetKind())
+	}
+	page, err := repository.ListMovements(h.db.WithContext(ctx), req.GetFarmId(), filter, pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotal(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list stock movements")
+	}
+
+	var movementList []*proto.StockMovement
+	for _, m := range page.Items {
+		movementList = append(movementList, movementToProto(m))
+	}
+	return &proto.ListStockMovementsResponse{
+		Movements:     movementList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// newMovement returns a movement of the given kind changing the stock of
+// warehouseID by quantity, performed by the caller at occurredAt or now.
+func (h *InventoryHandler) newMovement(ctx context.Context, kind, warehouseID string, quantity float64, occurredAt string) (*api.StockMovement, error) {
+	when := time.Now()
+	if occurredAt != "" {
+		var err error
+		if when, err = time.Parse(time.RFC3339, occurredAt); err != nil {
+			return nil, grpcerr.InvalidArgument("occurredAt", "must be an RFC 3339 timestamp")
+		}
+	}
+	warehouse, err := repository.GetWarehouse(h.db.WithContext(ctx), warehouseID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get warehouse")
+	}
+	return &api.StockMovement{
+		FarmID:      warehouse.FarmID,
+		Kind:        kind,
+		WarehouseID: warehouse.ID,
+		Quantity:    quantity,
+		ActorID:     auth.FromContext(ctx).UserID,
+		OccurredAt:  when.UTC(),
+	}, nil
+}
+
+func (h *InventoryHandler) logMovement(ctx context.Context, m *api.StockMovement) {
+	logging.WithContext(ctx, h.logger).Info("Stock movement recorded", zap.String("movement_id", m.ID),
+		zap.String("kind", m.Kind), zap.String("warehouse_id", m.WarehouseID), zap.String("lot_id", m.LotID),
+		zap.Float64("quantity", m.Quantity))
+}
+
+func movementToProto(m *api.StockMovement) *proto.StockMovement {
+	movement := &proto.StockMovement{
+		Id:          m.ID,
+		Kind:        proto.MovementKind(proto.MovementKind_value["MOVEMENT_KIND_"+strings.ToUpper(m.Kind)]),
+		WarehouseId: m.WarehouseID,
+		SkuId:       m.SKUID,
+		LotId:       m.LotID,
+		LotNumber:   m.Lot.LotNumber,
+		Quantity:    m.Quantity,
+		Reference:   m.Reference,
+		Reason:      m.Reason,
+		ActorId:     m.ActorID,
+		OccurredAt:  m.OccurredAt.Format(time.RFC3339),
+		RecordedAt:  m.RecordedAt.Format(time.RFC3339),
+	}
+	if m.ToWarehouseID != nil {
+		movement.ToWarehouseId = *m.ToWarehouseID
+	}
+	if m.ActivityID != nil {
+		movement.ActivityId = *m.ActivityID
+	}
+	if m.FieldID != nil {
+		movement.FieldId = *m.FieldID
+	}
+	return movement
+}
+
+// categoryName returns the name stored for c, e.g. "fertiliser".
+func categoryName(c proto.SkuCategory) string {
+	return strings.ToLower(strings.TrimPrefix(c.String(), "SKU_CATEGORY_"))
+}
+
+func categoryFromName(name string) proto.SkuCategory {
+	return proto.SkuCategory(proto.SkuCategory_value["SKU_CATEGORY_"+strings.ToUpper(name)])
+}
+
+// kindName returns the name stored for k, e.g. "receipt".
+func kindName(k proto.MovementKind) string {
+	return strings.ToLower(strings.TrimPrefix(k.String(), "MOVEMENT_KIND_"))
+}
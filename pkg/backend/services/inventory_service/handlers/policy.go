@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/inventory_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/inventory_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of InventoryService. Warehouses, SKUs and
+// stock are checked against the scope of the farm owning them; recording
+// movements needs inventory.write.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	farm := farmhandlers.FarmResource(db, func(req any) string {
+		return req.(interface{ GetFarmId() string }).GetFarmId()
+	})
+	warehouse := warehouseResource(db)
+
+	return auth.Policy{
+		proto.InventoryService_CreateWarehouse_FullMethodName:    checker.RequireLookup("inventory.write", farm),
+		proto.InventoryService_ListWarehouses_FullMethodName:     checker.RequireLookup("inventory.read", farm),
+		proto.InventoryService_CreateSku_FullMethodName:          checker.RequireLookup("inventory.write", farm),
+		proto.InventoryService_ListSkus_FullMethodName:           checker.RequireLookup("inventory.read", farm),
+		proto.InventoryService_ReceiveStock_FullMethodName:       checker.RequireLookup("inventory.write", warehouse),
+		proto.InventoryService_IssueStock_FullMethodName:         checker.RequireLookup("inventory.write", warehouse),
+		proto.InventoryService_TransferStock_FullMethodName:      checker.RequireLookup("inventory.write", warehouse),
+		proto.InventoryService_AdjustStock_FullMethodName:        checker.RequireLookup("inventory.write", warehouse),
+		proto.InventoryService_ListStockBalances_FullMethodName:  checker.RequireLookup("inventory.read", farm),
+		proto.InventoryService_ListStockMovements_FullMethodName: checker.RequireLookup("inventory.read", farm),
+	}
+}
+
+func warehouseResource(db *gorm.DB) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		warehouse, err := repository.GetWarehouse(db.WithContext(ctx), req.(interface{ GetWarehouseId() string }).GetWarehouseId())
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		return farmhandlers.FarmResource(db, func(any) string { return warehouse.FarmID })(ctx, req)
+	}
+}
@@ -0,0 +1,342 @@
+package repository
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DateLayout is the format of expiry dates.
+const DateLayout = "2006-01-02"
+
+func CreateWarehouse(db *gorm.DB, warehouse *api.Warehouse) (string, error) {
+	if err := validateID("farmId", warehouse.FarmID); err != nil {
+		return "", err
+	}
+	if err := db.Create(warehouse).Error; err != nil {
+		return "", repoerr.FromGorm(err, "failed to insert warehouse")
+	}
+	return warehouse.ID, nil
+}
+
+func GetWarehouse(db *gorm.DB, id string) (*api.Warehouse, error) {
+	if err := validateID("warehouseId", id); err != nil {
+		return nil, err
+	}
+	var warehouse api.Warehouse
+	result := db.First(&warehouse, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("warehouseId", "no warehouse found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch warehouse")
+	}
+	return &warehouse, nil
+}
+
+// ListWarehouses returns the warehouses of farmID ordered by name.
+func ListWarehouses(db *gorm.DB, farmID string, q pagination.Query) (*pagination.Page[api.Warehouse], error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "name"}
+	tx := db.Model(&api.Warehouse{}).Where("farm_id = ?", farmID)
+	return pagination.List(tx, q, func(w *api.Warehouse) (string, string) {
+		return w.Name, w.ID
+	})
+}
+
+func CreateSKU(db *gorm.DB, sku *api.SKU) (string, error) {
+	if err := validateID("farmId", sku.FarmID); err != nil {
+		return "", err
+	}
+	if err := db.Create(sku).Error; err != nil {
+		return "", repoerr.FromGorm(err, "failed to insert SKU")
+	}
+	return sku.ID, nil
+}
+
+func GetSKU(db *gorm.DB, id string) (*api.SKU, error) {
+	if err := validateID("skuId", id); err != nil {
+		return nil, err
+	}
+	var sku api.SKU
+	result := db.First(&sku, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("skuId", "no SKU found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch SKU")
+	}
+	return &sku, nil
+}
+
+// ListSKUs returns the SKUs of farmID in category, or all of them when it is
+// empty, ordered by code.
+func ListSKUs(db *gorm.DB, farmID, category string, q pagination.Query) (*pagination.Page[api.SKU], error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "code"}
+	tx := db.Model(&api.SKU{}).Where("farm_id = ?", farmID)
+	if category != "" {
+		tx = tx.Where("category = ?", category)
+	}
+	return pagination.List(tx, q, func(s *api.SKU) (string, string) {
+		return s.Code, s.ID
+	})
+}
+
+// GetLot returns the lot with the given id and its SKU.
+func GetLot(db *gorm.DB, id string) (*api.StockLot, *api.SKU, error) {
+	if err := validateID("lotId", id); err != nil {
+		return nil, nil, err
+	}
+	var lot api.StockLot
+	result := db.First(&lot, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil, repoerr.NotFound("lotId", "no lot found with ID: %s", id)
+	}
+	if result.Error != nil {
+		return nil, nil, repoerr.FromGorm(result.Error, "failed to fetch lot")
+	}
+	sku, err := GetSKU(db, lot.SKUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &lot, sku, nil
+}
+
+// Receive records movement, a receipt of lotNumber of movement.SKUID, which
+// must be a SKU of the movement's farm. The lot is created with expiry
+// unless it exists; an existing lot must have the same expiry date.
+func Receive(db *gorm.DB, movement *api.StockMovement, lotNumber string, expiry *time.Time) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		sku, err := GetSKU(tx, movement.SKUID)
+		if err != nil {
+			return err
+		}
+		if sku.FarmID != movement.FarmID {
+			return repoerr.InvalidArgument("skuId", "SKU %s is not stocked by farm %s", sku.ID, movement.FarmID)
+		}
+		lot := api.StockLot{SKUID: movement.SKUID, LotNumber: lotNumber, ExpiryDate: expiry}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&lot)
+		if result.Error != nil {
+			return repoerr.FromGorm(result.Error, "failed to insert lot")
+		}
+		if result.RowsAffected == 0 {
+			if err := tx.First(&lot, "sku_id = ? AND lot_number = ?", movement.SKUID, lotNumber).Error; err != nil {
+				return repoerr.FromGorm(err, "failed to fetch lot")
+			}
+			if formatDate(lot.ExpiryDate) != formatDate(expiry) {
+				return repoerr.InvalidArgument("expiryDate", "lot %s expires on %s", lotNumber, formatDate(lot.ExpiryDate))
+			}
+		}
+		movement.LotID = lot.ID
+		movement.Lot = lot
+		return record(tx, movement)
+	})
+}
+
+// Record records movement of an existing lot, an issue, transfer or
+// adjustment. The lot must be of a SKU of the movement's farm, transfers
+// stay within the farm, expired lots are not issued and the stock taken
+// must be available.
+func Record(db *gorm.DB, movement *api.StockMovement) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		lot, sku, err := GetLot(tx, movement.LotID)
+		if err != nil {
+			return err
+		}
+		if sku.FarmID != movement.FarmID {
+			return repoerr.InvalidArgument("lotId", "lot %s is not stocked by farm %s", lot.ID, movement.FarmID)
+		}
+		if movement.Kind == api.MovementIssue && lot.ExpiryDate != nil && lot.ExpiryDate.Before(movement.OccurredAt.UTC().Truncate(24*time.Hour)) {
+			return repoerr.FailedPrecondition("lotId", "lot %s expired on %s", lot.LotNumber, formatDate(lot.ExpiryDate))
+		}
+		if movement.ToWarehouseID != nil {
+			to, err := GetWarehouse(tx, *movement.ToWarehouseID)
+			if err != nil {
+				return err
+			}
+			if to.FarmID != movement.FarmID {
+				return repoerr.InvalidArgument("toWarehouseId", "warehouse %s belongs to another farm", to.ID)
+			}
+		}
+		movement.SKUID = sku.ID
+		movement.Lot = *lot
+		return record(tx, movement)
+	})
+}
+
+// record inserts movement and applies it to the balances of its lot. The
+// balances taken from are locked in a fixed order so that concurrent
+// transfers between two warehouses cannot deadlock, and are only
+// decremented while they cover the quantity, so concurrent issues cannot
+// overdraw them.
+func record(tx *gorm.DB, movement *api.StockMovement) error {
+	movement.Quantity = roundQuantity(movement.Quantity)
+	if movement.Quantity == 0 {
+		return repoerr.InvalidArgument("quantity", "must not round to 0")
+	}
+	warehouseIDs := []string{movement.WarehouseID}
+	if movement.ToWarehouseID != nil {
+		warehouseIDs = append(warehouseIDs, *movement.ToWarehouseID)
+	}
+	var locked []api.StockBalance
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("lot_id = ? AND warehouse_id IN ?", movement.LotID, warehouseIDs).
+		Order("warehouse_id").Find(&locked)
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to lock stock balances")
+	}
+
+	if err := tx.Omit(clause.Associations).Create(movement).Error; err != nil {
+		return repoerr.FromGorm(err, "failed to insert stock movement")
+	}
+	if err := apply(tx, movement.WarehouseID, movement.LotID, movement.SKUID, movement.Quantity); err != nil {
+		return err
+	}
+	if movement.ToWarehouseID != nil {
+		return apply(tx, *movement.ToWarehouseID, movement.LotID, movement.SKUID, -movement.Quantity)
+	}
+	return nil
+}
+
+// apply changes the balance of lotID in warehouseID by quantity.
+func apply(tx *gorm.DB, warehouseID, lotID, skuID string, quantity float64) error {
+	if quantity > 0 {
+		result := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "warehouse_id"}, {Name: "lot_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"quantity":   gorm.Expr("stock_balances.quantity + EXCLUDED.quantity"),
+				"updated_at": gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).Create(&api.StockBalance{WarehouseID: warehouseID, LotID: lotID, SKUID: skuID, Quantity: quantity})
+		if result.Error != nil {
+			return repoerr.FromGorm(result.Error, "failed to update stock balance")
+		}
+		return nil
+	}
+
+	result := tx.Model(&api.StockBalance{}).
+		Where("warehouse_id = ? AND lot_id = ? AND quantity >= ?", warehouseID, lotID, -quantity).
+		Updates(map[string]any{
+			"quantity":   gorm.Expr("quantity - ?", -quantity),
+			"updated_at": gorm.Expr("CURRENT_TIMESTAMP"),
+		})
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to update stock balance")
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	var available float64
+	err := tx.Model(&api.StockBalance{}).Select("COALESCE(SUM(quantity), 0)").
+		Where("warehouse_id = ? AND lot_id = ?", warehouseID, lotID).Scan(&available).Error
+	if err != nil {
+		return repoerr.FromGorm(err, "failed to fetch stock balance")
+	}
+	return repoerr.FailedPrecondition("quantity", "warehouse %s holds %g of lot %s, %g requested",
+		warehouseID, available, lotID, -quantity)
+}
+
+// BalanceFilter narrows a balance listing. Zero fields are ignored.
+type BalanceFilter struct {
+	WarehouseID  string
+	SKUID        string
+	IncludeEmpty bool
+}
+
+// ListBalances returns the stock of every lot in the warehouses of farmID,
+// ordered by warehouse, SKU and expiry date.
+func ListBalances(db *gorm.DB, farmID string, filter BalanceFilter) ([]api.StockBalance, error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	tx := db.Model(&api.StockBalance{}).Preload("Lot").
+		Joins("JOIN warehouses ON warehouses.id = stock_balances.warehouse_id").
+		Joins("JOIN stock_lots ON stock_lots.id = stock_balances.lot_id").
+		Where("warehouses.farm_id = ?", farmID)
+	if filter.WarehouseID != "" {
+		tx = tx.Where("stock_balances.warehouse_id = ?", filter.WarehouseID)
+	}
+	if filter.SKUID != "" {
+		tx = tx.Where("stock_balances.sku_id = ?", filter.SKUID)
+	}
+	if !filter.IncludeEmpty {
+		tx = tx.Where("stock_balances.quantity > 0")
+	}
+	var balances []api.StockBalance
+	result := tx.Select("stock_balances.*").
+		Order("warehouses.name, stock_balances.sku_id, stock_lots.expiry_date NULLS LAST, stock_lots.lot_number").
+		Find(&balances)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to list stock balances")
+	}
+	return balances, nil
+}
+
+// MovementFilter narrows a movement listing. Zero fields are ignored.
+type MovementFilter struct {
+	// WarehouseID selects the movements in and out of the warehouse.
+	WarehouseID string
+	SKUID       string
+	LotID       string
+	ActivityID  string
+	Kind        string
+}
+
+// ListMovements returns the movements of farmID, latest first.
+func ListMovements(db *gorm.DB, farmID string, filter MovementFilter, q pagination.Query) (*pagination.Page[api.StockMovement], error) {
+	if err := validateID("farmId", farmID); err != nil {
+		return nil, err
+	}
+	q.Sort = pagination.Sort{Column: "recorded_at", Desc: true}
+	tx := db.Model(&api.StockMovement{}).Preload("Lot").Where("farm_id = ?", farmID)
+	if filter.WarehouseID != "" {
+		tx = tx.Where("warehouse_id = ? OR to_warehouse_id = ?", filter.WarehouseID, filter.WarehouseID)
+	}
+	if filter.SKUID != "" {
+		tx = tx.Where("sku_id = ?", filter.SKUID)
+	}
+	if filter.LotID != "" {
+		tx = tx.Where("lot_id = ?", filter.LotID)
+	}
+	if filter.ActivityID != "" {
+		tx = tx.Where("activity_id = ?", filter.ActivityID)
+	}
+	if filter.Kind != "" {
+		tx = tx.Where("kind = ?", filter.Kind)
+	}
+	return pagination.List(tx, q, func(m *api.StockMovement) (string, string) {
+		return m.RecordedAt.Format(time.RFC3339Nano), m.ID
+	})
+}
+
+// roundQuantity rounds to the three decimals quantities are stored with.
+func roundQuantity(q float64) float64 {
+	return math.Round(q*1000) / 1000
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(DateLayout)
+}
+
+func validateID(field, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return repoerr.InvalidArgument(field, "invalid uid format: %v", err)
+	}
+	return nil
+}
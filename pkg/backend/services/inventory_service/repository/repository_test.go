@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+
+	"gorm.io/gorm"
+)
+
+// stock is a farm with two warehouses and a SKU.
+type stock struct {
+	db     *gorm.DB
+	farmID string
+	userID string
+	a, b   string
+	skuID  string
+}
+
+func newStock(t *testing.T) *stock {
+	t.Helper()
+	db := dbtest.Postgres(t)
+	owner := &api.User{Username: "ada", Email: "ada@example.com", Password: "x"}
+	if err := db.Create(owner).Error; err != nil {
+		t.Fatal(err)
+	}
+	farm := &api.Farm{OwnerID: owner.ID, Name: "Home"}
+	if err := db.Create(farm).Error; err != nil {
+		t.Fatal(err)
+	}
+	s := &stock{db: db, farmID: farm.ID, userID: owner.ID}
+	var err error
+	if s.a, err = CreateWarehouse(db, &api.Warehouse{FarmID: farm.ID, Name: "Barn"}); err != nil {
+		t.Fatal(err)
+	}
+	if s.b, err = CreateWarehouse(db, &api.Warehouse{FarmID: farm.ID, Name: "Shed"}); err != nil {
+		t.Fatal(err)
+	}
+	if s.skuID, err = CreateSKU(db, &api.SKU{FarmID: farm.ID, Code: "N27", Name: "CAN 27", Category: api.SKUCategoryFertiliser, Unit: "kg"}); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func (s *stock) movement(kind, warehouseID string, quantity float64, occurredAt time.Time) *api.StockMovement {
+	return &api.StockMovement{
+		FarmID:      s.farmID,
+		Kind:        kind,
+		WarehouseID: warehouseID,
+		SKUID:       s.skuID,
+		Quantity:    quantity,
+		ActorID:     s.userID,
+		OccurredAt:  occurredAt,
+	}
+}
+
+// receive receives quantity of lotNumber into warehouseID and returns the
+// lot's id.
+func (s *stock) receive(t *testing.T, warehouseID, lotNumber string, quantity float64, expiry *time.Time) string {
+	t.Helper()
+	m := s.movement(api.MovementReceipt, warehouseID, quantity, time.Now())
+	if err := Receive(s.db, m, lotNumber, expiry); err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	return m.LotID
+}
+
+func (s *stock) balance(t *testing.T, warehouseID, lotID string) float64 {
+	t.Helper()
+	var balance api.StockBalance
+	err := s.db.Where("warehouse_id = ? AND lot_id = ?", warehouseID, lotID).Limit(1).Find(&balance).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance.Quantity
+}
+
+func day(s string) *time.Time {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return &d
+}
+
+func TestConcurrentIssues(t *testing.T) {
+	s := newStock(t)
+	lotID := s.receive(t, s.a, "L1", 10, nil)
+
+	// each covered by the balance, together they overdraw it
+	start := make(chan struct{})
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := s.movement(api.MovementIssue, s.a, -6, time.Now())
+			m.LotID = lotID
+			<-start
+			errs[i] = Record(s.db, m)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		if repoerr.KindOf(err) != repoerr.KindFailedPrecondition || repoerr.FieldOf(err) != "quantity" {
+			t.Errorf("Record() error = %v, want a failed precondition on quantity", err)
+		}
+	}
+	if failed != 1 {
+		t.Errorf("%d of 2 concurrent issues failed, want 1", failed)
+	}
+	if got := s.balance(t, s.a, lotID); got != 4 {
+		t.Errorf("balance = %g, want 4", got)
+	}
+	var movements int64
+	if err := s.db.Model(&api.StockMovement{}).Where("kind = ?", api.MovementIssue).Count(&movements).Error; err != nil {
+		t.Fatal(err)
+	}
+	if movements != 1 {
+		t.Errorf("%d issues recorded, want 1", movements)
+	}
+}
+
+func TestConcurrentTransfers(t *testing.T) {
+	s := newStock(t)
+	lotID := s.receive(t, s.a, "L1", 50, nil)
+	s.receive(t, s.b, "L1", 50, nil)
+
+	// crossing transfers lock both balances, in opposite directions
+	const transfers = 20
+	start := make(chan struct{})
+	errs := make([]error, transfers)
+	var wg sync.WaitGroup
+	for i := range errs {
+		from, to := s.a, s.b
+		if i%2 == 1 {
+			from, to = to, from
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := s.movement(api.MovementTransfer, from, -1, time.Now())
+			m.LotID = lotID
+			m.ToWarehouseID = &to
+			<-start
+			errs[i] = Record(s.db, m)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Errorf("Record() of a transfer error = %v", err)
+		}
+	}
+	if a, b := s.balance(t, s.a, lotID), s.balance(t, s.b, lotID); a != 50 || b != 50 {
+		t.Errorf("balances = %g and %g, want 50 and 50", a, b)
+	}
+}
+
+func TestReceiveExpiry(t *testing.T) {
+	s := newStock(t)
+	lotID := s.receive(t, s.a, "L1", 10, day("2026-01-31"))
+
+	if got := s.receive(t, s.b, "L1", 5, day("2026-01-31")); got != lotID {
+		t.Errorf("Receive() of an existing lot recorded lot %s, want %s", got, lotID)
+	}
+	for name, expiry := range map[string]*time.Time{
+		"other expiry": day("2026-02-28"),
+		"no expiry":    nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := s.movement(api.MovementReceipt, s.a, 5, time.Now())
+			err := Receive(s.db, m, "L1", expiry)
+			if repoerr.KindOf(err) != repoerr.KindInvalidArgument || repoerr.FieldOf(err) != "expiryDate" {
+				t.Errorf("Receive() error = %v, want an invalid expiryDate", err)
+			}
+		})
+	}
+	if got := s.balance(t, s.a, lotID); got != 10 {
+		t.Errorf("balance = %g, want 10", got)
+	}
+
+	// lot numbers are per SKU
+	other, err := CreateSKU(s.db, &api.SKU{FarmID: s.farmID, Code: "P40", Name: "TSP", Category: api.SKUCategoryFertiliser, Unit: "kg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := s.movement(api.MovementReceipt, s.a, 5, time.Now())
+	m.SKUID = other
+	if err := Receive(s.db, m, "L1", day("2027-01-31")); err != nil {
+		t.Errorf("Receive() of another SKU's lot number error = %v", err)
+	}
+}
+
+func TestIssueExpired(t *testing.T) {
+	s := newStock(t)
+	lotID := s.receive(t, s.a, "L1", 10, day("2025-03-01"))
+	expiry := *day("2025-03-01")
+
+	tests := []struct {
+		name        string
+		kind        string
+		occurredAt  time.Time
+		wantExpired bool
+	}{
+		{"issued on the expiry date", api.MovementIssue, expiry.Add(23 * time.Hour), false},
+		{"issued after it", api.MovementIssue, expiry.Add(24 * time.Hour), true},
+		// dates are UTC, the day after while still the expiry date in New York
+		{"issued after it in UTC", api.MovementIssue, expiry.Add(26 * time.Hour).In(time.FixedZone("EST", -5*3600)), true},
+		{"moved after it", api.MovementTransfer, expiry.AddDate(0, 1, 0), false},
+		{"written off after it", api.MovementAdjustment, expiry.AddDate(0, 1, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := s.db.Begin()
+			defer tx.Rollback()
+			m := s.movement(tt.kind, s.a, -1, tt.occurredAt)
+			m.LotID = lotID
+			if tt.kind == api.MovementTransfer {
+				m.ToWarehouseID = &s.b
+			}
+			err := Record(tx, m)
+			if tt.wantExpired {
+				if repoerr.KindOf(err) != repoerr.KindFailedPrecondition || repoerr.FieldOf(err) != "lotId" {
+					t.Errorf("Record() error = %v, want a failed precondition on lotId", err)
+				}
+			} else if err != nil {
+				t.Errorf("Record() error = %v", err)
+			}
+		})
+	}
+	if got := s.balance(t, s.a, lotID); got != 10 {
+		t.Errorf("balance = %g, want 10", got)
+	}
+}
@@ -0,0 +1,197 @@
+// Package balance implements the daily soil water balance of the root zone
+// after FAO Irrigation and Drainage Paper 56, "Crop evapotranspiration".
+//
+// The root zone depletion grows by the crop's evapotranspiration, the
+// reference evapotranspiration scaled by the crop coefficient, and shrinks
+// by rainfall and irrigation. It is bounded by zero, a root zone at field
+// capacity, and the total available water between field capacity and
+// wilting point. Irrigation is due once the depletion exceeds the readily
+// available water.
+package balance
+
+import (
+	"math"
+	"time"
+)
+
+// DepletionFraction is the share of the total available water a crop can
+// use before it suffers water stress, FAO-56's p.
+const DepletionFraction = 0.5
+
+// Soil holds the volumetric water content of a soil at field capacity and at
+// the wilting point, in m³/m³.
+type Soil struct {
+	FieldCapacity float64
+	WiltingPoint  float64
+}
+
+// soils are the midpoints of the ranges in FAO-56 table 19, peat from
+// typical values for organic soils.
+var soils = map[string]Soil{
+	"sand":            {0.12, 0.045},
+	"loamy_sand":      {0.14, 0.06},
+	"sandy_loam":      {0.23, 0.10},
+	"loam":            {0.25, 0.12},
+	"silt_loam":       {0.29, 0.15},
+	"silt":            {0.32, 0.15},
+	"sandy_clay_loam": {0.27, 0.17},
+	"clay_loam":       {0.32, 0.20},
+	"silty_clay_loam": {0.34, 0.21},
+	"sandy_clay":      {0.30, 0.20},
+	"silty_clay":      {0.36, 0.23},
+	"clay":            {0.36, 0.22},
+	"peat":            {0.55, 0.25},
+}
+
+// SoilFor returns the soil of the given soil type name, e.g. "silt_loam".
+// Unknown and unspecified types are treated as loam.
+func SoilFor(soilType string) Soil {
+	if soil, ok := soils[soilType]; ok {
+		return soil
+	}
+	return soils["loam"]
+}
+
+// TotalAvailableWater returns the water in mm the root zone of the given
+// depth in m holds between field capacity and wilting point.
+func (s Soil) TotalAvailableWater(rootDepthM float64) float64 {
+	return 1000 * (s.FieldCapacity - s.WiltingPoint) * rootDepthM
+}
+
+// Depletion returns the root zone depletion in mm at a measured volumetric
+// water content in m³/m³.
+func (s Soil) Depletion(waterContent, rootDepthM float64) float64 {
+	taw := s.TotalAvailableWater(rootDepthM)
+	return clamp(1000*(s.FieldCapacity-waterContent)*rootDepthM, 0, taw)
+}
+
+// Crop describes the water use of a crop over its season.
+type Crop struct {
+	KcInitial      float64
+	KcMid          float64
+	KcEnd          float64
+	DaysToMaturity int
+	RootDepthM     float64
+}
+
+// Shares of the season taken by the initial, development and mid-season
+// stages; the late season stage takes the rest.
+const (
+	initialShare     = 0.15
+	developmentShare = 0.25
+	midSeasonShare   = 0.40
+)
+
+// Kc returns the crop coefficient day days after sowing: constant through
+// the initial and mid-season stages and interpolated linearly in between
+// and towards the end of the season. It is 0 outside the season.
+func (c Crop) Kc(day int) float64 {
+	season := float64(c.DaysToMaturity)
+	d := float64(day)
+	initialEnd := season * initialShare
+	developmentEnd := initialEnd + season*developmentShare
+	midEnd := developmentEnd + season*midSeasonShare
+	switch {
+	case day < 0 || d >= season:
+		return 0
+	case d < initialEnd:
+		return c.KcInitial
+	case d < developmentEnd:
+		return c.KcInitial + (c.KcMid-c.KcInitial)*(d-initialEnd)/(developmentEnd-initialEnd)
+	case d < midEnd:
+		return c.KcMid
+	}
+	return c.KcMid + (c.KcEnd-c.KcMid)*(d-midEnd)/(season-midEnd)
+}
+
+// Hargreaves returns the reference evapotranspiration in mm/day estimated
+// from the daily minimum and maximum air temperature in °C at the given
+// latitude, FAO-56 equation 52. It is used when no measured reference
+// evapotranspiration is available.
+func Hargreaves(tMin, tMax, latitude float64, day time.Time) float64 {
+	if tMax < tMin {
+		tMin, tMax = tMax, tMin
+	}
+	ra := ExtraterrestrialRadiation(latitude, day)
+	et0 := 0.0023 * ((tMin+tMax)/2 + 17.8) * math.Sqrt(tMax-tMin) * 0.408 * ra
+	return math.Max(et0, 0)
+}
+
+// solarConstant in MJ m⁻² min⁻¹.
+const solarConstant = 0.0820
+
+// ExtraterrestrialRadiation returns the daily radiation at the top of the
+// atmosphere in MJ m⁻² day⁻¹, FAO-56 equation 21.
+func ExtraterrestrialRadiation(latitude float64, day time.Time) float64 {
+	phi := latitude * math.Pi / 180
+	j := float64(day.YearDay())
+	dr := 1 + 0.033*math.Cos(2*math.Pi*j/365)
+	delta := 0.409 * math.Sin(2*math.Pi*j/365-1.39)
+	// clamped for polar day and night
+	omega := math.Acos(clamp(-math.Tan(phi)*math.Tan(delta), -1, 1))
+	return 24 * 60 / math.Pi * solarConstant * dr *
+		(omega*math.Sin(phi)*math.Sin(delta) + math.Cos(phi)*math.Cos(delta)*math.Sin(omega))
+}
+
+// Day is the input and outcome of one day of the balance. Depletions are in
+// mm, all other amounts in mm/day.
+type Day struct {
+	Date time.Time
+	ET0  float64
+	// Kc is the crop coefficient, 0 before sowing and after harvest.
+	Kc       float64
+	ETc      float64
+	Rainfall float64
+	// Irrigation is the water applied or already scheduled.
+	Irrigation float64
+	// MeasuredDepletion is the depletion derived from a soil moisture
+	// reading, it replaces the modelled one at the end of the day.
+	MeasuredDepletion *float64
+	// Depletion is the depletion at the end of the day.
+	Depletion float64
+	// Recommended is the irrigation recommended on the day to refill the
+	// root zone to field capacity.
+	Recommended float64
+}
+
+// Model is the water balance of one field.
+type Model struct {
+	Soil       Soil
+	RootDepthM float64
+}
+
+func (m Model) TotalAvailableWater() float64 {
+	return m.Soil.TotalAvailableWater(m.RootDepthM)
+}
+
+func (m Model) ReadilyAvailableWater() float64 {
+	return DepletionFraction * m.TotalAvailableWater()
+}
+
+// Run computes days in order starting from the initial depletion and
+// returns the depletion at the end of the last day. Days from recommendFrom
+// on get a recommendation whenever their depletion exceeds the readily
+// available water; the recommended water is assumed to be applied.
+func (m Model) Run(initial float64, days []Day, recommendFrom time.Time) float64 {
+	taw := m.TotalAvailableWater()
+	raw := m.ReadilyAvailableWater()
+	depletion := clamp(initial, 0, taw)
+	for i := range days {
+		d := &days[i]
+		d.ETc = d.Kc * d.ET0
+		depletion = clamp(depletion+d.ETc-d.Rainfall-d.Irrigation, 0, taw)
+		if d.MeasuredDepletion != nil {
+			depletion = clamp(*d.MeasuredDepletion, 0, taw)
+		}
+		if !d.Date.Before(recommendFrom) && d.Kc > 0 && depletion > raw {
+			d.Recommended = depletion
+			depletion = 0
+		}
+		d.Depletion = depletion
+	}
+	return depletion
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
@@ -0,0 +1,221 @@
+package balance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func near(got, want float64) bool {
+	return math.Abs(got-want) < 1e-9
+}
+
+func TestSoil(t *testing.T) {
+	tests := []struct {
+		soilType      string
+		rootDepthM    float64
+		waterContent  float64
+		wantTAW       float64
+		wantDepletion float64
+	}{
+		{"loam", 0.5, 0.20, 65, 25},
+		{"", 0.5, 0.20, 65, 25},
+		{"moon_dust", 0.5, 0.20, 65, 25},
+		{"sand", 1, 0.10, 75, 20},
+		{"clay", 0.3, 0.36, 42, 0},
+		// wetter than field capacity and drier than the wilting point
+		{"silt_loam", 1, 0.40, 140, 0},
+		{"silt_loam", 1, 0.05, 140, 140},
+	}
+	for _, tt := range tests {
+		soil := SoilFor(tt.soilType)
+		if got := soil.TotalAvailableWater(tt.rootDepthM); !near(got, tt.wantTAW) {
+			t.Errorf("%q TotalAvailableWater(%v) = %v, want %v", tt.soilType, tt.rootDepthM, got, tt.wantTAW)
+		}
+		if got := soil.Depletion(tt.waterContent, tt.rootDepthM); !near(got, tt.wantDepletion) {
+			t.Errorf("%q Depletion(%v, %v) = %v, want %v", tt.soilType, tt.waterContent, tt.rootDepthM, got, tt.wantDepletion)
+		}
+	}
+}
+
+func TestKc(t *testing.T) {
+	crop := Crop{KcInitial: 0.3, KcMid: 1.2, KcEnd: 0.5, DaysToMaturity: 100}
+	tests := []struct {
+		day  int
+		want float64
+	}{
+		{-1, 0},
+		{0, 0.3},
+		{14, 0.3},
+		{15, 0.3},
+		{20, 0.48},
+		{40, 1.2},
+		{79, 1.2},
+		{80, 1.2},
+		{90, 0.85},
+		{99, 0.535},
+		{100, 0},
+	}
+	for _, tt := range tests {
+		if got := crop.Kc(tt.day); !near(got, tt.want) {
+			t.Errorf("Kc(%d) = %v, want %v", tt.day, got, tt.want)
+		}
+	}
+}
+
+func TestExtraterrestrialRadiation(t *testing.T) {
+	tests := []struct {
+		name     string
+		latitude float64
+		day      time.Time
+		want     float64
+	}{
+		// FAO-56 example 8
+		{"20°S on 3 September", -20, time.Date(2023, 9, 3, 0, 0, 0, 0, time.UTC), 32.2},
+		{"polar night", 80, time.Date(2023, 12, 21, 0, 0, 0, 0, time.UTC), 0},
+		{"polar day", 80, time.Date(2023, 6, 21, 0, 0, 0, 0, time.UTC), 44.7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtraterrestrialRadiation(tt.latitude, tt.day); math.Abs(got-tt.want) > 0.05 {
+				t.Errorf("ExtraterrestrialRadiation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHargreaves(t *testing.T) {
+	day := time.Date(2023, 9, 3, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		tMin, tMax float64
+		want       float64
+	}{
+		{"warm day", 14.8, 26.6, 4.00},
+		{"swapped temperatures", 26.6, 14.8, 4.00},
+		{"no daily range", 20, 20, 0},
+		{"freezing", -30, -25, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hargreaves(tt.tMin, tt.tMax, -20, day); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("Hargreaves() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	// loam, 0.5 m of roots: 65 mm total, 32.5 mm readily available
+	model := Model{Soil: SoilFor("loam"), RootDepthM: 0.5}
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := func(i int) time.Time { return start.AddDate(0, 0, i) }
+	measured := 40.0
+
+	tests := []struct {
+		name            string
+		initial         float64
+		days            []Day
+		recommendFrom   time.Time
+		wantDepletion   []float64
+		wantRecommended []float64
+	}{
+		{
+			name:    "crop uses water",
+			initial: 20,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1},
+				{Date: day(1), ET0: 5, Kc: 1},
+				{Date: day(2), ET0: 4, Kc: 0.5},
+			},
+			recommendFrom:   day(3),
+			wantDepletion:   []float64{25, 30, 32},
+			wantRecommended: []float64{0, 0, 0},
+		},
+		{
+			name:    "rainfall and irrigation refill up to field capacity",
+			initial: 30,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1, Rainfall: 10},
+				{Date: day(1), ET0: 5, Kc: 1, Irrigation: 20},
+				{Date: day(2), ET0: 5, Kc: 1, Rainfall: 50},
+			},
+			recommendFrom:   day(3),
+			wantDepletion:   []float64{25, 10, 0},
+			wantRecommended: []float64{0, 0, 0},
+		},
+		{
+			name:    "depletion is bounded by the total available water",
+			initial: 100,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1},
+			},
+			recommendFrom:   day(1),
+			wantDepletion:   []float64{65},
+			wantRecommended: []float64{0},
+		},
+		{
+			name:    "measurements replace the model",
+			initial: 10,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1, MeasuredDepletion: &measured},
+				{Date: day(1), ET0: 5, Kc: 1},
+			},
+			recommendFrom:   day(2),
+			wantDepletion:   []float64{40, 45},
+			wantRecommended: []float64{0, 0},
+		},
+		{
+			name:    "recommendations refill the root zone",
+			initial: 25,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1},
+				{Date: day(1), ET0: 5, Kc: 1},
+				{Date: day(2), ET0: 5, Kc: 1},
+			},
+			recommendFrom:   day(0),
+			wantDepletion:   []float64{30, 0, 5},
+			wantRecommended: []float64{0, 35, 0},
+		},
+		{
+			name:    "no recommendations before recommendFrom",
+			initial: 30,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 1},
+				{Date: day(1), ET0: 5, Kc: 1},
+			},
+			recommendFrom:   day(1),
+			wantDepletion:   []float64{35, 0},
+			wantRecommended: []float64{0, 40},
+		},
+		{
+			name:    "no recommendations outside the season",
+			initial: 50,
+			days: []Day{
+				{Date: day(0), ET0: 5, Kc: 0},
+			},
+			recommendFrom:   day(0),
+			wantDepletion:   []float64{50},
+			wantRecommended: []float64{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			final := model.Run(tt.initial, tt.days, tt.recommendFrom)
+			for i, d := range tt.days {
+				if !near(d.ETc, d.Kc*d.ET0) {
+					t.Errorf("day %d ETc = %v, want %v", i, d.ETc, d.Kc*d.ET0)
+				}
+				if !near(d.Depletion, tt.wantDepletion[i]) {
+					t.Errorf("day %d depletion = %v, want %v", i, d.Depletion, tt.wantDepletion[i])
+				}
+				if !near(d.Recommended, tt.wantRecommended[i]) {
+					t.Errorf("day %d recommended = %v, want %v", i, d.Recommended, tt.wantRecommended[i])
+				}
+			}
+			if want := tt.wantDepletion[len(tt.wantDepletion)-1]; !near(final, want) {
+				t.Errorf("Run() = %v, want %v", final, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/scheduler"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const dateLayout = "2006-01-02"
+
+var errNoScheduler = status.Error(codes.FailedPrecondition, "irrigation scheduling requires MongoDB to be configured")
+
+type IrrigationHandler struct {
+	proto.UnimplementedIrrigationServiceServer
+	scheduler *scheduler.Scheduler
+	logger    *zap.Logger
+}
+
+// NewIrrigationHandler returns the IrrigationService handler. s is nil when
+// MongoDB is not configured, every call then fails with FailedPrecondition.
+func NewIrrigationHandler(s *scheduler.Scheduler, logger *zap.Logger) *IrrigationHandler {
+	irrigationHandler := IrrigationHandler{
+		scheduler: s,
+		logger:    logger,
+	}
+	return &irrigationHandler
+}
+
+// GetSchedule returns the latest schedule of the field, computing it when
+// the worker has not done so yet.
+func (h *IrrigationHandler) GetSchedule(ctx context.Context, req *proto.GetScheduleRequest) (*proto.GetScheduleResponse, error) {
+	if h.scheduler == nil {
+		return nil, errNoScheduler
+	}
+	schedule, err := h.scheduler.Get(ctx, req.GetFieldId())
+	if errors.Is(err, repoerr.ErrNotFound) {
+		schedule, err = h.scheduler.Compute(ctx, req.GetFieldId(), time.Now())
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get irrigation schedule")
+	}
+	return &proto.GetScheduleResponse{Schedule: toProto(schedule)}, nil
+}
+
+func (h *IrrigationHandler) RecomputeSchedule(ctx context.Context, req *proto.RecomputeScheduleRequest) (*proto.RecomputeScheduleResponse, error) {
+	if h.scheduler == nil {
+		return nil, errNoScheduler
+	}
+	schedule, err := h.scheduler.Compute(ctx, req.GetFieldId(), time.Now())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to compute irrigation schedule")
+	}
+	return &proto.RecomputeScheduleResponse{Schedule: toProto(schedule)}, nil
+}
+
+func (h *IrrigationHandler) AcceptSchedule(ctx context.Context, req *proto.AcceptScheduleRequest) (*proto.AcceptScheduleResponse, error) {
+	if h.scheduler == nil {
+		return nil, errNoScheduler
+	}
+	schedule, err := h.scheduler.Accept(ctx, req.GetFieldId(), req.GetScheduleId(), auth.FromContext(ctx).UserID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to accept irrigation schedule")
+	}
+
+	logging.WithContext(ctx, h.logger).Info("Irrigation schedule accepted", zap.String("field_id", schedule.FieldID),
+		zap.String("schedule_id", schedule.ID), zap.Int("activities", len(schedule.ActivityIDs)))
+	return &proto.AcceptScheduleResponse{
+		Schedule: toProto(schedule),
+		Message:  "Irrigation schedule accepted successfully",
+	}, nil
+}
+
+func toProto(s *scheduler.Schedule) *proto.Schedule {
+	schedule := &proto.Schedule{
+		Id:                    s.ID,
+		FieldId:               s.FieldID,
+		PlantingPlanId:        s.PlantingPlanID,
+		CropId:                s.CropID,
+		ComputedAt:            s.ComputedAt.Format(time.RFC3339),
+		TotalAvailableWater:   s.TotalAvailableWater,
+		ReadilyAvailableWater: s.ReadilyAvailableWater,
+		Depletion:             s.Depletion,
+		MeasuredDays:          int32(s.MeasuredDays),
+		AcceptedBy:            s.AcceptedBy,
+		ActivityIds:           s.ActivityIDs,
+	}
+	for _, d := range s.Days {
+		schedule.Days = append(schedule.Days, &proto.ScheduleDay{
+			Date:        d.Date.Format(dateLayout),
+			Et0:         d.ET0,
+			Kc:          d.Kc,
+			Etc:         d.ETc,
+			Rainfall:    d.Rainfall,
+			Irrigation:  d.Irrigation,
+			Depletion:   d.Depletion,
+			Recommended: d.Recommended,
+		})
+	}
+	if next := s.Next(); next != nil {
+		schedule.NextIrrigationDate = next.Date.Format(dateLayout)
+		schedule.NextIrrigationAmount = next.Recommended
+	}
+	if s.AcceptedAt != nil {
+		schedule.AcceptedAt = s.AcceptedAt.Format(time.RFC3339)
+	}
+	return schedule
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of IrrigationService. Schedules are part of
+// the operations of a field: reading them needs activity.read, and
+// recomputing, which supersedes the schedule others may be about to accept,
+// and accepting, which records activities, activity.write.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	field := farmhandlers.FieldResource(db, func(req any) string {
+		return req.(interface{ GetFieldId() string }).GetFieldId()
+	})
+
+	return auth.Policy{
+		proto.IrrigationService_GetSchedule_FullMethodName:       checker.RequireLookup("activity.read", field),
+		proto.IrrigationService_RecomputeSchedule_FullMethodName: checker.RequireLookup("activity.write", field),
+		proto.IrrigationService_AcceptSchedule_FullMethodName:    checker.RequireLookup("activity.write", field),
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.19.6
+// source: irrigation.proto
+
+package proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/aburifat/go-agro/pkg/backend/common/validate/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduleDay is a day of a schedule. Amounts are in mm.
+type ScheduleDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// reference evapotranspiration
+	Et0 float64 `protobuf:"fixed64,2,opt,name=et0,proto3" json:"et0,omitempty"`
+	// crop coefficient
+	Kc float64 `protobuf:"fixed64,3,opt,name=kc,proto3" json:"kc,omitempty"`
+	// crop evapotranspiration, et0 × kc
+	Etc      float64 `protobuf:"fixed64,4,opt,name=etc,proto3" json:"etc,omitempty"`
+	Rainfall float64 `protobuf:"fixed64,5,opt,name=rainfall,proto3" json:"rainfall,omitempty"`
+	// irrigation already recorded for the day
+	Irrigation float64 `protobuf:"fixed64,6,opt,name=irrigation,proto3" json:"irrigation,omitempty"`
+	// root zone depletion at the end of the day
+	Depletion float64 `protobuf:"fixed64,7,opt,name=depletion,proto3" json:"depletion,omitempty"`
+	// irrigation recommended on the day
+	Recommended   float64 `protobuf:"fixed64,8,opt,name=recommended,proto3" json:"recommended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleDay) Reset() {
+	*x = ScheduleDay{}
+	mi := &file_irrigation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDay) ProtoMessage() {}
+
+func (x *ScheduleDay) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleDay.ProtoReflect.Descriptor instead.
+func (*ScheduleDay) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduleDay) GetEt0() float64 {
+	if x != nil {
+		return x.Et0
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetKc() float64 {
+	if x != nil {
+		return x.Kc
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetEtc() float64 {
+	if x != nil {
+		return x.Etc
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetRainfall() float64 {
+	if x != nil {
+		return x.Rainfall
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetIrrigation() float64 {
+	if x != nil {
+		return x.Irrigation
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetDepletion() float64 {
+	if x != nil {
+		return x.Depletion
+	}
+	return 0
+}
+
+func (x *ScheduleDay) GetRecommended() float64 {
+	if x != nil {
+		return x.Recommended
+	}
+	return 0
+}
+
+type Schedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId        string                 `protobuf:"bytes,2,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	PlantingPlanId string                 `protobuf:"bytes,3,opt,name=plantingPlanId,proto3" json:"plantingPlanId,omitempty"`
+	CropId         string                 `protobuf:"bytes,4,opt,name=cropId,proto3" json:"cropId,omitempty"`
+	// RFC 3339
+	ComputedAt string `protobuf:"bytes,5,opt,name=computedAt,proto3" json:"computedAt,omitempty"`
+	// water held by the root zone between field capacity and wilting point
+	TotalAvailableWater float64 `protobuf:"fixed64,6,opt,name=totalAvailableWater,proto3" json:"totalAvailableWater,omitempty"`
+	// depletion above which irrigation is due
+	ReadilyAvailableWater float64 `protobuf:"fixed64,7,opt,name=readilyAvailableWater,proto3" json:"readilyAvailableWater,omitempty"`
+	// root zone depletion at the start of the first day
+	Depletion float64 `protobuf:"fixed64,8,opt,name=depletion,proto3" json:"depletion,omitempty"`
+	// days of the last 30 with measured or estimated evapotranspiration
+	MeasuredDays int32          `protobuf:"varint,9,opt,name=measuredDays,proto3" json:"measuredDays,omitempty"`
+	Days         []*ScheduleDay `protobuf:"bytes,10,rep,name=days,proto3" json:"days,omitempty"`
+	// the next recommended irrigation, YYYY-MM-DD, empty if none is due
+	NextIrrigationDate   string  `protobuf:"bytes,11,opt,name=nextIrrigationDate,proto3" json:"nextIrrigationDate,omitempty"`
+	NextIrrigationAmount float64 `protobuf:"fixed64,12,opt,name=nextIrrigationAmount,proto3" json:"nextIrrigationAmount,omitempty"`
+	// RFC 3339, empty until the schedule is accepted
+	AcceptedAt string `protobuf:"bytes,13,opt,name=acceptedAt,proto3" json:"acceptedAt,omitempty"`
+	AcceptedBy string `protobuf:"bytes,14,opt,name=acceptedBy,proto3" json:"acceptedBy,omitempty"`
+	// the irrigation activity recorded on acceptance, if any was due
+	ActivityIds   []string `protobuf:"bytes,15,rep,name=activityIds,proto3" json:"activityIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_irrigation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *Schedule) GetPlantingPlanId() string {
+	if x != nil {
+		return x.PlantingPlanId
+	}
+	return ""
+}
+
+func (x *Schedule) GetCropId() string {
+	if x != nil {
+		return x.CropId
+	}
+	return ""
+}
+
+func (x *Schedule) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+func (x *Schedule) GetTotalAvailableWater() float64 {
+	if x != nil {
+		return x.TotalAvailableWater
+	}
+	return 0
+}
+
+func (x *Schedule) GetReadilyAvailableWater() float64 {
+	if x != nil {
+		return x.ReadilyAvailableWater
+	}
+	return 0
+}
+
+func (x *Schedule) GetDepletion() float64 {
+	if x != nil {
+		return x.Depletion
+	}
+	return 0
+}
+
+func (x *Schedule) GetMeasuredDays() int32 {
+	if x != nil {
+		return x.MeasuredDays
+	}
+	return 0
+}
+
+func (x *Schedule) GetDays() []*ScheduleDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Schedule) GetNextIrrigationDate() string {
+	if x != nil {
+		return x.NextIrrigationDate
+	}
+	return ""
+}
+
+func (x *Schedule) GetNextIrrigationAmount() float64 {
+	if x != nil {
+		return x.NextIrrigationAmount
+	}
+	return 0
+}
+
+func (x *Schedule) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
+}
+
+func (x *Schedule) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+func (x *Schedule) GetActivityIds() []string {
+	if x != nil {
+		return x.ActivityIds
+	}
+	return nil
+}
+
+type GetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_irrigation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetScheduleRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+type GetScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_irrigation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type RecomputeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeScheduleRequest) Reset() {
+	*x = RecomputeScheduleRequest{}
+	mi := &file_irrigation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeScheduleRequest) ProtoMessage() {}
+
+func (x *RecomputeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecomputeScheduleRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+type RecomputeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeScheduleResponse) Reset() {
+	*x = RecomputeScheduleResponse{}
+	mi := &file_irrigation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeScheduleResponse) ProtoMessage() {}
+
+func (x *RecomputeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecomputeScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Only the latest schedule of a field can be accepted, and only once. The
+// irrigation it recommends for the day of acceptance is recorded, later
+// days are forecasts. It is checked against the pesticide registry like
+// recorded activities; under block enforcement entering the field within a
+// re-entry interval fails the accept with FailedPrecondition.
+type AcceptScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=fieldId,proto3" json:"fieldId,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,2,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptScheduleRequest) Reset() {
+	*x = AcceptScheduleRequest{}
+	mi := &file_irrigation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptScheduleRequest) ProtoMessage() {}
+
+func (x *AcceptScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptScheduleRequest.ProtoReflect.Descriptor instead.
+func (*AcceptScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptScheduleRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *AcceptScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type AcceptScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptScheduleResponse) Reset() {
+	*x = AcceptScheduleResponse{}
+	mi := &file_irrigation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptScheduleResponse) ProtoMessage() {}
+
+func (x *AcceptScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_irrigation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AcceptScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_irrigation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *AcceptScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_irrigation_proto protoreflect.FileDescriptor
+
+var file_irrigation_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0a, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x30,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x74, 0x30, 0x12, 0x0e, 0x0a, 0x02, 0x6b,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6b, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x74, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x74, 0x63, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x61, 0x69, 0x6e, 0x66, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x72, 0x61, 0x69, 0x6e, 0x66, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x72, 0x72,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69,
+	0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xb1, 0x04, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x6f, 0x70,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x6f, 0x70, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x30, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x61, 0x74,
+	0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6c, 0x79, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x15, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x72, 0x72, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61,
+	0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x49,
+	0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x49,
+	0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x72, 0x72, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73, 0x22, 0x36, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x07, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3c,
+	0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18,
+	0x02, 0x38, 0x01, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x19,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x72,
+	0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x61, 0x0a, 0x15, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x38, 0x01, 0x52, 0x07, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02,
+	0x38, 0x01, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x64,
+	0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x72, 0x72,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x32, 0xca, 0x03, 0x0a, 0x11, 0x49, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x69, 0x72, 0x72, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x72, 0x72, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x24, 0x2e, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x7d, 0x2f,
+	0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x3a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x92, 0x01, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x21, 0x2e, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01,
+	0x2a, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2f, 0x7b, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x62, 0x75, 0x72, 0x69, 0x66, 0x61, 0x74, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x67, 0x72, 0x6f,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x72, 0x72, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_irrigation_proto_rawDescOnce sync.Once
+	file_irrigation_proto_rawDescData = file_irrigation_proto_rawDesc
+)
+
+func file_irrigation_proto_rawDescGZIP() []byte {
+	file_irrigation_proto_rawDescOnce.Do(func() {
+		file_irrigation_proto_rawDescData = protoimpl.X.CompressGZIP(file_irrigation_proto_rawDescData)
+	})
+	return file_irrigation_proto_rawDescData
+}
+
+var file_irrigation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_irrigation_proto_goTypes = []any{
+	(*ScheduleDay)(nil),               // 0: irrigation.ScheduleDay
+	(*Schedule)(nil),                  // 1: irrigation.Schedule
+	(*GetScheduleRequest)(nil),        // 2: irrigation.GetScheduleRequest
+	(*GetScheduleResponse)(nil),       // 3: irrigation.GetScheduleResponse
+	(*RecomputeScheduleRequest)(nil),  // 4: irrigation.RecomputeScheduleRequest
+	(*RecomputeScheduleResponse)(nil), // 5: irrigation.RecomputeScheduleResponse
+	(*AcceptScheduleRequest)(nil),     // 6: irrigation.AcceptScheduleRequest
+	(*AcceptScheduleResponse)(nil),    // 7: irrigation.AcceptScheduleResponse
+}
+var file_irrigation_proto_depIdxs = []int32{
+	0, // 0: irrigation.Schedule.days:type_name -> irrigation.ScheduleDay
+	1, // 1: irrigation.GetScheduleResponse.schedule:type_name -> irrigation.Schedule
+	1, // 2: irrigation.RecomputeScheduleResponse.schedule:type_name -> irrigation.Schedule
+	1, // 3: irrigation.AcceptScheduleResponse.schedule:type_name -> irrigation.Schedule
+	2, // 4: irrigation.IrrigationService.GetSchedule:input_type -> irrigation.GetScheduleRequest
+	4, // 5: irrigation.IrrigationService.RecomputeSchedule:input_type -> irrigation.RecomputeScheduleRequest
+	6, // 6: irrigation.IrrigationService.AcceptSchedule:input_type -> irrigation.AcceptScheduleRequest
+	3, // 7: irrigation.IrrigationService.GetSchedule:output_type -> irrigation.GetScheduleResponse
+	5, // 8: irrigation.IrrigationService.RecomputeSchedule:output_type -> irrigation.RecomputeScheduleResponse
+	7, // 9: irrigation.IrrigationService.AcceptSchedule:output_type -> irrigation.AcceptScheduleResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_irrigation_proto_init() }
+func file_irrigation_proto_init() {
+	if File_irrigation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_irrigation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_irrigation_proto_goTypes,
+		DependencyIndexes: file_irrigation_proto_depIdxs,
+		MessageInfos:      file_irrigation_proto_msgTypes,
+	}.Build()
+	File_irrigation_proto = out.File
+	file_irrigation_proto_rawDesc = nil
+	file_irrigation_proto_goTypes = nil
+	file_irrigation_proto_depIdxs = nil
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: irrigation.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IrrigationService_GetSchedule_FullMethodName       = "/irrigation.IrrigationService/GetSchedule"
+	IrrigationService_RecomputeSchedule_FullMethodName = "/irrigation.IrrigationService/RecomputeSchedule"
+	IrrigationService_AcceptSchedule_FullMethodName    = "/irrigation.IrrigationService/AcceptSchedule"
+)
+
+// IrrigationServiceClient is the client API for IrrigationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IrrigationService schedules the irrigation of planted fields from a daily
+// soil water balance. Schedules are recomputed periodically; an accepted
+// schedule records the day's irrigation as an activity of the field.
+type IrrigationServiceClient interface {
+	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error)
+	RecomputeSchedule(ctx context.Context, in *RecomputeScheduleRequest, opts ...grpc.CallOption) (*RecomputeScheduleResponse, error)
+	AcceptSchedule(ctx context.Context, in *AcceptScheduleRequest, opts ...grpc.CallOption) (*AcceptScheduleResponse, error)
+}
+
+type irrigationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIrrigationServiceClient(cc grpc.ClientConnInterface) IrrigationServiceClient {
+	return &irrigationServiceClient{cc}
+}
+
+func (c *irrigationServiceClient) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleResponse)
+	err := c.cc.Invoke(ctx, IrrigationService_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *irrigationServiceClient) RecomputeSchedule(ctx context.Context, in *RecomputeScheduleRequest, opts ...grpc.CallOption) (*RecomputeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeScheduleResponse)
+	err := c.cc.Invoke(ctx, IrrigationService_RecomputeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *irrigationServiceClient) AcceptSchedule(ctx context.Context, in *AcceptScheduleRequest, opts ...grpc.CallOption) (*AcceptScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptScheduleResponse)
+	err := c.cc.Invoke(ctx, IrrigationService_AcceptSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IrrigationServiceServer is the server API for IrrigationService service.
+// All implementations must embed UnimplementedIrrigationServiceServer
+// for forward compatibility.
+//
+// IrrigationService schedules the irrigation of planted fields from a daily
+// soil water balance. Schedules are recomputed periodically; an accepted
+// schedule records the day's irrigation as an activity of the field.
+type IrrigationServiceServer interface {
+	GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error)
+	RecomputeSchedule(context.Context, *RecomputeScheduleRequest) (*RecomputeScheduleResponse, error)
+	AcceptSchedule(context.Context, *AcceptScheduleRequest) (*AcceptScheduleResponse, error)
+	mustEmbedUnimplementedIrrigationServiceServer()
+}
+
+// UnimplementedIrrigationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIrrigationServiceServer struct{}
+
+func (UnimplementedIrrigationServiceServer) GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedIrrigationServiceServer) RecomputeSchedule(context.Context, *RecomputeScheduleRequest) (*RecomputeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeSchedule not implemented")
+}
+func (UnimplementedIrrigationServiceServer) AcceptSchedule(context.Context, *AcceptScheduleRequest) (*AcceptScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSchedule not implemented")
+}
+func (UnimplementedIrrigationServiceServer) mustEmbedUnimplementedIrrigationServiceServer() {}
+func (UnimplementedIrrigationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeIrrigationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IrrigationServiceServer will
+// result in compilation errors.
+type UnsafeIrrigationServiceServer interface {
+	mustEmbedUnimplementedIrrigationServiceServer()
+}
+
+func RegisterIrrigationServiceServer(s grpc.ServiceRegistrar, srv IrrigationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIrrigationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IrrigationService_ServiceDesc, srv)
+}
+
+func _IrrigationService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrrigationServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IrrigationService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrrigationServiceServer).GetSchedule(ctx, req.(*GetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IrrigationService_RecomputeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrrigationServiceServer).RecomputeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IrrigationService_RecomputeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrrigationServiceServer).RecomputeSchedule(ctx, req.(*RecomputeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IrrigationService_AcceptSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrrigationServiceServer).AcceptSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IrrigationService_AcceptSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrrigationServiceServer).AcceptSchedule(ctx, req.(*AcceptScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IrrigationService_ServiceDesc is the grpc.ServiceDesc for IrrigationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IrrigationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "irrigation.IrrigationService",
+	HandlerType: (*IrrigationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedule",
+			Handler:    _IrrigationService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "RecomputeSchedule",
+			Handler:    _IrrigationService_RecomputeSchedule_Handler,
+		},
+		{
+			MethodName: "AcceptSchedule",
+			Handler:    _IrrigationService_AcceptSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "irrigation.proto",
+}
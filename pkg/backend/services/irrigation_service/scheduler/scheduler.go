@@ -0,0 +1,573 @@
+// Package scheduler computes irrigation schedules of planted fields from
+// their telemetry and activity journal and keeps the latest schedule of
+// every field, and the schedules that were accepted, in MongoDB.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/rules"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+	farmrepository "github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/balance"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/series"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const CollectionName = "irrigation_schedules"
+
+// Telemetry metrics the balance is computed from.
+const (
+	// MetricET0 is the reference evapotranspiration in mm.
+	MetricET0 = "et0"
+	// MetricAirTemperature in °C estimates ET0 where it is not measured.
+	MetricAirTemperature = "air_temperature"
+	// MetricRainfall in mm.
+	MetricRainfall = "rainfall"
+	// MetricSoilMoisture is the volumetric water content in percent, or in
+	// m³/m³ for values up to 1.
+	MetricSoilMoisture = "soil_moisture"
+)
+
+const (
+	// ForecastDays is the length of a schedule, starting today.
+	ForecastDays = 7
+	// lookbackDays of telemetry are replayed to find today's depletion.
+	lookbackDays = 30
+	// forecastBasisDays is the number of the latest days of reference
+	// evapotranspiration whose mean is assumed for the forecast.
+	forecastBasisDays = 7
+	// releaseTimeout bounds releasing the claim of a schedule whose
+	// activities could not be recorded.
+	releaseTimeout = 10 * time.Second
+)
+
+// Scheduler computes and stores irrigation schedules.
+type Scheduler struct {
+	db         *gorm.DB
+	telemetry  *series.Store
+	journal    *journal.Journal
+	compliance *rules.Engine
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+func NewScheduler(db *gorm.DB, store *storage.Storage, telemetry *series.Store, j *journal.Journal, compliance *rules.Engine, logger *zap.Logger) *Scheduler {
+	scheduler := Scheduler{
+		db:         db,
+		telemetry:  telemetry,
+		journal:    j,
+		compliance: compliance,
+		collection: store.GetCollection(CollectionName),
+		logger:     logger,
+	}
+	return &scheduler
+}
+
+// Day is a day of a schedule. Amounts are in mm.
+type Day struct {
+	Date time.Time `bson:"date"`
+	ET0  float64   `bson:"et0"`
+	Kc   float64   `bson:"kc"`
+	ETc  float64   `bson:"etc"`
+	// Rainfall is not forecast and 0 unless it was measured today.
+	Rainfall float64 `bson:"rainfall"`
+	// Irrigation is the water already recorded in the activity journal.
+	Irrigation float64 `bson:"irrigation"`
+	// Depletion is the root zone depletion at the end of the day, after the
+	// recommended irrigation.
+	Depletion   float64 `bson:"depletion"`
+	Recommended float64 `bson:"recommended"`
+}
+
+// Schedule is the irrigation schedule of a field for the ForecastDays days
+// from the day it was computed.
+type Schedule struct {
+	ID             string    `bson:"_id"`
+	FieldID        string    `bson:"fieldId"`
+	FarmID         string    `bson:"farmId"`
+	PlantingPlanID string    `bson:"plantingPlanId"`
+	CropID         string    `bson:"cropId"`
+	ComputedAt     time.Time `bson:"computedAt"`
+	// TotalAvailableWater and ReadilyAvailableWater of the root zone in mm.
+	TotalAvailableWater   float64 `bson:"totalAvailableWater"`
+	ReadilyAvailableWater float64 `bson:"readilyAvailableWater"`
+	// Depletion is the root zone depletion in mm at the start of the first
+	// day.
+	Depletion float64 `bson:"depletion"`
+	// MeasuredDays is the number of days of the lookback with measured or
+	// estimated reference evapotranspiration.
+	MeasuredDays int        `bson:"measuredDays"`
+	Days         []Day      `bson:"days"`
+	AcceptedAt   *time.Time `bson:"acceptedAt,omitempty"`
+	AcceptedBy   string     `bson:"acceptedBy,omitempty"`
+	// ActivityIDs are the irrigation activities recorded on acceptance.
+	ActivityIDs []string `bson:"activityIds,omitempty"`
+}
+
+// EnsureIndexes creates the index the latest schedule of a field is found
+// by.
+func (s *Scheduler) EnsureIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "fieldId", Value: 1}, {Key: "computedAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create irrigation schedule indexes: %w", err)
+	}
+	return nil
+}
+
+// Next returns the first day with recommended irrigation, nil if none is
+// due within the schedule.
+func (s *Schedule) Next() *Day {
+	for i := range s.Days {
+		if s.Days[i].Recommended > 0 {
+			return &s.Days[i]
+		}
+	}
+	return nil
+}
+
+// Compute computes and stores the schedule of fieldID starting on the day
+// of now. It supersedes the field's earlier schedules; those that were
+// accepted are kept.
+func (s *Scheduler) Compute(ctx context.Context, fieldID string, now time.Time) (*Schedule, error) {
+	db := s.db.WithContext(ctx)
+	today := now.UTC().Truncate(24 * time.Hour)
+	end := today.AddDate(0, 0, ForecastDays)
+
+	field, err := farmrepository.GetField(db, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := croprepository.GetActivePlantingPlan(db, fieldID, today, end)
+	if errors.Is(err, repoerr.ErrNotFound) {
+		return nil, repoerr.FailedPrecondition("plantingPlan", "field %s has no planned or sown crop in the next %d days", fieldID, ForecastDays)
+	}
+	if err != nil {
+		return nil, err
+	}
+	boundary, err := geo.Parse(field.Boundary)
+	if err != nil {
+		return nil, fmt.Errorf("field %s has an invalid boundary: %w", fieldID, err)
+	}
+	latitude := boundary.Center().Lat()
+
+	lookback := today.AddDate(0, 0, -lookbackDays)
+	daily, err := s.telemetry.Daily(ctx, fieldID,
+		[]string{MetricET0, MetricAirTemperature, MetricRainfall, MetricSoilMoisture}, lookback, today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	observed := observations(daily, latitude)
+	var et0s []float64
+	for day := lookback; day.Before(today); day = day.AddDate(0, 0, 1) {
+		if o, ok := observed[day]; ok && o.et0 != nil {
+			et0s = append(et0s, *o.et0)
+		}
+	}
+	if len(et0s) == 0 {
+		return nil, repoerr.FailedPrecondition("telemetry", "field %s has no %s or %s readings in the last %d days",
+			fieldID, MetricET0, MetricAirTemperature, lookbackDays)
+	}
+	forecastET0 := mean(et0s[max(0, len(et0s)-forecastBasisDays):])
+	meanET0 := mean(et0s)
+
+	start := lookback
+	if plan.SowingDate.After(start) {
+		start = plan.SowingDate
+	}
+	if start.After(today) {
+		start = today
+	}
+	irrigation, err := s.irrigation(ctx, fieldID, field.AreaHectares, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	crop := balance.Crop{
+		KcInitial:      plan.Crop.KcInitial,
+		KcMid:          plan.Crop.KcMid,
+		KcEnd:          plan.Crop.KcEnd,
+		DaysToMaturity: plan.Crop.DaysToMaturity,
+		RootDepthM:     plan.Crop.RootDepthM,
+	}
+	soil := balance.SoilFor(field.SoilType)
+	model := balance.Model{Soil: soil, RootDepthM: crop.RootDepthM}
+
+	// the root zone is assumed at field capacity at the start of the
+	// lookback or at sowing, soil moisture readings correct that
+	var history []balance.Day
+	for day := start; day.Before(today); day = day.AddDate(0, 0, 1) {
+		d := balance.Day{
+			Date:       day,
+			Kc:         crop.Kc(daysBetween(plan.SowingDate, day)),
+			ET0:        meanET0,
+			Irrigation: irrigation[day],
+		}
+		if o, ok := observed[day]; ok {
+			if o.et0 != nil {
+				d.ET0 = *o.et0
+			}
+			d.Rainfall = o.rainfall
+			if o.waterContent != nil {
+				depletion := soil.Depletion(*o.waterContent, crop.RootDepthM)
+				d.MeasuredDepletion = &depletion
+			}
+		}
+		history = append(history, d)
+	}
+	depletion := model.Run(0, history, end)
+	if o, ok := observed[today]; ok && o.waterContent != nil {
+		depletion = soil.Depletion(*o.waterContent, crop.RootDepthM)
+	}
+
+	forecast := make([]balance.Day, 0, ForecastDays)
+	for day := today; day.Before(end); day = day.AddDate(0, 0, 1) {
+		d := balance.Day{
+			Date:       day,
+			Kc:         crop.Kc(daysBetween(plan.SowingDate, day)),
+			ET0:        forecastET0,
+			Irrigation: irrigation[day],
+		}
+		if o, ok := observed[day]; ok {
+			d.Rainfall = o.rainfall
+		}
+		forecast = append(forecast, d)
+	}
+	initial := depletion
+	model.Run(initial, forecast, today)
+
+	schedule := &Schedule{
+		FieldID:               fieldID,
+		ID:                    uuid.NewString(),
+		FarmID:                field.FarmID,
+		PlantingPlanID:        plan.ID,
+		CropID:                plan.CropID,
+		ComputedAt:            now.UTC().Truncate(time.Millisecond),
+		TotalAvailableWater:   round(model.TotalAvailableWater()),
+		ReadilyAvailableWater: round(model.ReadilyAvailableWater()),
+		Depletion:             round(initial),
+		MeasuredDays:          len(et0s),
+	}
+	for _, d := range forecast {
+		schedule.Days = append(schedule.Days, Day{
+			Date:        d.Date,
+			ET0:         round(d.ET0),
+			Kc:          round(d.Kc),
+			ETc:         round(d.ETc),
+			Rainfall:    round(d.Rainfall),
+			Irrigation:  round(d.Irrigation),
+			Depletion:   round(d.Depletion),
+			Recommended: round(d.Recommended),
+		})
+	}
+
+	if _, err := s.collection.InsertOne(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to store irrigation schedule: %w", err)
+	}
+	// only older schedules, so that of concurrent computes the latest stays
+	_, err = s.collection.DeleteMany(ctx, bson.D{
+		{Key: "fieldId", Value: fieldID},
+		{Key: "acceptedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "computedAt", Value: bson.D{{Key: "$lt", Value: schedule.ComputedAt}}}},
+			bson.D{{Key: "computedAt", Value: schedule.ComputedAt}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: schedule.ID}}}},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove superseded irrigation schedules: %w", err)
+	}
+	return schedule, nil
+}
+
+// Get returns the latest schedule of fieldID.
+func (s *Scheduler) Get(ctx context.Context, fieldID string) (*Schedule, error) {
+	var schedule Schedule
+	err := s.collection.FindOne(ctx, bson.D{{Key: "fieldId", Value: fieldID}},
+		options.FindOne().SetSort(bson.D{{Key: "computedAt", Value: -1}, {Key: "_id", Value: -1}})).Decode(&schedule)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repoerr.NotFound("fieldId", "no irrigation schedule computed for field %s", fieldID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch irrigation schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// Accept marks the schedule with the given id as accepted by actorID and
+// records the irrigation it recommends for the day of acceptance as an
+// activity of the field. The days after are left to the schedules computed
+// on them, which account for the recorded irrigation. Only the latest
+// schedule of a field can be accepted, and only once; accepted schedules
+// are kept when superseded. The irrigation is checked against the pesticide
+// registry like any activity; a violation that blocks it fails the accept
+// with a *rules.BlockedError.
+//
+// The schedule is claimed before its activity is recorded so that
+// concurrent accepts do not both record it. If recording fails the claim is
+// released; the activity id derives from the schedule and day, so a retry
+// does not record it twice.
+func (s *Scheduler) Accept(ctx context.Context, fieldID, scheduleID, actorID string) (*Schedule, error) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	var schedule Schedule
+	err := s.collection.FindOneAndUpdate(ctx,
+		bson.D{
+			{Key: "_id", Value: scheduleID},
+			{Key: "fieldId", Value: fieldID},
+			{Key: "acceptedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+		},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "acceptedAt", Value: now}, {Key: "acceptedBy", Value: actorID}}}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&schedule)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		current, err := s.Get(ctx, fieldID)
+		if err != nil {
+			return nil, err
+		}
+		if current.ID != scheduleID {
+			return nil, repoerr.Conflict("scheduleId", "schedule %s was superseded by schedule %s", scheduleID, current.ID)
+		}
+		return nil, repoerr.Conflict("scheduleId", "schedule %s was already accepted", scheduleID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept irrigation schedule: %w", err)
+	}
+
+	// a schedule computed before the claim supersedes it
+	current, err := s.Get(ctx, fieldID)
+	if err == nil && current.ID != scheduleID {
+		err = repoerr.Conflict("scheduleId", "schedule %s was superseded by schedule %s", scheduleID, current.ID)
+	}
+	var ids []string
+	if err == nil {
+		ids, err = s.record(ctx, &schedule, actorID, now)
+	}
+	if err != nil {
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+		defer cancel()
+		_, rerr := s.collection.UpdateOne(releaseCtx,
+			bson.D{{Key: "_id", Value: scheduleID}, {Key: "acceptedAt", Value: now}},
+			bson.D{{Key: "$unset", Value: bson.D{{Key: "acceptedAt", Value: ""}, {Key: "acceptedBy", Value: ""}}}})
+		if rerr != nil {
+			s.logger.Error("Failed to release irrigation schedule", zap.String("schedule_id", scheduleID), zap.Error(rerr))
+		}
+		return nil, err
+	}
+	schedule.ActivityIDs = ids
+	if len(schedule.ActivityIDs) > 0 {
+		_, err = s.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: scheduleID}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "activityIds", Value: schedule.ActivityIDs}}}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to store scheduled activities: %w", err)
+		}
+	}
+	return &schedule, nil
+}
+
+// record appends the irrigation schedule recommends on the day of now to the
+// journal, once and if it complies with the pesticide registry. Irrigations
+// recommended for later days are forecasts and not recorded.
+func (s *Scheduler) record(ctx context.Context, schedule *Schedule, actorID string, now time.Time) ([]string, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	var day *Day
+	for i := range schedule.Days {
+		if schedule.Days[i].Date.Equal(today) {
+			day = &schedule.Days[i]
+		}
+	}
+	if day == nil || day.Recommended <= 0 {
+		return nil, nil
+	}
+
+	scheduleID, err := uuid.Parse(schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("irrigation schedule has an invalid id: %w", err)
+	}
+	field, err := farmrepository.GetField(s.db.WithContext(ctx), schedule.FieldID)
+	if err != nil {
+		return nil, err
+	}
+	// the id is set so that a retry is not checked against itself
+	activity := &journal.Activity{
+		ID:          uuid.NewSHA1(scheduleID, []byte(day.Date.Format(croprepository.DateLayout))).String(),
+		FieldID:     schedule.FieldID,
+		FarmID:      schedule.FarmID,
+		Kind:        journal.KindIrrigation,
+		ActorID:     actorID,
+		PerformedAt: day.Date,
+		Quantity:    day.Recommended,
+		Unit:        "mm",
+		Notes:       "Scheduled by irrigation schedule " + schedule.ID,
+	}
+	violations, err := s.compliance.Record(ctx, activity, field, func() error {
+		_, err := s.journal.AppendOnce(ctx, activity.ID, activity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		s.logger.Warn("Scheduled irrigation breaks the conditions of use of pesticide products",
+			zap.String("schedule_id", schedule.ID), zap.String("activity_id", activity.ID),
+			zap.Int("compliance_warnings", len(violations)))
+	}
+	return []string{activity.ID}, nil
+}
+
+// Run recomputes the schedules of all planted fields every interval until
+// ctx is cancelled, starting right away.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.ComputeAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ComputeAll recomputes the schedules of all fields with a planned or sown
+// crop in the coming days. Fields lacking telemetry are skipped.
+func (s *Scheduler) ComputeAll(ctx context.Context) {
+	now := time.Now()
+	today := now.UTC().Truncate(24 * time.Hour)
+	fieldIDs, err := croprepository.ListActivePlantingFields(s.db.WithContext(ctx), today, today.AddDate(0, 0, ForecastDays))
+	if err != nil {
+		s.logger.Warn("Failed to list planted fields", zap.Error(err))
+		return
+	}
+	computed, skipped, failed := 0, 0, 0
+	for _, fieldID := range fieldIDs {
+		if ctx.Err() != nil {
+			return
+		}
+		_, err := s.Compute(ctx, fieldID, now)
+		switch {
+		case err == nil:
+			computed++
+		case errors.Is(err, repoerr.ErrFailedPrecondition):
+			skipped++
+			s.logger.Debug("Skipped irrigation schedule", zap.String("field_id", fieldID), zap.Error(err))
+		default:
+			failed++
+			s.logger.Warn("Failed to compute irrigation schedule", zap.String("field_id", fieldID), zap.Error(err))
+		}
+	}
+	s.logger.Info("Irrigation schedules computed", zap.Int("computed", computed),
+		zap.Int("skipped", skipped), zap.Int("failed", failed))
+}
+
+// irrigation returns the irrigation recorded in the journal for the days
+// from start to end in mm. Volumes in m³ are spread over the field's area,
+// other units are ignored.
+func (s *Scheduler) irrigation(ctx context.Context, fieldID string, areaHectares float64, start, end time.Time) (map[time.Time]float64, error) {
+	perDay := map[time.Time]float64{}
+	q := pagination.Query{PageSize: pagination.MaxPageSize}
+	for {
+		page, err := s.journal.List(ctx, journal.Filter{
+			FieldID: fieldID,
+			From:    start,
+			To:      end,
+			Kind:    journal.KindIrrigation,
+		}, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.Items {
+			day := a.PerformedAt.UTC().Truncate(24 * time.Hour)
+			switch a.Unit {
+			case "mm":
+				perDay[day] += a.Quantity
+			case "m3", "m³":
+				if areaHectares > 0 {
+					// 1 mm over a hectare is 10 m³
+					perDay[day] += a.Quantity / (areaHectares * 10)
+				}
+			}
+		}
+		if page.NextPageToken == "" {
+			return perDay, nil
+		}
+		q.PageToken = page.NextPageToken
+	}
+}
+
+// observation is what the telemetry of a field tells about a day.
+type observation struct {
+	et0          *float64
+	rainfall     float64
+	waterContent *float64
+}
+
+// observations groups daily summaries by day, estimating the reference
+// evapotranspiration from air temperatures where it was not measured.
+func observations(daily []series.Daily, latitude float64) map[time.Time]*observation {
+	observed := map[time.Time]*observation{}
+	temperatures := map[time.Time]series.Daily{}
+	for _, d := range daily {
+		o, ok := observed[d.Day]
+		if !ok {
+			o = &observation{}
+			observed[d.Day] = o
+		}
+		switch d.Metric {
+		case MetricET0:
+			et0 := d.Sum
+			o.et0 = &et0
+		case MetricRainfall:
+			o.rainfall = d.Sum
+		case MetricSoilMoisture:
+			content := d.Last
+			if content > 1 {
+				content /= 100
+			}
+			o.waterContent = &content
+		case MetricAirTemperature:
+			temperatures[d.Day] = d
+		}
+	}
+	for day, t := range temperatures {
+		// a single reading says nothing about the daily range
+		if o := observed[day]; o.et0 == nil && t.Count > 1 {
+			et0 := balance.Hargreaves(t.Min, t.Max, latitude, day)
+			o.et0 = &et0
+		}
+	}
+	return observed
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// round keeps a tenth of a millimetre.
+func round(v float64) float64 {
+	return math.Round(v*10) / 10
+}
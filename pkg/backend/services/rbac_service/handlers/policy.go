@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+)
+
+// Policy is the access policy of RBACService. Role bindings may be managed
+// by admins and by holders of rbac.manage in the binding's scope.
+func Policy(checker *rbac.Checker) auth.Policy {
+	self := auth.SelfUser(func(req any) string {
+		return req.(interface{ GetUserId() string }).GetUserId()
+	})
+	manage := auth.AnyOf(auth.Admin, checker.Require("rbac.manage", bindingScope))
+
+	return auth.Policy{
+		proto.RBACService_CreateRole_FullMethodName:       auth.Admin,
+		proto.RBACService_ListRoles_FullMethodName:        auth.Authenticated,
+		proto.RBACService_DeleteRole_FullMethodName:       auth.Admin,
+		proto.RBACService_GrantRole_FullMethodName:        manage,
+		proto.RBACService_RevokeRole_FullMethodName:       manage,
+		proto.RBACService_ListRoleBindings_FullMethodName: auth.AnyOf(self, auth.Admin),
+		proto.RBACService_Check_FullMethodName:            auth.AnyOf(self, auth.Admin),
+	}
+}
+
+func bindingScope(req any) rbac.Resource {
+	r, ok := req.(interface {
+		GetScope() proto.Scope
+		GetScopeId() string
+	})
+	if !ok {
+		return rbac.Resource{}
+	}
+	switch r.GetScope() {
+	case proto.Scope_SCOPE_ORGANISATION:
+		return rbac.Resource{OrganisationID: r.GetScopeId()}
+	case proto.Scope_SCOPE_FARM:
+		return rbac.Resource{FarmID: r.GetScopeId()}
+	}
+	return rbac.Resource{}
+}
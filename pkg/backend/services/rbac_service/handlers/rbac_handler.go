@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/repository"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type RBACHandler struct {
+	proto.UnimplementedRBACServiceServer
+	db      *gorm.DB
+	checker *rbac.Checker
+	logger  *zap.Logger
+}
+
+func NewRBACHandler(db *gorm.DB, checker *rbac.Checker, logger *zap.Logger) *RBACHandler {
+	rbacHandler := RBACHandler{
+		db:      db,
+		checker: checker,
+		logger:  logger,
+	}
+	return &rbacHandler
+}
+
+func (h *RBACHandler) CreateRole(ctx context.Context, req *proto.CreateRoleRequest) (*proto.CreateRoleResponse, error) {
+	role := &api.Role{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	id, err := repository.CreateRole(h.db.WithContext(ctx), role, req.GetPermissions())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to create role")
+	}
+	logging.WithContext(ctx, h.logger).Info("Role created", zap.String("role", role.Name), zap.Strings("permissions", req.GetPermissions()))
+
+	return &proto.CreateRoleResponse{
+		Id:      id,
+		Message: "Role created successfully",
+	}, nil
+}
+
+func (h *RBACHandler) ListRoles(ctx context.Context, req *proto.ListRolesRequest) (*proto.ListRolesResponse, error) {
+	roles, err := repository.ListRoles(h.db.WithContext(ctx))
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list roles")
+	}
+
+	var roleList []*proto.Role
+	for _, r := range roles {
+		var permissions []string
+		for _, p := range r.Permissions {
+			permissions = append(permissions, p.Name)
+		}
+		roleList = append(roleList, &proto.Role{
+			Id:          r.ID,
+			Name:        r.Name,
+			Description: r.Description,
+			Permissions: permissions,
+		})
+	}
+
+	return &proto.ListRolesResponse{
+		Roles: roleList,
+	}, nil
+}
+
+func (h *RBACHandler) DeleteRole(ctx context.Context, req *proto.DeleteRoleRequest) (*proto.DeleteRoleResponse, error) {
+	err := repository.DeleteRole(h.db.WithContext(ctx), req.GetName())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete role")
+	}
+	logging.WithContext(ctx, h.logger).Info("Role deleted", zap.String("role", req.GetName()))
+
+	return &proto.DeleteRoleResponse{
+		Message: "Role deleted successfully",
+	}, nil
+}
+
+func (h *RBACHandler) GrantRole(ctx context.Context, req *proto.GrantRoleRequest) (*proto.GrantRoleResponse, error) {
+	binding, err := h.binding(ctx, req.GetUserId(), req.GetRole(), req.GetScope(), req.GetScopeId())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := repository.Grant(h.db.WithContext(ctx), binding)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to grant role")
+	}
+	logging.WithContext(ctx, h.logger).Info("Role granted", bindingFields(req.GetRole(), binding)...)
+
+	return &proto.GrantRoleResponse{
+		Id:      id,
+		Message: "Role granted successfully",
+	}, nil
+}
+
+func (h *RBACHandler) RevokeRole(ctx context.Context, req *proto.RevokeRoleRequest) (*proto.RevokeRoleResponse, error) {
+	binding, err := h.binding(ctx, req.GetUserId(), req.GetRole(), req.GetScope(), req.GetScopeId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = repository.Revoke(h.db.WithContext(ctx), binding.UserID, binding.RoleID, binding.ScopeType, binding.ScopeID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to revoke role")
+	}
+	logging.WithContext(ctx, h.logger).Info("Role revoked", bindingFields(req.GetRole(), binding)...)
+
+	return &proto.RevokeRoleResponse{
+		Message: "Role revoked successfully",
+	}, nil
+}
+
+func (h *RBACHandler) ListRoleBindings(ctx context.Context, req *proto.ListRoleBindingsRequest) (*proto.ListRoleBindingsResponse, error) {
+	userID := req.GetUserId()
+	if userID == "" {
+		userID = auth.FromContext(ctx).UserID
+	}
+
+	bindings, err := repository.ListBindings(h.db.WithContext(ctx), userID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list role bindings")
+	}
+
+	var bindingList []*proto.RoleBinding
+	for _, b := range bindings {
+		bindingList = append(bindingList, &proto.RoleBinding{
+			Id:      b.ID,
+			UserId:  b.UserID,
+			Role:    b.Role.Name,
+			Scope:   scopeToProto(b.ScopeType),
+			ScopeId: b.ScopeID,
+		})
+	}
+
+	return &proto.ListRoleBindingsResponse{
+		Bindings: bindingList,
+	}, nil
+}
+
+func (h *RBACHandler) Check(ctx context.Context, req *proto.CheckRequest) (*proto.CheckResponse, error) {
+	principal := auth.FromContext(ctx)
+	if userID := req.GetUserId(); userID != "" && userID != principal.UserID {
+		// an admin asking on behalf of another user
+		principal = &auth.Principal{UserID: userID}
+	}
+
+	allowed, err := h.checker.Check(ctx, principal, req.GetAction(), rbac.Resource{
+		OrganisationID: req.GetOrganisationId(),
+		FarmID:         req.GetFarmId(),
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to check permission")
+	}
+
+	return &proto.CheckResponse{
+		Allowed: allowed,
+	}, nil
+}
+
+func (h *RBACHandler) binding(ctx context.Context, userID, roleName string, scope proto.Scope, scopeID string) (*api.RoleBinding, error) {
+	scopeType, err := scopeFromProto(scope, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := repository.GetRoleByName(h.db.WithContext(ctx), roleName)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to fetch role")
+	}
+	return &api.RoleBinding{
+		UserID:    userID,
+		RoleID:    role.ID,
+		ScopeType: scopeType,
+		ScopeID:   scopeID,
+	}, nil
+}
+
+func bindingFields(role string, binding *api.RoleBinding) []zap.Field {
+	return []zap.Field{
+		zap.String("user_id", binding.UserID),
+		zap.String("role", role),
+		zap.String("scope", binding.ScopeType),
+		zap.String("scope_id", binding.ScopeID),
+	}
+}
+
+func scopeFromProto(scope proto.Scope, scopeID string) (string, error) {
+	switch scope {
+	case proto.Scope_SCOPE_GLOBAL:
+		if scopeID != "" {
+			return "", grpcerr.InvalidArgument("scopeId", "scopeId must be empty for the global scope")
+		}
+		return api.ScopeGlobal, nil
+	case proto.Scope_SCOPE_ORGANISATION, proto.Scope_SCOPE_FARM:
+		if scopeID == "" {
+			return "", grpcerr.InvalidArgument("scopeId", "scopeId is required for organisation and farm scopes")
+		}
+		if scope == proto.Scope_SCOPE_ORGANISATION {
+			return api.ScopeOrganisation, nil
+		}
+		return api.ScopeFarm, nil
+	}
+	return "", grpcerr.InvalidArgument("scope", fmt.Sprintf("unsupported scope %v", scope))
+}
+
+func scopeToProto(scopeType string) proto.Scope {
+	switch scopeType {
+	case api.ScopeGlobal:
+		return proto.Scope_SCOPE_GLOBAL
+	case api.ScopeOrganisation:
+		return proto.Scope_SCOPE_ORGANISATION
+	case api.ScopeFarm:
+		return proto.Scope_SCOPE_FARM
+	}
+	return proto.Scope_SCOPE_UNSPECIFIED
+}
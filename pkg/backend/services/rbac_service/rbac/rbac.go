@@ -0,0 +1,124 @@
+package rbac
+
+import (
+	"context"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/repository"
+	"gorm.io/gorm"
+)
+
+// Resource locates the object an action is performed on. Empty ids are
+// ignored, so the zero Resource only matches global role bindings.
+type Resource struct {
+	OrganisationID string
+	FarmID         string
+}
+
+// Checker answers authorization questions for the rest of the backend.
+type Checker struct {
+	db *gorm.DB
+}
+
+func NewChecker(db *gorm.DB) *Checker {
+	checker := Checker{
+		db: db,
+	}
+	return &checker
+}
+
+// Check reports whether principal may perform action on resource. Holders of
+// the admin role may do anything.
+func (c *Checker) Check(ctx context.Context, principal *auth.Principal, action string, resource Resource) (bool, error) {
+	if principal == nil {
+		return false, nil
+	}
+	if principal.HasRole(auth.RoleAdmin) {
+		return true, nil
+	}
+	return repository.Allowed(c.db.WithContext(ctx), principal.UserID, action, resource.OrganisationID, resource.FarmID)
+}
+
+// Grants lists where a principal may perform an action. All is set when the
+// action is allowed everywhere, in which case the ids are not filled in.
+type Grants struct {
+	All             bool
+	OrganisationIDs []string
+	FarmIDs         []string
+}
+
+// Grants returns where principal may perform action, for listings that
+// cannot check every item on its own.
+func (c *Checker) Grants(ctx context.Context, principal *auth.Principal, action string) (Grants, error) {
+	if principal == nil {
+		return Grants{}, nil
+	}
+	if principal.HasRole(auth.RoleAdmin) {
+		return Grants{All: true}, nil
+	}
+	scopes, err := repository.GrantingScopes(c.db.WithContext(ctx), principal.UserID, action)
+	if err != nil {
+		return Grants{}, err
+	}
+	var grants Grants
+	for _, s := range scopes {
+		switch s.ScopeType {
+		case api.ScopeGlobal:
+			return Grants{All: true}, nil
+		case api.ScopeOrganisation:
+			grants.OrganisationIDs = append(grants.OrganisationIDs, s.ScopeID)
+		case api.ScopeFarm:
+			grants.FarmIDs = append(grants.FarmIDs, s.ScopeID)
+		}
+	}
+	return grants, nil
+}
+
+// Require returns a policy rule that allows the call when the principal may
+// perform action on the resource that resource extracts from the request.
+// resource may be nil for actions checked against global bindings only,
+// which is the only form allowing streams.
+func (c *Checker) Require(action string, resource func(req any) Resource) auth.Rule {
+	if resource == nil {
+		return c.require(action, func(ctx context.Context, req any) (Resource, error) {
+			return Resource{}, nil
+		})
+	}
+	return c.RequireLookup(action, func(ctx context.Context, req any) (Resource, error) {
+		return resource(req), nil
+	})
+}
+
+// RequireLookup is like Require for resources that have to be loaded, e.g.
+// the farm a field belongs to. Errors of lookup fail the call, so a missing
+// resource is reported as such rather than as a denial. Streams are denied
+// without calling lookup.
+func (c *Checker) RequireLookup(action string, lookup func(ctx context.Context, req any) (Resource, error)) auth.Rule {
+	return c.require(action, func(ctx context.Context, req any) (Resource, error) {
+		if req == nil {
+			return Resource{}, auth.ErrNoRequest
+		}
+		return lookup(ctx, req)
+	})
+}
+
+func (c *Checker) require(action string, lookup func(ctx context.Context, req any) (Resource, error)) auth.Rule {
+	return func(ctx context.Context, p *auth.Principal, req any) error {
+		if err := auth.Authenticated(ctx, p, req); err != nil {
+			return err
+		}
+		res, err := lookup(ctx, req)
+		if err != nil {
+			return err
+		}
+		allowed, err := c.Check(ctx, p, action, res)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return auth.ErrForbidden
+		}
+		return nil
+	}
+}
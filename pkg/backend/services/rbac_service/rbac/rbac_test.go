@@ -0,0 +1,150 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/repository"
+	"gorm.io/gorm"
+)
+
+// TestRequireStreams checks the rules that streaming RPCs, which are
+// authorized without a request, can use. Admins are allowed without
+// querying the bindings, so no database is needed.
+func TestRequireStreams(t *testing.T) {
+	checker := NewChecker(nil)
+	admin := &auth.Principal{UserID: "a1", Roles: []string{auth.RoleAdmin}}
+	lookup := func(ctx context.Context, req any) (Resource, error) {
+		t.Error("lookup called for a stream")
+		return Resource{}, nil
+	}
+	resource := func(req any) Resource {
+		t.Error("resource called for a stream")
+		return Resource{}
+	}
+
+	tests := []struct {
+		name      string
+		rule      auth.Rule
+		principal *auth.Principal
+		want      error
+	}{
+		{"global", checker.Require("farm.read", nil), admin, nil},
+		{"global anonymous", checker.Require("farm.read", nil), nil, auth.ErrUnauthenticated},
+		{"resource", checker.Require("farm.read", resource), admin, auth.ErrNoRequest},
+		{"lookup", checker.RequireLookup("farm.read", lookup), admin, auth.ErrNoRequest},
+		{"lookup anonymous", checker.RequireLookup("farm.read", lookup), nil, auth.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule(context.Background(), tt.principal, nil); !errors.Is(err, tt.want) {
+				t.Errorf("rule() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// principal creates a user holding the built-in role in each of scopes,
+// given as scope type and id pairs.
+func principal(t *testing.T, db *gorm.DB, name, role string, scopes ...string) *auth.Principal {
+	t.Helper()
+	user := api.User{Username: name, Email: name + "@example.com", Password: "x"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	r, err := repository.GetRoleByName(db, role)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(scopes); i += 2 {
+		if _, err := repository.Grant(db, &api.RoleBinding{UserID: user.ID, RoleID: r.ID, ScopeType: scopes[i], ScopeID: scopes[i+1]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &auth.Principal{UserID: user.ID}
+}
+
+func TestChecker(t *testing.T) {
+	db := dbtest.Postgres(t)
+	checker := NewChecker(db)
+	ctx := context.Background()
+
+	worker := principal(t, db, "worker", "field_worker", api.ScopeFarm, "f1", api.ScopeFarm, "f2")
+	owner := principal(t, db, "owner", "owner", api.ScopeOrganisation, "o1")
+	global := principal(t, db, "global", "agronomist", api.ScopeGlobal, "")
+	admin := &auth.Principal{UserID: "a1", Roles: []string{auth.RoleAdmin}}
+
+	checks := []struct {
+		name      string
+		principal *auth.Principal
+		action    string
+		resource  Resource
+		want      bool
+	}{
+		{"anonymous", nil, "farm.read", Resource{}, false},
+		{"admin", admin, "rbac.manage", Resource{FarmID: "f9"}, true},
+		{"farm binding", worker, "activity.write", Resource{OrganisationID: "o1", FarmID: "f2"}, true},
+		{"farm binding on another farm", worker, "activity.write", Resource{OrganisationID: "o1", FarmID: "f3"}, false},
+		{"farm binding on its organisation", worker, "activity.write", Resource{OrganisationID: "o1"}, false},
+		{"wildcard in organisation", owner, "inventory.write", Resource{OrganisationID: "o1", FarmID: "f3"}, true},
+		{"wildcard in another organisation", owner, "inventory.write", Resource{OrganisationID: "o2", FarmID: "f4"}, false},
+		{"global binding", global, "crop.write", Resource{OrganisationID: "o2", FarmID: "f4"}, true},
+		{"global binding without the permission", global, "farm.write", Resource{}, false},
+	}
+	for _, tt := range checks {
+		got, err := checker.Check(ctx, tt.principal, tt.action, tt.resource)
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Check(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	grants := []struct {
+		name      string
+		principal *auth.Principal
+		action    string
+		want      Grants
+	}{
+		{"anonymous", nil, "farm.read", Grants{}},
+		{"admin", admin, "farm.read", Grants{All: true}},
+		{"farm bindings", worker, "farm.read", Grants{FarmIDs: []string{"f1", "f2"}}},
+		{"organisation binding", owner, "farm.read", Grants{OrganisationIDs: []string{"o1"}}},
+		{"global binding", global, "farm.read", Grants{All: true}},
+		{"no binding", worker, "farm.write", Grants{}},
+	}
+	for _, tt := range grants {
+		got, err := checker.Grants(ctx, tt.principal, tt.action)
+		if err != nil {
+			t.Fatalf("Grants() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Grants(%s) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	rule := checker.RequireLookup("activity.write", func(ctx context.Context, req any) (Resource, error) {
+		return Resource{FarmID: req.(string)}, nil
+	})
+	rules := []struct {
+		name      string
+		principal *auth.Principal
+		req       string
+		want      error
+	}{
+		{"allowed", worker, "f1", nil},
+		{"forbidden", worker, "f3", auth.ErrForbidden},
+		{"anonymous", nil, "f1", auth.ErrUnauthenticated},
+	}
+	for _, tt := range rules {
+		if err := rule(ctx, tt.principal, tt.req); !errors.Is(err, tt.want) {
+			t.Errorf("RequireLookup(%s) = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
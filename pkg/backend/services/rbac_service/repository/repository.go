@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRole stores role together with its permissions, creating permissions
+// that do not exist yet.
+func CreateRole(db *gorm.DB, role *api.Role, permissions []string) (string, error) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		perms, err := ensurePermissions(tx, permissions)
+		if err != nil {
+			return err
+		}
+		role.Permissions = perms
+		if err := tx.Create(role).Error; err != nil {
+			return repoerr.FromGorm(err, "failed to insert role")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return role.ID, nil
+}
+
+func ensurePermissions(tx *gorm.DB, names []string) ([]api.Permission, error) {
+	perms := make([]api.Permission, 0, len(names))
+	for _, name := range names {
+		perm := api.Permission{Name: name}
+		if err := tx.Where(perm).FirstOrCreate(&perm).Error; err != nil {
+			return nil, fmt.Errorf("failed to ensure permission %s: %w", name, err)
+		}
+		perms = append(perms, perm)
+	}
+	return perms, nil
+}
+
+func ListRoles(db *gorm.DB) ([]*api.Role, error) {
+	var roles []*api.Role
+	result := db.Preload("Permissions").Order("name").Find(&roles)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to get roles")
+	}
+	return roles, nil
+}
+
+func GetRoleByName(db *gorm.DB, name string) (*api.Role, error) {
+	var role api.Role
+	result := db.First(&role, "name = ?", name)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("role", "role %q not found", name)
+	}
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch role")
+	}
+	return &role, nil
+}
+
+func DeleteRole(db *gorm.DB, name string) error {
+	result := db.Select(clause.Associations).Where("name = ?", name).Delete(&api.Role{})
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to delete role")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("name", "role %q not found", name)
+	}
+	return nil
+}
+
+func Grant(db *gorm.DB, binding *api.RoleBinding) (string, error) {
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(binding)
+	if result.Error != nil {
+		return "", repoerr.FromGorm(result.Error, "failed to grant role")
+	}
+	if result.RowsAffected == 0 {
+		// already granted, return the existing binding
+		var existing api.RoleBinding
+		err := db.Where(&api.RoleBinding{
+			UserID:    binding.UserID,
+			RoleID:    binding.RoleID,
+			ScopeType: binding.ScopeType,
+		}).Where("scope_id = ?", binding.ScopeID).First(&existing).Error
+		if err != nil {
+			return "", repoerr.FromGorm(err, "failed to fetch role binding")
+		}
+		return existing.ID, nil
+	}
+	return binding.ID, nil
+}
+
+func Revoke(db *gorm.DB, userID, roleID, scopeType, scopeID string) error {
+	result := db.Where("user_id = ? AND role_id = ? AND scope_type = ? AND scope_id = ?", userID, roleID, scopeType, scopeID).
+		Delete(&api.RoleBinding{})
+	if result.Error != nil {
+		return repoerr.FromGorm(result.Error, "failed to revoke role")
+	}
+	if result.RowsAffected == 0 {
+		return repoerr.NotFound("userId", "no role binding found for user: %s", userID)
+	}
+	return nil
+}
+
+func ListBindings(db *gorm.DB, userID string) ([]*api.RoleBinding, error) {
+	var bindings []*api.RoleBinding
+	result := db.Preload("Role").Where("user_id = ?", userID).Order("created_at").Find(&bindings)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to get role bindings")
+	}
+	return bindings, nil
+}
+
+// Allowed reports whether any role bound to userID grants action in the
+// global scope, in organisationID or in farmID. Permissions named "*" or
+// "<resource>.*" match every action, respectively every action on resource.
+func Allowed(db *gorm.DB, userID, action, organisationID, farmID string) (bool, error) {
+	names := permissionNames(action)
+	scopes := db.Session(&gorm.Session{NewDB: true}).Where("rb.scope_type = ?", api.ScopeGlobal)
+	if organisationID != "" {
+		scopes = scopes.Or("rb.scope_type = ? AND rb.scope_id = ?", api.ScopeOrganisation, organisationID)
+	}
+	if farmID != "" {
+		scopes = scopes.Or("rb.scope_type = ? AND rb.scope_id = ?", api.ScopeFarm, farmID)
+	}
+
+	var count int64
+	result := db.Table("role_bindings AS rb").
+		Joins("JOIN role_permissions rp ON rp.role_id = rb.role_id").
+		Joins("JOIN permissions p ON p.id = rp.permission_id").
+		Where("rb.user_id = ? AND p.name IN ?", userID, names).
+		Where(scopes).
+		Count(&count)
+	if result.Error != nil {
+		return false, repoerr.FromGorm(result.Error, "failed to check permission")
+	}
+	return count > 0, nil
+}
+
+// BoundScope is a scope in which a user holds a permission.
+type BoundScope struct {
+	ScopeType string
+	ScopeID   string
+}
+
+// GrantingScopes returns the scopes in which any role bound to userID grants
+// action, ordered by type and id.
+func GrantingScopes(db *gorm.DB, userID, action string) ([]BoundScope, error) {
+	var scopes []BoundScope
+	result := db.Table("role_bindings AS rb").
+		Distinct("rb.scope_type", "rb.scope_id").
+		Joins("JOIN role_permissions rp ON rp.role_id = rb.role_id").
+		Joins("JOIN permissions p ON p.id = rp.permission_id").
+		Where("rb.user_id = ? AND p.name IN ?", userID, permissionNames(action)).
+		Order("rb.scope_type, rb.scope_id").
+		Scan(&scopes)
+	if result.Error != nil {
+		return nil, repoerr.FromGorm(result.Error, "failed to list permission scopes")
+	}
+	return scopes, nil
+}
+
+func permissionNames(action string) []string {
+	names := []string{action, "*"}
+	if resource, _, ok := strings.Cut(action, "."); ok {
+		names = append(names, resource+".*")
+	}
+	return names
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// recorder is a database/sql connector answering every query with a zero
+// count and recording the queries run.
+type recorder struct {
+	queries []string
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return &conn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+type conn struct{ r *recorder }
+
+func (c *conn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *conn) Close() error                        { return nil }
+func (c *conn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (c *conn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.r.queries = append(c.r.queries, query)
+	return &rows{values: [][]driver.Value{{int64(0)}}}, nil
+}
+
+type rows struct {
+	values [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return []string{"count"} }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+// TestAllowedScopes checks that the scope alternatives are grouped, so that
+// a binding of another user in a matching scope never grants the action.
+func TestAllowedScopes(t *testing.T) {
+	const prefix = `SELECT count(*) FROM role_bindings AS rb JOIN role_permissions rp ON rp.role_id = rb.role_id ` +
+		`JOIN permissions p ON p.id = rp.permission_id WHERE (rb.user_id = $1 AND p.name IN ($2,$3,$4)) AND `
+	tests := []struct {
+		name           string
+		organisationID string
+		farmID         string
+		want           string
+	}{
+		{"global", "", "", `rb.scope_type = $5`},
+		{"organisation", "o1", "", `(rb.scope_type = $5 OR (rb.scope_type = $6 AND rb.scope_id = $7))`},
+		{"farm", "", "f1", `(rb.scope_type = $5 OR (rb.scope_type = $6 AND rb.scope_id = $7))`},
+		{"organisation and farm", "o1", "f1", `(rb.scope_type = $5 OR (rb.scope_type = $6 AND rb.scope_id = $7) OR (rb.scope_type = $8 AND rb.scope_id = $9))`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(r)}), &gorm.Config{Logger: logger.Discard})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Allowed(db, "u1", "farm.read", tt.organisationID, tt.farmID); err != nil {
+				t.Fatalf("Allowed() error = %v", err)
+			}
+			if want := []string{prefix + tt.want}; !reflect.DeepEqual(r.queries, want) {
+				t.Errorf("Allowed() ran\n%q\nwant\n%q", r.queries, want)
+			}
+		})
+	}
+}
+
+func TestPermissionNames(t *testing.T) {
+	tests := []struct {
+		action string
+		want   []string
+	}{
+		{"farm.read", []string{"farm.read", "*", "farm.*"}},
+		{"rbac.manage", []string{"rbac.manage", "*", "rbac.*"}},
+		{"audit", []string{"audit", "*"}},
+	}
+	for _, tt := range tests {
+		if got := permissionNames(tt.action); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("permissionNames(%q) = %q, want %q", tt.action, got, tt.want)
+		}
+	}
+}
+
+// bindings sets up users holding roles in the scopes of two organisations
+// and their farms, returning the ids of the users by name.
+func bindings(t *testing.T, db *gorm.DB) map[string]string {
+	t.Helper()
+	roles := map[string][]string{
+		"reader":    {"farm.read"},
+		"farmhand":  {"field.*"},
+		"superuser": {"*"},
+	}
+	roleIDs := map[string]string{}
+	for name, permissions := range roles {
+		id, err := CreateRole(db, &api.Role{Name: name}, permissions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roleIDs[name] = id
+	}
+
+	users := map[string]string{}
+	for _, b := range []struct {
+		user, role, scopeType, scopeID string
+	}{
+		{"farm reader", "reader", api.ScopeFarm, "f1"},
+		{"organisation farmhand", "farmhand", api.ScopeOrganisation, "o1"},
+		{"superuser", "superuser", api.ScopeGlobal, ""},
+		{"unbound", "", "", ""},
+	} {
+		user := api.User{Username: strings.ReplaceAll(b.user, " ", "_"), Email: strings.ReplaceAll(b.user, " ", ".") + "@example.com", Password: "x"}
+		if err := db.Create(&user).Error; err != nil {
+			t.Fatal(err)
+		}
+		users[b.user] = user.ID
+		if b.role == "" {
+			continue
+		}
+		if _, err := Grant(db, &api.RoleBinding{UserID: user.ID, RoleID: roleIDs[b.role], ScopeType: b.scopeType, ScopeID: b.scopeID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return users
+}
+
+func TestAllowed(t *testing.T) {
+	db := dbtest.Postgres(t)
+	users := bindings(t, db)
+
+	tests := []struct {
+		user           string
+		action         string
+		organisationID string
+		farmID         string
+		want           bool
+	}{
+		{"farm reader", "farm.read", "o1", "f1", true},
+		{"farm reader", "farm.read", "", "f1", true},
+		{"farm reader", "farm.read", "o1", "f2", false},
+		{"farm reader", "farm.read", "o1", "", false},
+		{"farm reader", "farm.read", "", "", false},
+		{"farm reader", "farm.write", "o1", "f1", false},
+		{"organisation farmhand", "field.write", "o1", "f2", true},
+		{"organisation farmhand", "field.read", "o1", "", true},
+		{"organisation farmhand", "field.write", "o2", "f3", false},
+		// ids only match bindings of their own scope type
+		{"organisation farmhand", "field.write", "o2", "o1", false},
+		{"organisation farmhand", "farm.read", "o1", "f1", false},
+		{"organisation farmhand", "fields.read", "o1", "f1", false},
+		{"superuser", "rbac.manage", "", "", true},
+		{"superuser", "farm.write", "o2", "f3", true},
+		{"unbound", "farm.read", "o1", "f1", false},
+	}
+	for _, tt := range tests {
+		got, err := Allowed(db, users[tt.user], tt.action, tt.organisationID, tt.farmID)
+		if err != nil {
+			t.Fatalf("Allowed() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Allowed(%s, %s, %q, %q) = %v, want %v", tt.user, tt.action, tt.organisationID, tt.farmID, got, tt.want)
+		}
+	}
+}
+
+func TestGrantingScopes(t *testing.T) {
+	db := dbtest.Postgres(t)
+	users := bindings(t, db)
+
+	tests := []struct {
+		user   string
+		action string
+		want   []BoundScope
+	}{
+		{"farm reader", "farm.read", []BoundScope{{api.ScopeFarm, "f1"}}},
+		{"farm reader", "farm.write", nil},
+		{"organisation farmhand", "field.write", []BoundScope{{api.ScopeOrganisation, "o1"}}},
+		{"organisation farmhand", "farm.read", nil},
+		{"superuser", "farm.read", []BoundScope{{api.ScopeGlobal, ""}}},
+		{"unbound", "farm.read", nil},
+	}
+	for _, tt := range tests {
+		got, err := GrantingScopes(db, users[tt.user], tt.action)
+		if err != nil {
+			t.Fatalf("GrantingScopes() error = %v", err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("GrantingScopes(%s, %s) = %v, want %v", tt.user, tt.action, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/series"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of TelemetryService. Stream rules do not see
+// the messages, so Ingest checks every batch against the scope of its field,
+// and every device against the field it last reported for, itself.
+func Policy(db *gorm.DB, checker *rbac.Checker, store *series.Store) auth.Policy {
+	return auth.Policy{
+		proto.TelemetryService_Ingest_FullMethodName:         auth.Authenticated,
+		proto.TelemetryService_GetDeviceState_FullMethodName: checker.RequireLookup("telemetry.read", deviceResource(db, store)),
+	}
+}
+
+// deviceResource locates a device by the field it last reported for.
+// Devices without a field are checked against global bindings.
+func deviceResource(db *gorm.DB, store *series.Store) func(ctx context.Context, req any) (rbac.Resource, error) {
+	return func(ctx context.Context, req any) (rbac.Resource, error) {
+		if store == nil {
+			return rbac.Resource{}, errNoStore
+		}
+		state, err := store.DeviceState(ctx, req.(*proto.GetDeviceStateRequest).GetDeviceId())
+		if err != nil {
+			return rbac.Resource{}, err
+		}
+		if state.FieldID == "" {
+			return rbac.Resource{}, nil
+		}
+		return farmhandlers.FieldResource(db, func(any) string { return state.FieldID })(ctx, req)
+	}
+}
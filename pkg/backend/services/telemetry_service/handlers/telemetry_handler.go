@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/series"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// flushSize is the number of buffered readings of a device that are
+	// written at once.
+	flushSize = 500
+	// flushTimeout bounds the write of buffered readings after the client
+	// went away.
+	flushTimeout = 10 * time.Second
+)
+
+var errNoStore = status.Error(codes.FailedPrecondition, "telemetry requires MongoDB to be configured")
+
+type TelemetryHandler struct {
+	proto.UnimplementedTelemetryServiceServer
+	db      *gorm.DB
+	checker *rbac.Checker
+	store   *series.Store
+	logger  *zap.Logger
+}
+
+// NewTelemetryHandler returns the TelemetryService handler. store is nil
+// when MongoDB is not configured, every call then fails with
+// FailedPrecondition.
+func NewTelemetryHandler(db *gorm.DB, checker *rbac.Checker, store *series.Store, logger *zap.Logger) *TelemetryHandler {
+	telemetryHandler := TelemetryHandler{
+		db:      db,
+		checker: checker,
+		store:   store,
+		logger:  logger,
+	}
+	return &telemetryHandler
+}
+
+// buffer holds the readings of a device that are not written yet.
+type buffer struct {
+	fieldID  string
+	readings []series.Reading
+}
+
+// ingest is the state of one Ingest stream.
+type ingest struct {
+	h       *TelemetryHandler
+	buffers map[string]*buffer
+	// allowed caches the authorization of the fields written to
+	allowed  map[string]bool
+	acks     map[string]int64
+	devices  []string
+	response proto.IngestResponse
+}
+
+func (h *TelemetryHandler) Ingest(stream proto.TelemetryService_IngestServer) error {
+	if h.store == nil {
+		return errNoStore
+	}
+	ctx := stream.Context()
+	in := &ingest{
+		h:       h,
+		buffers: map[string]*buffer{},
+		allowed: map[string]bool{},
+		acks:    map[string]int64{},
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// keeps what was received, the device resumes after it
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+			defer cancel()
+			if ferr := in.flushAll(flushCtx); ferr != nil {
+				logging.WithContext(ctx, h.logger).Warn("Failed to write readings of an interrupted stream", zap.Error(ferr))
+			}
+			return err
+		}
+		if err := in.add(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	if err := in.flushAll(ctx); err != nil {
+		return err
+	}
+	for _, deviceID := range in.devices {
+		in.response.Acks = append(in.response.Acks, &proto.DeviceAck{DeviceId: deviceID, LastSequence: in.acks[deviceID]})
+	}
+	logging.WithContext(ctx, h.logger).Info("Telemetry ingested", zap.Int("devices", len(in.devices)),
+		zap.Int64("received", in.response.Received), zap.Int64("stored", in.response.Stored),
+		zap.Int64("duplicates", in.response.Duplicates))
+	return stream.SendAndClose(&in.response)
+}
+
+func (in *ingest) add(ctx context.Context, req *proto.IngestRequest) error {
+	if err := in.authorize(ctx, req.GetFieldId()); err != nil {
+		return err
+	}
+	deviceID := req.GetDeviceId()
+	buf, ok := in.buffers[deviceID]
+	if !ok {
+		if err := in.authorizeDevice(ctx, deviceID); err != nil {
+			return err
+		}
+		buf = &buffer{fieldID: req.GetFieldId()}
+		in.buffers[deviceID] = buf
+		in.devices = append(in.devices, deviceID)
+	} else if buf.fieldID != req.GetFieldId() {
+		// readings are written with the field of their batch
+		if err := in.flush(ctx, deviceID); err != nil {
+			return err
+		}
+		buf.fieldID = req.GetFieldId()
+	}
+
+	for _, r := range req.GetReadings() {
+		buf.readings = append(buf.readings, series.Reading{
+			Timestamp: time.UnixMilli(r.GetTimestamp()),
+			Meta:      series.Meta{Metric: r.GetMetric(), Unit: r.GetUnit()},
+			Value:     r.GetValue(),
+			Sequence:  r.GetSequence(),
+		})
+	}
+	in.response.Received += int64(len(req.GetReadings()))
+	if len(buf.readings) >= flushSize {
+		return in.flush(ctx, deviceID)
+	}
+	return nil
+}
+
+// authorize checks that the caller may write telemetry for fieldID, or
+// everywhere when no field is given.
+func (in *ingest) authorize(ctx context.Context, fieldID string) error {
+	if allowed, ok := in.allowed[fieldID]; ok {
+		if !allowed {
+			return status.Error(codes.PermissionDenied, auth.ErrForbidden.Error())
+		}
+		return nil
+	}
+	var res rbac.Resource
+	if fieldID != "" {
+		var err error
+		res, err = farmhandlers.FieldResource(in.h.db, func(any) string { return fieldID })(ctx, nil)
+		if err != nil {
+			return grpcerr.FromError(err, "failed to authorize")
+		}
+	}
+	allowed, err := in.h.checker.Check(ctx, auth.FromContext(ctx), "telemetry.write", res)
+	if err != nil {
+		return grpcerr.FromError(err, "failed to authorize")
+	}
+	in.allowed[fieldID] = allowed
+	if !allowed {
+		return status.Error(codes.PermissionDenied, auth.ErrForbidden.Error())
+	}
+	return nil
+}
+
+// authorizeDevice checks that the caller may also write telemetry for the
+// field deviceID last reported for, so that the sequence and field of a
+// device cannot be taken over from another farm. Devices that never sent
+// readings are claimed by their first writer.
+func (in *ingest) authorizeDevice(ctx context.Context, deviceID string) error {
+	state, err := in.h.store.DeviceState(ctx, deviceID)
+	if err != nil {
+		return grpcerr.FromError(err, "failed to get device state")
+	}
+	if state.UpdatedAt.IsZero() {
+		return nil
+	}
+	return in.authorize(ctx, state.FieldID)
+}
+
+func (in *ingest) flush(ctx context.Context, deviceID string) error {
+	buf := in.buffers[deviceID]
+	if len(buf.readings) == 0 {
+		return nil
+	}
+	result, err := in.h.store.Write(ctx, deviceID, buf.fieldID, buf.readings)
+	if err != nil {
+		return grpcerr.FromError(err, "failed to write readings")
+	}
+	buf.readings = buf.readings[:0]
+	in.acks[deviceID] = result.LastSequence
+	in.response.Stored += int64(result.Stored)
+	in.response.Duplicates += int64(result.Duplicates)
+	return nil
+}
+
+func (in *ingest) flushAll(ctx context.Context) error {
+	for _, deviceID := range in.devices {
+		if err := in.flush(ctx, deviceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *TelemetryHandler) GetDeviceState(ctx context.Context, req *proto.GetDeviceStateRequest) (*proto.GetDeviceStateResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	state, err := h.store.DeviceState(ctx, req.GetDeviceId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get device state")
+	}
+	response := &proto.GetDeviceStateResponse{
+		DeviceId:     state.DeviceID,
+		LastSequence: state.LastSequence,
+		FieldId:      state.FieldID,
+	}
+	if !state.UpdatedAt.IsZero() {
+		response.UpdatedAt = state.UpdatedAt.UnixMilli()
+	}
+	return response, nil
+}
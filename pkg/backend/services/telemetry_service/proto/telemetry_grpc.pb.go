@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: telemetry.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TelemetryService_Ingest_FullMethodName         = "/telemetry.TelemetryService/Ingest"
+	TelemetryService_GetDeviceState_FullMethodName = "/telemetry.TelemetryService/GetDeviceState"
+)
+
+// TelemetryServiceClient is the client API for TelemetryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelemetryServiceClient interface {
+	// Ingest receives batches of readings until the client closes the stream
+	// and acknowledges, per device, the last sequence number persisted. A
+	// device that lost its stream asks GetDeviceState where to resume.
+	Ingest(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestRequest, IngestResponse], error)
+	GetDeviceState(ctx context.Context, in *GetDeviceStateRequest, opts ...grpc.CallOption) (*GetDeviceStateResponse, error)
+}
+
+type telemetryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryServiceClient(cc grpc.ClientConnInterface) TelemetryServiceClient {
+	return &telemetryServiceClient{cc}
+}
+
+func (c *telemetryServiceClient) Ingest(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestRequest, IngestResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TelemetryService_ServiceDesc.Streams[0], TelemetryService_Ingest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IngestRequest, IngestResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_IngestClient = grpc.ClientStreamingClient[IngestRequest, IngestResponse]
+
+func (c *telemetryServiceClient) GetDeviceState(ctx context.Context, in *GetDeviceStateRequest, opts ...grpc.CallOption) (*GetDeviceStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeviceStateResponse)
+	err := c.cc.Invoke(ctx, TelemetryService_GetDeviceState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryServiceServer is the server API for TelemetryService service.
+// All implementations must embed UnimplementedTelemetryServiceServer
+// for forward compatibility.
+type TelemetryServiceServer interface {
+	// Ingest receives batches of readings until the client closes the stream
+	// and acknowledges, per device, the last sequence number persisted. A
+	// device that lost its stream asks GetDeviceState where to resume.
+	Ingest(grpc.ClientStreamingServer[IngestRequest, IngestResponse]) error
+	GetDeviceState(context.Context, *GetDeviceStateRequest) (*GetDeviceStateResponse, error)
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+// UnimplementedTelemetryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTelemetryServiceServer struct{}
+
+func (UnimplementedTelemetryServiceServer) Ingest(grpc.ClientStreamingServer[IngestRequest, IngestResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Ingest not implemented")
+}
+func (UnimplementedTelemetryServiceServer) GetDeviceState(context.Context, *GetDeviceStateRequest) (*GetDeviceStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceState not implemented")
+}
+func (UnimplementedTelemetryServiceServer) mustEmbedUnimplementedTelemetryServiceServer() {}
+func (UnimplementedTelemetryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTelemetryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryServiceServer will
+// result in compilation errors.
+type UnsafeTelemetryServiceServer interface {
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+func RegisterTelemetryServiceServer(s grpc.ServiceRegistrar, srv TelemetryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTelemetryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TelemetryService_ServiceDesc, srv)
+}
+
+func _TelemetryService_Ingest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TelemetryServiceServer).Ingest(&grpc.GenericServerStream[IngestRequest, IngestResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_IngestServer = grpc.ClientStreamingServer[IngestRequest, IngestResponse]
+
+func _TelemetryService_GetDeviceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetDeviceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelemetryService_GetDeviceState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetDeviceState(ctx, req.(*GetDeviceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TelemetryService_ServiceDesc is the grpc.ServiceDesc for TelemetryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelemetryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "telemetry.TelemetryService",
+	HandlerType: (*TelemetryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeviceState",
+			Handler:    _TelemetryService_GetDeviceState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Ingest",
+			Handler:       _TelemetryService_Ingest_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "telemetry.proto",
+}
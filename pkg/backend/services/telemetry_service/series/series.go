@@ -0,0 +1,317 @@
+// Package series stores sensor readings in a MongoDB time series collection
+// and tracks, per device, the sequence number up to which its readings are
+// persisted so that devices can resume sending after a disconnect.
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ReadingsCollection = "telemetry_readings"
+	KeysCollection     = "telemetry_reading_keys"
+	DevicesCollection  = "telemetry_devices"
+)
+
+// Store is the telemetry of all devices.
+type Store struct {
+	store    *storage.Storage
+	readings *mongo.Collection
+	keys     *mongo.Collection
+	devices  *mongo.Collection
+}
+
+func NewStore(store *storage.Storage) *Store {
+	s := Store{
+		store:    store,
+		readings: store.GetCollection(ReadingsCollection),
+		keys:     store.GetCollection(KeysCollection),
+		devices:  store.GetCollection(DevicesCollection),
+	}
+	return &s
+}
+
+// Meta identifies the series a reading belongs to.
+type Meta struct {
+	DeviceID string `bson:"deviceId"`
+	// FieldID is the field the device was reporting for, if any.
+	FieldID string `bson:"fieldId,omitempty"`
+	Metric  string `bson:"metric"`
+	Unit    string `bson:"unit"`
+}
+
+// Reading is a single measurement. Sequence is assigned by the device and
+// increases with every reading it sends.
+type Reading struct {
+	Timestamp time.Time `bson:"timestamp"`
+	Meta      Meta      `bson:"meta"`
+	Value     float64   `bson:"value"`
+	Sequence  int64     `bson:"sequence"`
+}
+
+// DeviceState is what is known about a device's stream of readings.
+type DeviceState struct {
+	DeviceID string `bson:"_id"`
+	// LastSequence is the highest sequence number persisted, readings up to
+	// it need not be sent again.
+	LastSequence int64     `bson:"lastSequence"`
+	FieldID      string    `bson:"fieldId,omitempty"`
+	UpdatedAt    time.Time `bson:"updatedAt"`
+}
+
+// EnsureCollections creates the time series collection of readings and the
+// indexes the writes and summaries rely on, among them the unique index that
+// keeps readings from being stored twice. Time series collections need MongoDB 5.0.
+func (s *Store) EnsureCollections(ctx context.Context) error {
+	err := s.store.CreateCollection(ctx, ReadingsCollection, options.CreateCollection().
+		SetTimeSeriesOptions(options.TimeSeries().
+			SetTimeField("timestamp").
+			SetMetaField("meta").
+			SetGranularity("minutes")))
+	if err != nil {
+		return fmt.Errorf("failed to create readings collection: %w", err)
+	}
+	_, err = s.readings.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "meta.deviceId", Value: 1}, {Key: "timestamp", Value: 1}}},
+		{Keys: bson.D{{Key: "meta.fieldId", Value: 1}, {Key: "meta.metric", Value: 1}, {Key: "timestamp", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create readings indexes: %w", err)
+	}
+	_, err = s.keys.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "deviceId", Value: 1}, {Key: "metric", Value: 1}, {Key: "timestamp", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create reading keys index: %w", err)
+	}
+	return nil
+}
+
+// DeviceState returns the state of the device with the given id, the zero
+// state for devices that never sent readings.
+func (s *Store) DeviceState(ctx context.Context, deviceID string) (*DeviceState, error) {
+	state := DeviceState{DeviceID: deviceID}
+	err := s.devices.FindOne(ctx, bson.D{{Key: "_id", Value: deviceID}}).Decode(&state)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to fetch device state: %w", err)
+	}
+	return &state, nil
+}
+
+// Result summarises a Write.
+type Result struct {
+	Stored     int
+	Duplicates int
+	// LastSequence is the device's last persisted sequence number after the
+	// write.
+	LastSequence int64
+}
+
+// readingKey identifies a reading of a device. Time series collections
+// cannot have unique indexes, so the key of every stored reading is kept in
+// KeysCollection, where its unique index lets one of several writes of the
+// same reading claim it.
+type readingKey struct {
+	DeviceID  string    `bson:"deviceId"`
+	Metric    string    `bson:"metric"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+// Write persists the readings of deviceID. Readings at or below the
+// device's last sequence number and readings of a metric at a timestamp
+// already stored for the device are dropped as duplicates, also when
+// written concurrently, e.g. by the flush of an interrupted stream and the
+// stream the device resumed on.
+func (s *Store) Write(ctx context.Context, deviceID, fieldID string, readings []Reading) (*Result, error) {
+	state, err := s.DeviceState(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	fresh, result := dedup(state.LastSequence, readings)
+	if len(fresh) > 0 {
+		claimed, err := s.claim(ctx, deviceID, fresh)
+		if err != nil {
+			return nil, err
+		}
+		docs := make([]any, 0, len(fresh))
+		keys := make([]any, 0, len(fresh))
+		for i, r := range fresh {
+			if !claimed[i] {
+				result.Duplicates++
+				continue
+			}
+			r.Meta.DeviceID = deviceID
+			r.Meta.FieldID = fieldID
+			docs = append(docs, r)
+			keys = append(keys, readingKey{deviceID, r.Meta.Metric, r.Timestamp})
+		}
+		if len(docs) > 0 {
+			if _, err := s.readings.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false)); err != nil {
+				// released so that the readings are stored when sent again
+				if _, derr := s.keys.DeleteMany(context.WithoutCancel(ctx), bson.D{{Key: "$or", Value: keys}}); derr != nil {
+					err = errors.Join(err, derr)
+				}
+				return nil, fmt.Errorf("failed to store readings: %w", err)
+			}
+		}
+		result.Stored = len(docs)
+	}
+
+	if result.LastSequence > state.LastSequence {
+		set := bson.D{{Key: "updatedAt", Value: time.Now()}}
+		if fieldID != "" {
+			set = append(set, bson.E{Key: "fieldId", Value: fieldID})
+		}
+		_, err := s.devices.UpdateOne(ctx, bson.D{{Key: "_id", Value: deviceID}}, bson.D{
+			{Key: "$max", Value: bson.D{{Key: "lastSequence", Value: result.LastSequence}}},
+			{Key: "$set", Value: set},
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update device state: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// dedup drops the readings at or below lastSequence and repeated readings of
+// a metric at the same timestamp. It returns the remaining readings with
+// their timestamps truncated to milliseconds, as MongoDB stores them.
+func dedup(lastSequence int64, readings []Reading) ([]Reading, *Result) {
+	result := &Result{LastSequence: lastSequence}
+	seen := make(map[readingKey]bool, len(readings))
+	fresh := make([]Reading, 0, len(readings))
+	for _, r := range readings {
+		if r.Sequence > result.LastSequence {
+			result.LastSequence = r.Sequence
+		}
+		r.Timestamp = r.Timestamp.UTC().Truncate(time.Millisecond)
+		k := readingKey{Metric: r.Meta.Metric, Timestamp: r.Timestamp}
+		if r.Sequence <= lastSequence || seen[k] {
+			result.Duplicates++
+			continue
+		}
+		seen[k] = true
+		fresh = append(fresh, r)
+	}
+	return fresh, result
+}
+
+// claim inserts the keys of readings and reports for each reading whether
+// its key was inserted, i.e. whether no other write stored it.
+func (s *Store) claim(ctx context.Context, deviceID string, readings []Reading) ([]bool, error) {
+	keys := make([]any, len(readings))
+	for i, r := range readings {
+		keys[i] = readingKey{deviceID, r.Meta.Metric, r.Timestamp}
+	}
+	_, err := s.keys.InsertMany(ctx, keys, options.InsertMany().SetOrdered(false))
+	claimed, err := claims(len(keys), err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim readings: %w", err)
+	}
+	return claimed, nil
+}
+
+// claims interprets the error of an unordered insert of n keys: the keys
+// rejected as duplicates are not claimed, any other error fails the claim.
+func claims(n int, err error) ([]bool, error) {
+	claimed := make([]bool, n)
+	for i := range claimed {
+		claimed[i] = true
+	}
+	if err == nil {
+		return claimed, nil
+	}
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil {
+		return nil, err
+	}
+	for _, we := range bwe.WriteErrors {
+		if !mongo.IsDuplicateKeyError(we.WriteError) || we.Index < 0 || we.Index >= n {
+			return nil, err
+		}
+		claimed[we.Index] = false
+	}
+	return claimed, nil
+}
+
+// Daily summarises the readings of a metric on one UTC day.
+type Daily struct {
+	Day    time.Time
+	Metric string
+	Min    float64
+	Max    float64
+	Mean   float64
+	Sum    float64
+	// Last is the latest reading of the day.
+	Last  float64
+	Count int
+}
+
+// Daily returns the daily summaries of the given metrics reported for
+// fieldID from from, inclusive, to to, exclusive, ordered by day and metric.
+func (s *Store) Daily(ctx context.Context, fieldID string, metrics []string, from, to time.Time) ([]Daily, error) {
+	cursor, err := s.readings.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "meta.fieldId", Value: fieldID},
+			{Key: "meta.metric", Value: bson.D{{Key: "$in", Value: metrics}}},
+			{Key: "timestamp", Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "day", Value: bson.D{{Key: "$dateTrunc", Value: bson.D{{Key: "date", Value: "$timestamp"}, {Key: "unit", Value: "day"}}}}},
+				{Key: "metric", Value: "$meta.metric"},
+			}},
+			{Key: "min", Value: bson.D{{Key: "$min", Value: "$value"}}},
+			{Key: "max", Value: bson.D{{Key: "$max", Value: "$value"}}},
+			{Key: "mean", Value: bson.D{{Key: "$avg", Value: "$value"}}},
+			{Key: "sum", Value: bson.D{{Key: "$sum", Value: "$value"}}},
+			{Key: "last", Value: bson.D{{Key: "$last", Value: "$value"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.day", Value: 1}, {Key: "_id.metric", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarise readings: %w", err)
+	}
+	var rows []struct {
+		ID struct {
+			Day    time.Time `bson:"day"`
+			Metric string    `bson:"metric"`
+		} `bson:"_id"`
+		Min   float64 `bson:"min"`
+		Max   float64 `bson:"max"`
+		Mean  float64 `bson:"mean"`
+		Sum   float64 `bson:"sum"`
+		Last  float64 `bson:"last"`
+		Count int     `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode reading summaries: %w", err)
+	}
+	days := make([]Daily, 0, len(rows))
+	for _, r := range rows {
+		days = append(days, Daily{
+			Day:    r.ID.Day.UTC(),
+			Metric: r.ID.Metric,
+			Min:    r.Min,
+			Max:    r.Max,
+			Mean:   r.Mean,
+			Sum:    r.Sum,
+			Last:   r.Last,
+			Count:  r.Count,
+		})
+	}
+	return days, nil
+}
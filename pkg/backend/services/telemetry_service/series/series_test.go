@@ -0,0 +1,254 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/dbtest"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var start = time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+
+func reading(minute int, metric string, sequence int64) Reading {
+	return Reading{
+		Timestamp: start.Add(time.Duration(minute) * time.Minute),
+		Meta:      Meta{Metric: metric, Unit: "%"},
+		Value:     float64(minute),
+		Sequence:  sequence,
+	}
+}
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name             string
+		lastSequence     int64
+		readings         []Reading
+		want             []int64
+		wantDuplicates   int
+		wantLastSequence int64
+	}{
+		{
+			name:             "fresh",
+			readings:         []Reading{reading(0, "moisture", 1), reading(0, "temperature", 2), reading(1, "moisture", 3)},
+			want:             []int64{1, 2, 3},
+			wantLastSequence: 3,
+		},
+		{
+			name:             "repeated in the batch",
+			readings:         []Reading{reading(0, "moisture", 1), reading(0, "moisture", 2), reading(1, "moisture", 3)},
+			want:             []int64{1, 3},
+			wantDuplicates:   1,
+			wantLastSequence: 3,
+		},
+		{
+			name: "repeated within a millisecond",
+			readings: []Reading{
+				reading(0, "moisture", 1),
+				{Timestamp: start.Add(500 * time.Microsecond), Meta: Meta{Metric: "moisture"}, Sequence: 2},
+			},
+			want:             []int64{1},
+			wantDuplicates:   1,
+			wantLastSequence: 2,
+		},
+		{
+			name:             "at or below the last sequence",
+			lastSequence:     5,
+			readings:         []Reading{reading(0, "moisture", 4), reading(1, "moisture", 5), reading(2, "moisture", 6)},
+			want:             []int64{6},
+			wantDuplicates:   2,
+			wantLastSequence: 6,
+		},
+		{
+			name:             "all sent before",
+			lastSequence:     5,
+			readings:         []Reading{reading(0, "moisture", 4), reading(1, "moisture", 5)},
+			want:             []int64{},
+			wantDuplicates:   2,
+			wantLastSequence: 5,
+		},
+		{
+			name:             "out of order",
+			lastSequence:     2,
+			readings:         []Reading{reading(4, "moisture", 8), reading(3, "moisture", 7), reading(0, "moisture", 1)},
+			want:             []int64{8, 7},
+			wantDuplicates:   1,
+			wantLastSequence: 8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fresh, result := dedup(tt.lastSequence, tt.readings)
+			got := []int64{}
+			for _, r := range fresh {
+				got = append(got, r.Sequence)
+				if !r.Timestamp.Equal(r.Timestamp.Truncate(time.Millisecond)) || r.Timestamp.Location() != time.UTC {
+					t.Errorf("timestamp %v is not in UTC milliseconds", r.Timestamp)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dedup() kept sequences %v, want %v", got, tt.want)
+			}
+			if result.Duplicates != tt.wantDuplicates || result.LastSequence != tt.wantLastSequence {
+				t.Errorf("dedup() = %d duplicates up to %d, want %d up to %d",
+					result.Duplicates, result.LastSequence, tt.wantDuplicates, tt.wantLastSequence)
+			}
+		})
+	}
+}
+
+func TestClaims(t *testing.T) {
+	duplicate := func(i int) mongo.BulkWriteError {
+		return mongo.BulkWriteError{WriteError: mongo.WriteError{Index: i, Code: 11000, Message: "E11000 duplicate key error"}}
+	}
+	failed := errors.New("connection reset")
+	tests := []struct {
+		name    string
+		err     error
+		want    []bool
+		wantErr bool
+	}{
+		{"all claimed", nil, []bool{true, true, true}, false},
+		{
+			name: "duplicates",
+			err:  mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{duplicate(0), duplicate(2)}},
+			want: []bool{false, true, false},
+		},
+		{
+			name: "other write error",
+			err: mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{
+				duplicate(0), {WriteError: mongo.WriteError{Index: 1, Code: 2, Message: "bad value"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "write concern error",
+			err:     mongo.BulkWriteException{WriteConcernError: &mongo.WriteConcernError{Code: 64}},
+			wantErr: true,
+		},
+		{"not a write error", failed, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := claims(3, tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("claims() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("claims() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newStore(t *testing.T) *Store {
+	t.Helper()
+	s := NewStore(dbtest.Mongo(t))
+	if err := s.EnsureCollections(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func stored(t *testing.T, s *Store, deviceID string) int64 {
+	t.Helper()
+	n, err := s.readings.CountDocuments(context.Background(), bson.D{{Key: "meta.deviceId", Value: deviceID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestWrite(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+
+	result, err := s.Write(ctx, "d1", "f1", []Reading{reading(0, "moisture", 1), reading(0, "moisture", 2), reading(1, "moisture", 3)})
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if want := (Result{Stored: 2, Duplicates: 1, LastSequence: 3}); *result != want {
+		t.Errorf("Write() = %+v, want %+v", *result, want)
+	}
+
+	// resent after a reconnect, the device's sequence restarted for the last one
+	result, err = s.Write(ctx, "d1", "f1", []Reading{reading(0, "moisture", 2), reading(1, "moisture", 3), reading(1, "moisture", 4), reading(2, "moisture", 5)})
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if want := (Result{Stored: 1, Duplicates: 3, LastSequence: 5}); *result != want {
+		t.Errorf("Write() of a resend = %+v, want %+v", *result, want)
+	}
+
+	// the same time and metric of another device is no duplicate
+	if _, err := s.Write(ctx, "d2", "f1", []Reading{reading(0, "moisture", 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if n := stored(t, s, "d1"); n != 3 {
+		t.Errorf("stored %d readings of d1, want 3", n)
+	}
+	state, err := s.DeviceState(ctx, "d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.LastSequence != 5 || state.FieldID != "f1" {
+		t.Errorf("DeviceState() = %+v, want sequence 5 for f1", state)
+	}
+}
+
+func TestOverlappingWrites(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+
+	// as the flush of an interrupted stream and the stream resumed after it
+	const writers = 4
+	var batches [writers][]Reading
+	for w := range batches {
+		for i := 0; i < 40; i++ {
+			minute := w*10 + i
+			batches[w] = append(batches[w], reading(minute, "moisture", int64(minute+1)))
+		}
+	}
+	var wg sync.WaitGroup
+	results := make([]*Result, writers)
+	errs := make([]error, writers)
+	for w := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[w], errs[w] = s.Write(ctx, "d1", "f1", batches[w])
+		}()
+	}
+	wg.Wait()
+
+	storedTotal := 0
+	for w, err := range errs {
+		if err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		storedTotal += results[w].Stored
+		if results[w].Stored+results[w].Duplicates != len(batches[w]) {
+			t.Errorf("Write() = %+v, want %d readings accounted for", *results[w], len(batches[w]))
+		}
+	}
+	// minutes 0 to 69
+	if storedTotal != 70 {
+		t.Errorf("writes reported %d readings stored, want 70", storedTotal)
+	}
+	if n := stored(t, s, "d1"); n != 70 {
+		t.Errorf("stored %d readings, want 70", n)
+	}
+	state, err := s.DeviceState(ctx, "d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.LastSequence != 70 {
+		t.Errorf("LastSequence = %d, want 70", state.LastSequence)
+	}
+}
@@ -0,0 +1,45 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/password"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/repository"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Check looks up the user by username or email and verifies plain against the
+// stored hash. A hash produced with outdated parameters, or a password stored
+// in plain text, is replaced on success. A stored hash that cannot be decoded
+// never matches.
+func Check(db *gorm.DB, login, plain string) (*api.User, error) {
+	user, err := repository.GetByLogin(db, login)
+	if errors.Is(err, repoerr.ErrNotFound) {
+		// hash anyway so unknown logins take as long as wrong passwords
+		_, _ = password.Hash(plain)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	match, needsRehash, err := password.Verify(plain, user.Password)
+	if err != nil || !match {
+		return nil, ErrInvalidCredentials
+	}
+	if needsRehash {
+		hash, err := password.Hash(plain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rehash password: %w", err)
+		}
+		if err := repository.Update(db, user.ID, &api.User{Password: hash}); err != nil {
+			return nil, fmt.Errorf("failed to store rehashed password: %w", err)
+		}
+		user.Password = hash
+	}
+	return user, nil
+}
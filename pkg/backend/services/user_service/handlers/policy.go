@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/proto"
+)
+
+// Policy is the access policy of UserService.
+var Policy = auth.Policy{
+	proto.UserService_CreateUser_FullMethodName:     auth.Public,
+	proto.UserService_GetUserById_FullMethodName:    auth.Authenticated,
+	proto.UserService_GetUsers_FullMethodName:       auth.Admin,
+	proto.UserService_UpdateUser_FullMethodName:     auth.AnyOf(auth.Self, auth.Admin),
+	proto.UserService_DeleteUser_FullMethodName:     auth.Admin,
+	proto.UserService_VerifyPassword_FullMethodName: auth.Public,
+}
@@ -2,38 +2,55 @@ package handlers
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"time"
 
 	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/password"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/credentials"
 	"github.com/aburifat/go-agro/pkg/backend/services/user_service/proto"
 	"github.com/aburifat/go-agro/pkg/backend/services/user_service/repository"
 
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 type UserHandler struct {
 	proto.UnimplementedUserServiceServer
-	db *gorm.DB
+	db     *gorm.DB
+	logger *zap.Logger
 }
 
-func NewUserHandler(db *gorm.DB) *UserHandler {
+func NewUserHandler(db *gorm.DB, logger *zap.Logger) *UserHandler {
 	userHandler := UserHandler{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 	return &userHandler
 }
 
 func (h *UserHandler) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.CreateUserResponse, error) {
+	hash, err := password.Hash(req.GetPassword())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
 	user := &api.User{
 		Username: req.GetUsername(),
 		Email:    req.GetEmail(),
-		Password: req.GetPassword(),
+		Password: hash,
 	}
 
-	id, err := repository.Create(h.db, user)
+	id, err := repository.Create(h.db.WithContext(ctx), user)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %v", err)
+		return nil, grpcerr.FromError(err, "failed to create user")
 	}
+	logging.WithContext(ctx, h.logger).Info("User created", zap.String("user_id", id))
 
 	return &proto.CreateUserResponse{
 		Id:      id,
@@ -42,12 +59,9 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *proto.CreateUserReque
 }
 
 func (h *UserHandler) GetUserById(ctx context.Context, req *proto.GetUserByIdRequest) (*proto.GetUserByIdResponse, error) {
-	user, err := repository.GetById(h.db, req.GetId())
+	user, err := repository.GetById(h.db.WithContext(ctx), req.GetId())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user by id: %v", err)
-	}
-	if user == nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, grpcerr.FromError(err, "failed to get user by id")
 	}
 
 	return &proto.GetUserByIdResponse{
@@ -58,35 +72,71 @@ func (h *UserHandler) GetUserById(ctx context.Context, req *proto.GetUserByIdReq
 }
 
 func (h *UserHandler) GetUsers(ctx context.Context, req *proto.GetUsersRequest) (*proto.GetUsersResponse, error) {
-	users, err := repository.GetAll[api.User](h.db, int(req.GetPageNumber()), int(req.GetPageSize()))
+	filter := repository.ListFilter{
+		UsernamePrefix: req.GetUsernamePrefix(),
+		EmailPrefix:    req.GetEmailPrefix(),
+		Role:           req.GetRole(),
+	}
+	if req.GetCreatedAfter() > 0 {
+		filter.CreatedAfter = time.Unix(req.GetCreatedAfter(), 0)
+	}
+
+	query := pagination.Query{
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		Sort:         pagination.Sort{Column: sortColumn(req.GetSortBy()), Desc: req.GetDescending()},
+		IncludeTotal: req.GetIncludeTotal(),
+	}
+	if pageNumber := int(req.GetPageNumber()); pageNumber > 1 {
+		size := query.PageSize
+		if size <= 0 {
+			size = pagination.DefaultPageSize
+		}
+		query.Offset = (pageNumber - 1) * size
+	}
+
+	page, err := repository.List(h.db.WithContext(ctx), filter, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get users")
 	}
 
 	var userList []*proto.User
-	for _, u := range users {
+	for _, u := range page.Items {
 		userList = append(userList, &proto.User{
-			Id:       u.ID,
-			Username: u.Username,
-			Email:    u.Email,
+			Id:        u.ID,
+			Username:  u.Username,
+			Email:     u.Email,
+			CreatedAt: u.CreatedAt.Unix(),
 		})
 	}
 
 	return &proto.GetUsersResponse{
-		Users: userList,
+		Users:         userList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
+	id := req.GetId()
+	if id == "" {
+		// no id means the caller updates their own user
+		principal := auth.FromContext(ctx)
+		if principal == nil {
+			return nil, grpcerr.InvalidArgument("id", "no user id given")
+		}
+		id = principal.UserID
+	}
+
 	updatedUser := &api.User{
-		ID:       req.GetId(),
+		ID:       id,
 		Username: req.GetUsername(),
 		Email:    req.GetEmail(),
 	}
 
-	err := repository.Update(h.db, req.GetId(), updatedUser)
+	err := repository.Update(h.db.WithContext(ctx), id, updatedUser)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update user: %v", err)
+		return nil, grpcerr.FromError(err, "failed to update user")
 	}
 
 	return &proto.UpdateUserResponse{
@@ -95,11 +145,36 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *proto.UpdateUserReque
 }
 
 func (h *UserHandler) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
-	err := repository.Delete(h.db, req.GetId())
+	err := repository.Delete(h.db.WithContext(ctx), req.GetId())
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete user: %v", err)
+		return nil, grpcerr.FromError(err, "failed to delete user")
 	}
+	logging.WithContext(ctx, h.logger).Info("User deleted", zap.String("user_id", req.GetId()))
 	return &proto.DeleteUserResponse{
 		Message: "User deleted successfully",
 	}, nil
 }
+
+func (h *UserHandler) VerifyPassword(ctx context.Context, req *proto.VerifyPasswordRequest) (*proto.VerifyPasswordResponse, error) {
+	user, err := credentials.Check(h.db.WithContext(ctx), req.GetLogin(), req.GetPassword())
+	if errors.Is(err, credentials.ErrInvalidCredentials) {
+		return &proto.VerifyPasswordResponse{Valid: false}, nil
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to verify password")
+	}
+	return &proto.VerifyPasswordResponse{
+		Valid: true,
+		Id:    user.ID,
+	}, nil
+}
+
+func sortColumn(field proto.UserSortField) string {
+	switch field {
+	case proto.UserSortField_USER_SORT_FIELD_USERNAME:
+		return "username"
+	case proto.UserSortField_USER_SORT_FIELD_EMAIL:
+		return "email"
+	}
+	return "created_at"
+}
@@ -1,10 +1,15 @@
 package repository
 
 import (
-	"fmt"
+	"errors"
 	"reflect"
+	"slices"
+	"time"
 
 	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/pagination"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -27,66 +32,134 @@ func serialize[T any](source, destination *T) {
 func Create(db *gorm.DB, user *api.User) (string, error) {
 	result := db.Create(&user)
 	if result.Error != nil {
-		return "", fmt.Errorf("failed to insert: %v", result.Error)
+		return "", repoerr.FromGorm(result.Error, "failed to insert user")
 	}
 	return user.ID, nil
 }
 
 func Update[T interface{}](db *gorm.DB, id string, model *T) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
 	result := db.Model(&api.User{}).Where("id = ?", id).Updates(model)
 	if result.Error != nil {
-		return fmt.Errorf("failed to update user: %w", result.Error)
+		return repoerr.FromGorm(result.Error, "failed to update user")
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no user found with ID: %s", id)
+		return repoerr.NotFound("id", "no user found with ID: %s", id)
 	}
 	return nil
 }
 
 func GetById(db *gorm.DB, id string) (*api.User, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
 	var user api.User
 	result := db.First(&user, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("id", "no user found with ID: %s", id)
+	}
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			fmt.Println("No user found with ID:", id)
-		} else {
-			panic("failed to fetch user: " + result.Error.Error())
-		}
-	} else {
-		fmt.Printf("Fetched user: %+v\n", user)
-		fmt.Println("User ID:", user.ID)
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch user")
 	}
 	return &user, nil
 }
 
-func GetAll[T interface{}](db *gorm.DB, pageNumber, pageSize int) ([]*T, error) {
-	skip := int64((pageNumber - 1) * pageSize)
-	limit := int64(pageSize)
-
-	var data []*T
-	result := db.Limit(int(limit)).Offset(int(skip)).Find(&data)
+func GetByLogin(db *gorm.DB, login string) (*api.User, error) {
+	var user api.User
+	result := db.Where("username = ? OR email = ?", login, login).First(&user)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, repoerr.NotFound("login", "no user found with login: %s", login)
+	}
 	if result.Error != nil {
-		return nil, fmt.Errorf("failed to get users: %v", result.Error)
+		return nil, repoerr.FromGorm(result.Error, "failed to fetch user")
+	}
+	return &user, nil
+}
+
+// GetUsernames returns the usernames of the users with the given ids, keyed
+// by id. Unknown ids are left out.
+func GetUsernames(db *gorm.DB, ids []string) (map[string]string, error) {
+	usernames := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return usernames, nil
+	}
+	var users []api.User
+	if err := db.Select("id", "username").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, repoerr.FromGorm(err, "failed to fetch usernames")
+	}
+	for _, u := range users {
+		usernames[u.ID] = u.Username
+	}
+	return usernames, nil
+}
+
+// ListFilter narrows a user listing. Zero fields are ignored.
+type ListFilter struct {
+	UsernamePrefix string
+	EmailPrefix    string
+	Role           string
+	CreatedAfter   time.Time
+}
+
+var sortColumns = []string{"created_at", "username", "email"}
+
+func List(db *gorm.DB, filter ListFilter, q pagination.Query) (*pagination.Page[api.User], error) {
+	if !slices.Contains(sortColumns, q.Sort.Column) {
+		return nil, repoerr.InvalidArgument("sortBy", "cannot sort users by %s", q.Sort.Column)
 	}
 
-	return data, nil
+	tx := db.Model(&api.User{})
+	if filter.UsernamePrefix != "" {
+		tx = tx.Where("username LIKE ?", pagination.EscapeLike(filter.UsernamePrefix)+"%")
+	}
+	if filter.EmailPrefix != "" {
+		tx = tx.Where("email LIKE ?", pagination.EscapeLike(filter.EmailPrefix)+"%")
+	}
+	if filter.Role == auth.RoleAdmin {
+		tx = tx.Where("admin")
+	} else if filter.Role != "" {
+		tx = tx.Where("id IN (?)", db.Table("role_bindings AS rb").
+			Select("rb.user_id").
+			Joins("JOIN roles r ON r.id = rb.role_id").
+			Where("r.name = ?", filter.Role))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		tx = tx.Where("created_at > ?", filter.CreatedAfter)
+	}
+	return pagination.List(tx, q, func(u *api.User) (string, string) {
+		switch q.Sort.Column {
+		case "username":
+			return u.Username, u.ID
+		case "email":
+			return u.Email, u.ID
+		}
+		return u.CreatedAt.UTC().Format(time.RFC3339Nano), u.ID
+	})
 }
 
 func Delete(db *gorm.DB, id string) error {
-	_, err := uuid.Parse(id)
-	if err != nil {
-		return fmt.Errorf("invalid uid format: %v", err)
+	if err := validateID(id); err != nil {
+		return err
 	}
 
 	// Delete the user with the given uid
 	result := db.Where("id = ?", id).Delete(&api.User{})
 	if err := result.Error; err != nil {
-		return fmt.Errorf("failed to delete user: %v", err)
+		return repoerr.FromGorm(err, "failed to delete user")
 	}
 
 	// Check if any rows were affected
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("no user found with uid: %s", id)
+		return repoerr.NotFound("id", "no user found with uid: %s", id)
+	}
+	return nil
+}
+
+func validateID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return repoerr.InvalidArgument("id", "invalid uid format: %v", err)
 	}
 	return nil
 }
@@ -1,59 +1,482 @@
 package user_service
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"net"
-	"os"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"time"
 
-	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/validate"
+	"github.com/aburifat/go-agro/pkg/backend/config"
+	"github.com/aburifat/go-agro/pkg/backend/gateway"
+	"github.com/aburifat/go-agro/pkg/backend/health"
+	"github.com/aburifat/go-agro/pkg/backend/lifecycle"
+	"github.com/aburifat/go-agro/pkg/backend/metrics"
+	"github.com/aburifat/go-agro/pkg/backend/migrations"
+	activityhandlers "github.com/aburifat/go-agro/pkg/backend/services/activity_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/activity_service/journal"
+	activityproto "github.com/aburifat/go-agro/pkg/backend/services/activity_service/proto"
+	authhandlers "github.com/aburifat/go-agro/pkg/backend/services/auth_service/handlers"
+	authproto "github.com/aburifat/go-agro/pkg/backend/services/auth_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/auth_service/token"
+	compliancehandlers "github.com/aburifat/go-agro/pkg/backend/services/compliance_service/handlers"
+	complianceproto "github.com/aburifat/go-agro/pkg/backend/services/compliance_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/compliance_service/rules"
+	crophandlers "github.com/aburifat/go-agro/pkg/backend/services/crop_service/handlers"
+	cropproto "github.com/aburifat/go-agro/pkg/backend/services/crop_service/proto"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	farmproto "github.com/aburifat/go-agro/pkg/backend/services/farm_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/spatial"
+	"github.com/aburifat/go-agro/pkg/backend/services/growth_service/growth"
+	growthhandlers "github.com/aburifat/go-agro/pkg/backend/services/growth_service/handlers"
+	growthproto "github.com/aburifat/go-agro/pkg/backend/services/growth_service/proto"
+	inventoryhandlers "github.com/aburifat/go-agro/pkg/backend/services/inventory_service/handlers"
+	inventoryproto "github.com/aburifat/go-agro/pkg/backend/services/inventory_service/proto"
+	irrigationhandlers "github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/handlers"
+	irrigationproto "github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/irrigation_service/scheduler"
+	rbachandlers "github.com/aburifat/go-agro/pkg/backend/services/rbac_service/handlers"
+	rbacproto "github.com/aburifat/go-agro/pkg/backend/services/rbac_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	telemetryhandlers "github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/handlers"
+	telemetryproto "github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/telemetry_service/series"
 	"github.com/aburifat/go-agro/pkg/backend/services/user_service/handlers"
 	"github.com/aburifat/go-agro/pkg/backend/services/user_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+	weatherhandlers "github.com/aburifat/go-agro/pkg/backend/services/weather_service/handlers"
+	weatherproto "github.com/aburifat/go-agro/pkg/backend/services/weather_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+	"github.com/aburifat/go-agro/pkg/backend/tracing"
 
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
-func Server() {
-	logger, _ := zap.NewProduction() // or zap.NewDevelopment() for dev
+func Server(cfg *config.Config) {
+	logger, err := newLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("failed to create logger: %v", err)
+	}
 	defer logger.Sync()
 	logger.Info("Starting server")
-	//err := godotenv.Load()
-	//if err != nil {
-	//	log.Fatalf("Error loading .env file: %v", err)
-	//}
-	postgresUser := os.Getenv("POSTGRES_USER")
-	postgresPassword := os.Getenv("POSTGRES_PASSWORD")
-	dsn := fmt.Sprintf("host=localhost user=%s password=%s dbname=users port=5432 sslmode=disable TimeZone=UTC", postgresUser, postgresPassword)
+
+	lc := lifecycle.New(logger, cfg.GRPC.ShutdownTimeout)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		panic("failed to set up tracing: " + err.Error())
+	}
+	// appended first so that spans of the other components are flushed
+	lc.Append(lifecycle.Hook{
+		Name:   "tracing",
+		OnStop: shutdownTracing,
+	})
+	monitor := health.NewMonitor(logger, cfg.Health.ProbeInterval, cfg.Health.ProbeTimeout)
+	recorder := metrics.New()
+
 	// Connect to PostgreSQL
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(cfg.Database.DSN()), &gorm.Config{
+		Logger: logging.NewGormLogger(logger, cfg.Database.SlowQueryThreshold),
+	})
 	if err != nil {
 		panic("failed to connect to database: " + err.Error())
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic("failed to connect to database: " + err.Error())
+	}
+	lc.Append(lifecycle.Hook{
+		Name: "postgres",
+		OnStop: func(ctx context.Context) error {
+			return sqlDB.Close()
+		},
+	})
+	monitor.AddProbe("postgres", sqlDB.PingContext)
+	if err := recorder.InstrumentGorm(db, cfg.Database.Name); err != nil {
+		panic("failed to instrument database: " + err.Error())
+	}
+	if err := tracing.InstrumentGorm(db); err != nil {
+		panic("failed to instrument database: " + err.Error())
+	}
 
 	logger.Info("Successfully connected to database")
 
-	//install extension
-	db.Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`)
-	// Auto-migrate the schema (creates/updates tables based on structs)
-	err = db.AutoMigrate(&api.User{})
+	var index *spatial.Index
+	var activities *journal.Journal
+	var compliance *rules.Engine
+	var telemetry *series.Store
+	var irrigation *scheduler.Scheduler
+	var weatherStore *weather.Store
+	var tracker *growth.Tracker
+	if cfg.Mongo.URI != "" {
+		store, err := storage.NewStorage(cfg.Mongo.URI, cfg.Mongo.Database, options.Client().SetMonitor(storage.ChainMonitors(
+			otelmongo.NewMonitor(),
+			recorder.CommandMonitor(),
+		)))
+		if err != nil {
+			panic(err.Error())
+		}
+		lc.Append(lifecycle.Hook{
+			Name:   "mongo",
+			OnStop: store.Close,
+		})
+		monitor.AddProbe("mongo", store.Ping)
+		logger.Info("Successfully connected to MongoDB")
+
+		activities = journal.NewJournal(store)
+		lc.Append(lifecycle.Hook{
+			Name:    "activity journal",
+			OnStart: activities.EnsureIndexes,
+		})
+		compliance = rules.NewEngine(db, activities, cfg.Compliance.Enforcement)
+
+		telemetry = series.NewStore(store)
+		lc.Append(lifecycle.Hook{
+			Name:    "telemetry",
+			OnStart: telemetry.EnsureCollections,
+		})
+
+		irrigation = scheduler.NewScheduler(db, store, telemetry, activities, compliance, logger)
+		lc.Append(lifecycle.Hook{
+			Name:    "irrigation schedules",
+			OnStart: irrigation.EnsureIndexes,
+		})
+		if cfg.Irrigation.Interval > 0 {
+			scheduleCtx, cancelSchedule := context.WithCancel(context.Background())
+			scheduled := make(chan struct{})
+			lc.Append(lifecycle.Hook{
+				Name: "irrigation scheduler",
+				OnStart: func(ctx context.Context) error {
+					go func() {
+						defer close(scheduled)
+						irrigation.Run(scheduleCtx, cfg.Irrigation.Interval)
+					}()
+					return nil
+				},
+				OnStop: func(ctx context.Context) error {
+					cancelSchedule()
+					select {
+					case <-scheduled:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				},
+			})
+		}
+
+		index = spatial.NewIndex(store)
+		rebuildCtx, cancelRebuild := context.WithCancel(context.Background())
+		rebuilt := make(chan struct{})
+		lc.Append(lifecycle.Hook{
+			Name: "spatial index",
+			OnStart: func(ctx context.Context) error {
+				if err := index.EnsureIndexes(ctx); err != nil {
+					return err
+				}
+				// catches up on changes made while MongoDB was unreachable
+				go func() {
+					defer close(rebuilt)
+					if err := index.Rebuild(rebuildCtx, db); err != nil {
+						logger.Warn("Failed to rebuild spatial index", zap.Error(err))
+						return
+					}
+					logger.Info("Spatial index rebuilt")
+				}()
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				cancelRebuild()
+				select {
+				case <-rebuilt:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			},
+		})
+
+		weatherStore = weather.NewStore(store, index)
+		lc.Append(lifecycle.Hook{
+			Name:    "weather",
+			OnStart: weatherStore.EnsureIndexes,
+		})
+		tracker = growth.NewTracker(db, store, weatherStore)
+	}
+
+	if cfg.Database.MigrateOnStart {
+		migrator, err := migrations.New(sqlDB)
+		if err != nil {
+			panic("failed to migrate database: " + err.Error())
+		}
+		applied, err := migrator.Up(context.Background(), 0)
+		if err != nil {
+			panic("failed to migrate database: " + err.Error())
+		}
+		logger.Info("Database migrated", zap.Int64s("applied", applied))
+	}
+
+	signingKey, err := loadSigningKey(cfg.Auth.SigningKeyFile, logger)
 	if err != nil {
-		panic("failed to migrate database: " + err.Error())
+		panic("failed to load signing key: " + err.Error())
+	}
+	var verificationKeys []ed25519.PublicKey
+	for _, path := range cfg.Auth.VerificationKeyFiles {
+		key, err := token.LoadVerificationKey(path)
+		if err != nil {
+			panic("failed to load verification key: " + err.Error())
+		}
+		verificationKeys = append(verificationKeys, key)
 	}
+	issuer := token.NewIssuer(signingKey, cfg.Auth.AccessTokenTTL, verificationKeys...)
 
-	grpcServer := grpc.NewServer()
+	checker := rbac.NewChecker(db)
+	policy := auth.Merge(handlers.Policy, authhandlers.Policy, rbachandlers.Policy(checker),
+		farmhandlers.Policy(db, checker), crophandlers.Policy(db, checker),
+		activityhandlers.Policy(db, checker, activities), telemetryhandlers.Policy(db, checker, telemetry),
+		irrigationhandlers.Policy(db, checker), weatherhandlers.Policy(db, checker),
+		growthhandlers.Policy(db, checker), inventoryhandlers.Policy(db, checker),
+		compliancehandlers.Policy(db, checker), health.Policy)
+	authenticate := func(ctx context.Context, raw string) (*auth.Principal, error) {
+		claims, err := issuer.Verify(raw)
+		if err != nil {
+			return nil, err
+		}
+		return &auth.Principal{UserID: claims.Subject, Roles: claims.Roles}, nil
+	}
+
+	// validated with the config
+	trustedPeers, _ := cfg.HTTP.TrustedProxyPrefixes()
+	if cfg.HTTP.Addr != "" {
+		trustedPeers = append(trustedPeers, gatewayPeers(cfg.GRPC.Addr)...)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(logger, trustedPeers),
+			recorder.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(authenticate, policy),
+			validate.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(logger, trustedPeers),
+			recorder.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(authenticate, policy),
+			validate.StreamServerInterceptor(),
+		),
+	)
 
-	proto.RegisterUserServiceServer(grpcServer, handlers.NewUserHandler(db))
+	proto.RegisterUserServiceServer(grpcServer, handlers.NewUserHandler(db, logger))
+	authproto.RegisterAuthServiceServer(grpcServer, authhandlers.NewAuthHandler(db, issuer, cfg.Auth.RefreshTokenTTL, logger))
+	rbacproto.RegisterRBACServiceServer(grpcServer, rbachandlers.NewRBACHandler(db, checker, logger))
+	farmproto.RegisterFarmServiceServer(grpcServer, farmhandlers.NewFarmHandler(db, checker, index, logger))
+	cropproto.RegisterCropServiceServer(grpcServer, crophandlers.NewCropHandler(db, logger))
+	activityproto.RegisterActivityServiceServer(grpcServer, activityhandlers.NewActivityHandler(db, activities, compliance, logger))
+	telemetryproto.RegisterTelemetryServiceServer(grpcServer, telemetryhandlers.NewTelemetryHandler(db, checker, telemetry, logger))
+	irrigationproto.RegisterIrrigationServiceServer(grpcServer, irrigationhandlers.NewIrrigationHandler(irrigation, logger))
+	weatherproto.RegisterWeatherServiceServer(grpcServer, weatherhandlers.NewWeatherHandler(db, weatherStore, logger))
+	growthproto.RegisterGrowthServiceServer(grpcServer, growthhandlers.NewGrowthHandler(tracker, logger))
+	inventoryproto.RegisterInventoryServiceServer(grpcServer, inventoryhandlers.NewInventoryHandler(db, activities, logger))
+	complianceproto.RegisterComplianceServiceServer(grpcServer, compliancehandlers.NewComplianceHandler(db, compliance, logger))
+	monitor.Register(grpcServer)
+	monitor.AddService(proto.UserService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(authproto.AuthService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(rbacproto.RBACService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(farmproto.FarmService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(cropproto.CropService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(activityproto.ActivityService_ServiceDesc.ServiceName, "postgres", "mongo")
+	monitor.AddService(telemetryproto.TelemetryService_ServiceDesc.ServiceName, "postgres", "mongo")
+	monitor.AddService(irrigationproto.IrrigationService_ServiceDesc.ServiceName, "postgres", "mongo")
+	monitor.AddService(weatherproto.WeatherService_ServiceDesc.ServiceName, "postgres", "mongo")
+	monitor.AddService(growthproto.GrowthService_ServiceDesc.ServiceName, "postgres", "mongo")
+	monitor.AddService(inventoryproto.InventoryService_ServiceDesc.ServiceName, "postgres")
+	monitor.AddService(complianceproto.ComplianceService_ServiceDesc.ServiceName, "postgres", "mongo")
 
-	listener, err := net.Listen("tcp", ":50051")
+	if cfg.Metrics.Addr != "" {
+		// appended before the gRPC server so that it can be scraped while
+		// the server drains
+		var metricsServer *http.Server
+		lc.Append(lifecycle.Hook{
+			Name: "metrics",
+			OnStart: func(ctx context.Context) error {
+				mux := http.NewServeMux()
+				mux.Handle("GET /metrics", recorder.Handler())
+				var err error
+				metricsServer, err = serveHTTP(lc, logger, "metrics", cfg.Metrics.Addr, mux)
+				return err
+			},
+			OnStop: func(ctx context.Context) error {
+				return metricsServer.Shutdown(ctx)
+			},
+		})
+	}
+
+	var grpcAddr net.Addr
+	lc.Append(lifecycle.Hook{
+		Name: "grpc",
+		OnStart: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", cfg.GRPC.Addr)
+			if err != nil {
+				return err
+			}
+			grpcAddr = listener.Addr()
+			logger.Info("gRPC server is running", zap.String("addr", grpcAddr.String()))
+			lc.Go("grpc", func() error {
+				return grpcServer.Serve(listener)
+			})
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return gracefulStop(ctx, grpcServer)
+		},
+	})
+	if cfg.HTTP.Addr != "" {
+		var conn *grpc.ClientConn
+		var httpServer *http.Server
+		lc.Append(lifecycle.Hook{
+			Name: "http",
+			OnStart: func(ctx context.Context) error {
+				// the gateway calls back into the gRPC server so that REST
+				// requests pass the same interceptors
+				var err error
+				conn, err = grpc.NewClient("passthrough:///"+loopback(grpcAddr), grpc.WithTransportCredentials(insecure.NewCredentials()),
+					grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+				)
+				if err != nil {
+					return err
+				}
+				gw, err := gateway.New(conn, slices.Collect(maps.Keys(grpcServer.GetServiceInfo()))...)
+				if err != nil {
+					return err
+				}
+				// validated with the config
+				proxies, _ := cfg.HTTP.TrustedProxyPrefixes()
+				gw.TrustProxies(proxies)
+				openAPI, err := gw.OpenAPIHandler("go-agro", "v1")
+				if err != nil {
+					return err
+				}
+				mux := http.NewServeMux()
+				mux.Handle("GET /openapi.json", openAPI)
+				mux.Handle("/", gw)
+				httpServer, err = serveHTTP(lc, logger, "http", cfg.HTTP.Addr, mux)
+				return err
+			},
+			OnStop: func(ctx context.Context) error {
+				return errors.Join(httpServer.Shutdown(ctx), conn.Close())
+			},
+		})
+	}
+	// appended after the servers so that it reports NOT_SERVING before they
+	// start draining
+	lc.Append(lifecycle.Hook{
+		Name:    "health",
+		OnStart: monitor.Start,
+		OnStop:  monitor.Stop,
+	})
+
+	if err := lc.Run(context.Background()); err != nil {
+		logger.Fatal("Server stopped with error", zap.Error(err))
+	}
+	logger.Info("Server stopped")
+}
+
+// gracefulStop stops accepting connections and waits for in-flight RPCs to
+// finish, cancelling whatever is still running when ctx expires.
+func gracefulStop(ctx context.Context, grpcServer *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		grpcServer.Stop()
+		<-done
+		return fmt.Errorf("in-flight RPCs were cancelled: %w", ctx.Err())
+	}
+}
+
+// loopback returns an address dialing addr from the same host.
+func loopback(addr net.Addr) string {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok || !tcpAddr.IP.IsUnspecified() {
+		return addr.String()
+	}
+	return net.JoinHostPort("localhost", strconv.Itoa(tcpAddr.Port))
+}
+
+// gatewayPeers are the addresses the gateway calls the gRPC server from: the
+// loopback, or the listen address when it is not a wildcard.
+func gatewayPeers(grpcAddr string) []netip.Prefix {
+	peers := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+	host, _, err := net.SplitHostPort(grpcAddr)
+	if err != nil {
+		return peers
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !addr.IsUnspecified() {
+		addr = addr.Unmap()
+		peers = append(peers, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return peers
+}
+
+// serveHTTP serves handler on addr until the server is shut down, failing lc
+// when it stops for any other reason.
+func serveHTTP(lc *lifecycle.Manager, logger *zap.Logger, name, addr string, handler http.Handler) (*http.Server, error) {
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
-		log.Fatalf("failed to listen on port 50051: %v", err)
+		return nil, err
 	}
+	logger.Info("HTTP server is running", zap.String("server", name), zap.String("addr", listener.Addr().String()))
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	lc.Go(name, func() error {
+		if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	return server, nil
+}
+
+func newLogger(cfg config.LogConfig) (*zap.Logger, error) {
+	zapConfig := zap.NewProductionConfig()
+	if cfg.Development {
+		zapConfig = zap.NewDevelopmentConfig()
+	}
+	level, err := zap.ParseAtomicLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	zapConfig.Level = level
+	return zapConfig.Build()
+}
 
-	fmt.Println("gRPC server is running on port 50051...")
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatalf("failed to serve gRPC server: %v", err)
+func loadSigningKey(path string, logger *zap.Logger) (ed25519.PrivateKey, error) {
+	if path != "" {
+		return token.LoadSigningKey(path)
 	}
+	logger.Warn("auth.signingKeyFile is not set, using an ephemeral signing key; tokens will not survive a restart")
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	return key, err
 }
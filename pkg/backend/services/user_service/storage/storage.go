@@ -2,8 +2,10 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -13,8 +15,10 @@ type Storage struct {
 	database *mongo.Database
 }
 
-func NewStorage(uri, dbName string) (*Storage, error) {
-	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+// NewStorage connects to the MongoDB at uri. opts are applied after the URI,
+// e.g. to set a command monitor.
+func NewStorage(uri, dbName string, opts ...*options.ClientOptions) (*Storage, error) {
+	client, err := mongo.Connect(context.Background(), append([]*options.ClientOptions{options.Client().ApplyURI(uri)}, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %v", err)
 	}
@@ -33,3 +37,50 @@ func (s *Storage) GetCollection(collectionName string) *mongo.Collection {
 	collection := s.database.Collection(collectionName)
 	return collection
 }
+
+// CreateCollection creates the named collection with opts, e.g. as a time
+// series collection. Existing collections are left as they are.
+func (s *Storage) CreateCollection(ctx context.Context, collectionName string, opts ...*options.CreateCollectionOptions) error {
+	err := s.database.CreateCollection(ctx, collectionName, opts...)
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Name == "NamespaceExists" {
+		return nil
+	}
+	return err
+}
+
+func (s *Storage) Close(ctx context.Context) error {
+	return s.client.Disconnect(ctx)
+}
+
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx, nil)
+}
+
+// ChainMonitors returns a command monitor calling every given monitor in
+// order, since the client accepts only one.
+func ChainMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
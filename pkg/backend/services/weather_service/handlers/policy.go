@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"github.com/aburifat/go-agro/pkg/backend/common/auth"
+	farmhandlers "github.com/aburifat/go-agro/pkg/backend/services/farm_service/handlers"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/proto"
+	"gorm.io/gorm"
+)
+
+// Policy is the access policy of WeatherService. Station data is open to
+// every caller; imports feed all farms and need a global binding of
+// weather.write. The indices of a field are read like the field itself.
+func Policy(db *gorm.DB, checker *rbac.Checker) auth.Policy {
+	field := farmhandlers.FieldResource(db, func(req any) string {
+		return req.(*proto.GetFieldIndicesRequest).GetFieldId()
+	})
+
+	return auth.Policy{
+		proto.WeatherService_ImportObservations_FullMethodName: checker.Require("weather.write", nil),
+		proto.WeatherService_GetStation_FullMethodName:         auth.Authenticated,
+		proto.WeatherService_ListStations_FullMethodName:       auth.Authenticated,
+		proto.WeatherService_ListObservations_FullMethodName:   auth.Authenticated,
+		proto.WeatherService_GetFieldIndices_FullMethodName:    checker.RequireLookup("field.read", field),
+	}
+}
@@ -0,0 +1,311 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/grpcerr"
+	"github.com/aburifat/go-agro/pkg/backend/common/logging"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	croprepository "github.com/aburifat/go-agro/pkg/backend/services/crop_service/repository"
+	farmrepository "github.com/aburifat/go-agro/pkg/backend/services/farm_service/repository"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/proto"
+	"github.com/aburifat/go-agro/pkg/backend/services/weather_service/weather"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	defaultObservationPageSize = 100
+)
+
+var errNoStore = status.Error(codes.FailedPrecondition, "weather data requires MongoDB to be configured")
+
+type WeatherHandler struct {
+	proto.UnimplementedWeatherServiceServer
+	db     *gorm.DB
+	store  *weather.Store
+	logger *zap.Logger
+}
+
+// NewWeatherHandler returns the WeatherService handler. store is nil when
+// MongoDB is not configured, every call then fails with FailedPrecondition.
+func NewWeatherHandler(db *gorm.DB, store *weather.Store, logger *zap.Logger) *WeatherHandler {
+	weatherHandler := WeatherHandler{
+		db:     db,
+		store:  store,
+		logger: logger,
+	}
+	return &weatherHandler
+}
+
+func (h *WeatherHandler) ImportObservations(ctx context.Context, req *proto.ImportObservationsRequest) (*proto.ImportObservationsResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	var data *weather.Dataset
+	var err error
+	switch req.GetFormat() {
+	case proto.ImportFormat_IMPORT_FORMAT_CSV:
+		data, err = weather.ParseCSV(bytes.NewReader(req.GetContent()), req.GetStation())
+	default:
+		data, err = weather.ParseNetCDFJSON(bytes.NewReader(req.GetContent()), req.GetStation())
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to parse observations")
+	}
+	radius := req.GetLinkRadiusMeters()
+	if radius == 0 {
+		radius = weather.DefaultLinkRadius
+	}
+	result, err := h.store.Import(ctx, data, radius)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to import observations")
+	}
+
+	logging.WithContext(ctx, h.logger).Info("Weather observations imported", zap.Strings("station_ids", result.Stations),
+		zap.Int("inserted", result.Inserted), zap.Int("updated", result.Updated))
+	return &proto.ImportObservationsResponse{
+		StationIds:  result.Stations,
+		Inserted:    int64(result.Inserted),
+		Updated:     int64(result.Updated),
+		LinkedFarms: int64(result.LinkedFarms),
+		Message:     "Weather observations imported successfully",
+	}, nil
+}
+
+func (h *WeatherHandler) GetStation(ctx context.Context, req *proto.GetStationRequest) (*proto.GetStationResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	station, err := h.store.GetStation(ctx, req.GetId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get weather station")
+	}
+	return &proto.GetStationResponse{Station: stationToProto(station)}, nil
+}
+
+func (h *WeatherHandler) ListStations(ctx context.Context, req *proto.ListStationsRequest) (*proto.ListStationsResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	stations, err := h.store.ListStations(ctx, req.GetFarmId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list weather stations")
+	}
+	response := &proto.ListStationsResponse{}
+	for _, station := range stations {
+		response.Stations = append(response.Stations, stationToProto(station))
+	}
+	return response, nil
+}
+
+func (h *WeatherHandler) ListObservations(ctx context.Context, req *proto.ListObservationsRequest) (*proto.ListObservationsResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	q := weather.ObservationQuery{
+		StationID:  req.GetStationId(),
+		Resolution: resolutionName(req.GetResolution()),
+		Limit:      int(req.GetPageSize()),
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultObservationPageSize
+	}
+	var err error
+	if req.GetFrom() != "" {
+		if q.From, err = parseTime("from", req.GetFrom()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetTo() != "" {
+		if q.To, err = parseTime("to", req.GetTo()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetPageToken() != "" {
+		if q.After, err = decodePageToken(req.GetPageToken()); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := h.store.GetStation(ctx, q.StationID); err != nil {
+		return nil, grpcerr.FromError(err, "failed to list observations")
+	}
+
+	// one more than asked for tells whether there is a next page
+	limit := q.Limit
+	q.Limit++
+	observations, err := h.store.ListObservations(ctx, q)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list observations")
+	}
+	response := &proto.ListObservationsResponse{}
+	if len(observations) > limit {
+		observations = observations[:limit]
+		response.NextPageToken = encodePageToken(observations[limit-1].Time)
+	}
+	for _, o := range observations {
+		response.Observations = append(response.Observations, &proto.Observation{
+			Time:        o.Time.Format(time.RFC3339),
+			Resolution:  req.GetResolution(),
+			Temperature: o.Temperature,
+			TMin:        o.TMin,
+			TMax:        o.TMax,
+			Rainfall:    o.Rainfall,
+		})
+	}
+	return response, nil
+}
+
+// GetFieldIndices computes the indices of a field for the requested days,
+// by default those of its current planting so far above the base
+// temperature of its crop.
+func (h *WeatherHandler) GetFieldIndices(ctx context.Context, req *proto.GetFieldIndicesRequest) (*proto.GetFieldIndicesResponse, error) {
+	if h.store == nil {
+		return nil, errNoStore
+	}
+	db := h.db.WithContext(ctx)
+	field, err := farmrepository.GetField(db, req.GetFieldId())
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get field")
+	}
+
+	tomorrow := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	var from, to time.Time
+	base, upper := weather.DefaultBaseTemperature, weather.DefaultUpperTemperature
+	if req.GetFrom() == "" {
+		plan, err := croprepository.GetActivePlantingPlan(db, field.ID, tomorrow.AddDate(0, 0, -1), tomorrow)
+		if errors.Is(err, repoerr.ErrNotFound) {
+			return nil, grpcerr.InvalidArgument("from", "is required for fields without a current planting")
+		}
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to get planting plan")
+		}
+		from, to = plan.SowingDate, plan.HarvestDate
+		if to.After(tomorrow) {
+			to = tomorrow
+		}
+		base = plan.Crop.BaseTemperatureC
+	} else {
+		if from, err = parseDate("from", req.GetFrom()); err != nil {
+			return nil, err
+		}
+		to = tomorrow
+	}
+	if req.GetTo() != "" {
+		if to, err = parseDate("to", req.GetTo()); err != nil {
+			return nil, err
+		}
+	}
+	if !from.Before(to) {
+		return nil, grpcerr.InvalidArgument("to", "must be after from")
+	}
+	if req.BaseTemperature != nil {
+		base = req.GetBaseTemperature()
+	}
+	if req.UpperTemperature != nil {
+		upper = req.GetUpperTemperature()
+	} else if upper <= base {
+		upper = base + weather.DefaultUpperTemperature - weather.DefaultBaseTemperature
+	}
+	if upper <= base {
+		return nil, grpcerr.InvalidArgument("upperTemperature", "must be above the base temperature")
+	}
+
+	indices, err := h.store.FieldIndices(ctx, field, from, to, base, upper)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to compute weather indices")
+	}
+	response := &proto.GetFieldIndicesResponse{
+		FieldId:          field.ID,
+		StationId:        indices.StationID,
+		From:             indices.From.Format(dateLayout),
+		To:               indices.To.Format(dateLayout),
+		BaseTemperature:  indices.BaseTemperature,
+		UpperTemperature: indices.UpperTemperature,
+		DegreeDays:       indices.DegreeDays,
+		ChillHours:       indices.ChillHours,
+		Rainfall:         indices.Rainfall,
+		MissingDays:      int32(indices.MissingDays),
+	}
+	for _, d := range indices.Days {
+		response.Days = append(response.Days, &proto.IndexDay{
+			Date:                 d.Day.Format(dateLayout),
+			DegreeDays:           d.DegreeDays,
+			ChillHours:           d.ChillHours,
+			Rainfall:             d.Rainfall,
+			CumulativeDegreeDays: d.CumulativeDegreeDays,
+			CumulativeChillHours: d.CumulativeChillHours,
+			CumulativeRainfall:   d.CumulativeRainfall,
+			Missing:              d.Missing,
+		})
+	}
+	return response, nil
+}
+
+func stationToProto(s *weather.Station) *proto.Station {
+	station := &proto.Station{
+		Id:        s.ID,
+		Name:      s.Name,
+		FarmIds:   s.FarmIDs,
+		UpdatedAt: s.UpdatedAt.Format(time.RFC3339),
+	}
+	if s.Location != nil {
+		station.Latitude = s.Location.Coordinates.Lat()
+		station.Longitude = s.Location.Coordinates.Lon()
+		station.Located = true
+	}
+	if s.ElevationM != nil {
+		station.ElevationM = *s.ElevationM
+	}
+	return station
+}
+
+// resolutionName returns the name stored for r, e.g. "hourly".
+func resolutionName(r proto.Resolution) string {
+	return strings.ToLower(strings.TrimPrefix(r.String(), "RESOLUTION_"))
+}
+
+// parseTime accepts RFC 3339 timestamps and dates.
+func parseTime(field, value string) (time.Time, error) {
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, grpcerr.InvalidArgument(field, "must be an RFC 3339 timestamp or a date")
+	}
+	return t, nil
+}
+
+func parseDate(field, value string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, grpcerr.InvalidArgument(field, "must be a date formatted YYYY-MM-DD")
+	}
+	return t, nil
+}
+
+func encodePageToken(after time.Time) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(after.Format(time.RFC3339Nano)))
+}
+
+func decodePageToken(token string) (time.Time, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		var t time.Time
+		if t, err = time.Parse(time.RFC3339Nano, string(raw)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, grpcerr.InvalidArgument("pageToken", "is not a valid page token")
+}
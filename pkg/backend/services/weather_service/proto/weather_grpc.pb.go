@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: weather.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WeatherService_ImportObservations_FullMethodName = "/weather.WeatherService/ImportObservations"
+	WeatherService_GetStation_FullMethodName         = "/weather.WeatherService/GetStation"
+	WeatherService_ListStations_FullMethodName       = "/weather.WeatherService/ListStations"
+	WeatherService_ListObservations_FullMethodName   = "/weather.WeatherService/ListObservations"
+	WeatherService_GetFieldIndices_FullMethodName    = "/weather.WeatherService/GetFieldIndices"
+)
+
+// WeatherServiceClient is the client API for WeatherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WeatherService stores the observations of weather stations, links
+// stations to the farms around them and derives agronomic indices of fields
+// from the station closest to them.
+type WeatherServiceClient interface {
+	ImportObservations(ctx context.Context, in *ImportObservationsRequest, opts ...grpc.CallOption) (*ImportObservationsResponse, error)
+	GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*GetStationResponse, error)
+	ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error)
+	ListObservations(ctx context.Context, in *ListObservationsRequest, opts ...grpc.CallOption) (*ListObservationsResponse, error)
+	GetFieldIndices(ctx context.Context, in *GetFieldIndicesRequest, opts ...grpc.CallOption) (*GetFieldIndicesResponse, error)
+}
+
+type weatherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWeatherServiceClient(cc grpc.ClientConnInterface) WeatherServiceClient {
+	return &weatherServiceClient{cc}
+}
+
+func (c *weatherServiceClient) ImportObservations(ctx context.Context, in *ImportObservationsRequest, opts ...grpc.CallOption) (*ImportObservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportObservationsResponse)
+	err := c.cc.Invoke(ctx, WeatherService_ImportObservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*GetStationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStationResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetStation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStationsResponse)
+	err := c.cc.Invoke(ctx, WeatherService_ListStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) ListObservations(ctx context.Context, in *ListObservationsRequest, opts ...grpc.CallOption) (*ListObservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObservationsResponse)
+	err := c.cc.Invoke(ctx, WeatherService_ListObservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetFieldIndices(ctx context.Context, in *GetFieldIndicesRequest, opts ...grpc.CallOption) (*GetFieldIndicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFieldIndicesResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetFieldIndices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WeatherServiceServer is the server API for WeatherService service.
+// All implementations must embed UnimplementedWeatherServiceServer
+// for forward compatibility.
+//
+// WeatherService stores the observations of weather stations, links
+// stations to the farms around them and derives agronomic indices of fields
+// from the station closest to them.
+type WeatherServiceServer interface {
+	ImportObservations(context.Context, *ImportObservationsRequest) (*ImportObservationsResponse, error)
+	GetStation(context.Context, *GetStationRequest) (*GetStationResponse, error)
+	ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error)
+	ListObservations(context.Context, *ListObservationsRequest) (*ListObservationsResponse, error)
+	GetFieldIndices(context.Context, *GetFieldIndicesRequest) (*GetFieldIndicesResponse, error)
+	mustEmbedUnimplementedWeatherServiceServer()
+}
+
+// UnimplementedWeatherServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWeatherServiceServer struct{}
+
+func (UnimplementedWeatherServiceServer) ImportObservations(context.Context, *ImportObservationsRequest) (*ImportObservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportObservations not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetStation(context.Context, *GetStationRequest) (*GetStationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStation not implemented")
+}
+func (UnimplementedWeatherServiceServer) ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStations not implemented")
+}
+func (UnimplementedWeatherServiceServer) ListObservations(context.Context, *ListObservationsRequest) (*ListObservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObservations not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetFieldIndices(context.Context, *GetFieldIndicesRequest) (*GetFieldIndicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFieldIndices not implemented")
+}
+func (UnimplementedWeatherServiceServer) mustEmbedUnimplementedWeatherServiceServer() {}
+func (UnimplementedWeatherServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWeatherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WeatherServiceServer will
+// result in compilation errors.
+type UnsafeWeatherServiceServer interface {
+	mustEmbedUnimplementedWeatherServiceServer()
+}
+
+func RegisterWeatherServiceServer(s grpc.ServiceRegistrar, srv WeatherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWeatherServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WeatherService_ServiceDesc, srv)
+}
+
+func _WeatherService_ImportObservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportObservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).ImportObservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_ImportObservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).ImportObservations(ctx, req.(*ImportObservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetStation(ctx, req.(*GetStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_ListStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).ListStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_ListStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).ListStations(ctx, req.(*ListStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_ListObservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).ListObservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_ListObservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).ListObservations(ctx, req.(*ListObservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetFieldIndices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFieldIndicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetFieldIndices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetFieldIndices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetFieldIndices(ctx, req.(*GetFieldIndicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WeatherService_ServiceDesc is the grpc.ServiceDesc for WeatherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WeatherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weather.WeatherService",
+	HandlerType: (*WeatherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImportObservations",
+			Handler:    _WeatherService_ImportObservations_Handler,
+		},
+		{
+			MethodName: "GetStation",
+			Handler:    _WeatherService_GetStation_Handler,
+		},
+		{
+			MethodName: "ListStations",
+			Handler:    _WeatherService_ListStations_Handler,
+		},
+		{
+			MethodName: "ListObservations",
+			Handler:    _WeatherService_ListObservations_Handler,
+		},
+		{
+			MethodName: "GetFieldIndices",
+			Handler:    _WeatherService_GetFieldIndices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "weather.proto",
+}
@@ -0,0 +1,221 @@
+package weather
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+)
+
+// Column names accepted in CSV headers, case insensitive.
+var csvColumns = map[string]string{
+	"station":         "station",
+	"station_id":      "station",
+	"name":            "name",
+	"station_name":    "name",
+	"latitude":        "latitude",
+	"lat":             "latitude",
+	"longitude":       "longitude",
+	"lon":             "longitude",
+	"lng":             "longitude",
+	"elevation":       "elevation",
+	"time":            "time",
+	"date":            "time",
+	"timestamp":       "time",
+	"temperature":     "temperature",
+	"temp":            "temperature",
+	"air_temperature": "temperature",
+	"tmin":            "tmin",
+	"temperature_min": "tmin",
+	"tmax":            "tmax",
+	"temperature_max": "tmax",
+	"rainfall":        "rainfall",
+	"rain":            "rainfall",
+	"precipitation":   "rainfall",
+	"precip":          "rainfall",
+}
+
+// hourlyLayouts are the accepted times of hourly rows, in UTC unless they
+// carry an offset.
+var hourlyLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02 15:04"}
+
+const dateLayout = "2006-01-02"
+
+// ParseCSV parses a station export with a header row naming its columns,
+// e.g. "station,time,temperature,rainfall". Rows dated YYYY-MM-DD are daily
+// observations, rows with a time of day hourly ones. Temperatures are in °C
+// and rainfall in mm, empty cells are missing values. station is used for
+// files without a station column. Latitude, longitude, name and elevation
+// columns describe the station of their row.
+func ParseCSV(r io.Reader, station string) (*Dataset, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, repoerr.InvalidArgument("content", "file is empty")
+	}
+	if err != nil {
+		return nil, repoerr.InvalidArgument("content", "%v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if column, ok := csvColumns[name]; ok {
+			columns[column] = i
+		}
+	}
+	if _, ok := columns["time"]; !ok {
+		return nil, repoerr.InvalidArgument("content", "header has no time column")
+	}
+	if _, ok := columns["station"]; !ok && station == "" {
+		return nil, repoerr.InvalidArgument("station", "header has no station column and no station was given")
+	}
+
+	b := newBuilder()
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, repoerr.InvalidArgument("content", "%v", err)
+		}
+		cell := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(column string) (*float64, error) {
+			raw := cell(column)
+			if raw == "" {
+				return nil, nil
+			}
+			v, err := strconv.ParseFloat(raw, 64)
+			if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil, repoerr.InvalidArgument("content", "line %d: %s %q is not a number", line, column, raw)
+			}
+			return &v, nil
+		}
+
+		id := cell("station")
+		if id == "" {
+			id = station
+		}
+		if id == "" {
+			return nil, repoerr.InvalidArgument("content", "line %d: station is empty", line)
+		}
+		s := b.station(id)
+		if name := cell("name"); name != "" {
+			s.Name = name
+		}
+		lat, err := number("latitude")
+		if err != nil {
+			return nil, err
+		}
+		lon, err := number("longitude")
+		if err != nil {
+			return nil, err
+		}
+		if lat != nil && lon != nil {
+			if err := locate(s, *lat, *lon); err != nil {
+				return nil, repoerr.InvalidArgument("content", "line %d: %v", line, err)
+			}
+		}
+		elevation, err := number("elevation")
+		if err != nil {
+			return nil, err
+		}
+		if elevation != nil {
+			s.ElevationM = elevation
+		}
+
+		o := &Observation{StationID: id}
+		rawTime := cell("time")
+		if o.Time, o.Resolution, err = parseTime(rawTime); err != nil {
+			return nil, repoerr.InvalidArgument("content", "line %d: %v", line, err)
+		}
+		if o.Temperature, err = number("temperature"); err != nil {
+			return nil, err
+		}
+		if o.TMin, err = number("tmin"); err != nil {
+			return nil, err
+		}
+		if o.TMax, err = number("tmax"); err != nil {
+			return nil, err
+		}
+		if o.Rainfall, err = number("rainfall"); err != nil {
+			return nil, err
+		}
+		b.add(o)
+	}
+	return b.dataset(), nil
+}
+
+// parseTime parses the time of an observation and tells its resolution.
+func parseTime(raw string) (time.Time, string, error) {
+	if t, err := time.Parse(dateLayout, raw); err == nil {
+		return t, Daily, nil
+	}
+	for _, layout := range hourlyLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.UTC().Truncate(time.Second), Hourly, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("time %q is neither a date nor a date and time", raw)
+}
+
+// builder collects the stations and observations of a file.
+type builder struct {
+	stations     map[string]*Station
+	order        []string
+	observations []*Observation
+}
+
+func newBuilder() *builder {
+	return &builder{stations: map[string]*Station{}}
+}
+
+func (b *builder) station(id string) *Station {
+	s, ok := b.stations[id]
+	if !ok {
+		s = &Station{ID: id}
+		b.stations[id] = s
+		b.order = append(b.order, id)
+	}
+	return s
+}
+
+func locate(s *Station, lat, lon float64) error {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return fmt.Errorf("station %s has no valid location", s.ID)
+	}
+	s.Location = &Point{Type: "Point", Coordinates: geo.Position{lon, lat}}
+	return nil
+}
+
+// add keeps observations with at least one value.
+func (b *builder) add(o *Observation) {
+	if o.Temperature == nil && o.TMin == nil && o.TMax == nil && o.Rainfall == nil {
+		return
+	}
+	b.station(o.StationID)
+	b.observations = append(b.observations, o)
+}
+
+func (b *builder) dataset() *Dataset {
+	data := &Dataset{Observations: b.observations}
+	for _, id := range b.order {
+		data.Stations = append(data.Stations, b.stations[id])
+	}
+	return data
+}
@@ -0,0 +1,147 @@
+package weather
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+)
+
+// describe renders observations for comparison, missing values as "-".
+func describe(observations []*Observation) []string {
+	value := func(v *float64) string {
+		if v == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%.4g", *v)
+	}
+	var out []string
+	for _, o := range observations {
+		out = append(out, fmt.Sprintf("%s %s %s t=%s min=%s max=%s rain=%s", o.StationID, o.Resolution,
+			o.Time.Format("2006-01-02T15:04:05Z07:00"), value(o.Temperature), value(o.TMin), value(o.TMax), value(o.Rainfall)))
+	}
+	return out
+}
+
+func describeStations(stations []*Station) []string {
+	var out []string
+	for _, s := range stations {
+		d := s.ID
+		if s.Name != "" {
+			d += " " + s.Name
+		}
+		if s.Location != nil {
+			d += fmt.Sprintf(" at %v,%v", s.Location.Coordinates.Lon(), s.Location.Coordinates.Lat())
+		}
+		if s.ElevationM != nil {
+			d += fmt.Sprintf(" %vm", *s.ElevationM)
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		station          string
+		wantStations     []string
+		wantObservations []string
+	}{
+		{
+			name: "daily",
+			content: "station_id,date,tmin,tmax,rain\n" +
+				"S1,2024-05-01,8.5,21,0\n" +
+				"S1,2024-05-02,,19.5,4.2\n",
+			wantStations: []string{"S1"},
+			wantObservations: []string{
+				"S1 daily 2024-05-01T00:00:00Z t=- min=8.5 max=21 rain=0",
+				"S1 daily 2024-05-02T00:00:00Z t=- min=- max=19.5 rain=4.2",
+			},
+		},
+		{
+			name: "hourly in UTC",
+			content: "\ufeffTime, Temperature, Precipitation\n" +
+				"2024-05-01T10:00:00+02:00,14.5,0.2\n" +
+				"2024-05-01 09:00,15,\n",
+			station:      "S2",
+			wantStations: []string{"S2"},
+			wantObservations: []string{
+				"S2 hourly 2024-05-01T08:00:00Z t=14.5 min=- max=- rain=0.2",
+				"S2 hourly 2024-05-01T09:00:00Z t=15 min=- max=- rain=-",
+			},
+		},
+		{
+			name: "station columns",
+			content: "station,name,lat,lon,elevation,date,temp\n" +
+				"S1,North,38.7,-9.1,77,2024-05-01,16\n" +
+				"S2,,41.1,-8.6,,2024-05-01,14\n" +
+				"S1,,,,,2024-05-02,17\n",
+			wantStations: []string{"S1 North at -9.1,38.7 77m", "S2 at -8.6,41.1"},
+			wantObservations: []string{
+				"S1 daily 2024-05-01T00:00:00Z t=16 min=- max=- rain=-",
+				"S2 daily 2024-05-01T00:00:00Z t=14 min=- max=- rain=-",
+				"S1 daily 2024-05-02T00:00:00Z t=17 min=- max=- rain=-",
+			},
+		},
+		{
+			name:             "rows without values are dropped",
+			content:          "station,date,temp,rain\nS1,2024-05-01,,\n",
+			wantStations:     []string{"S1"},
+			wantObservations: nil,
+		},
+		{
+			name:             "station column wins over the given station",
+			content:          "station,date,rain\nS1,2024-05-01,1\n,2024-05-02,2\n",
+			station:          "S9",
+			wantStations:     []string{"S1", "S9"},
+			wantObservations: []string{"S1 daily 2024-05-01T00:00:00Z t=- min=- max=- rain=1", "S9 daily 2024-05-02T00:00:00Z t=- min=- max=- rain=2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := ParseCSV(strings.NewReader(tt.content), tt.station)
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+			if got := describeStations(data.Stations); !reflect.DeepEqual(got, tt.wantStations) {
+				t.Errorf("stations = %q, want %q", got, tt.wantStations)
+			}
+			if got := describe(data.Observations); !reflect.DeepEqual(got, tt.wantObservations) {
+				t.Errorf("observations = %q, want %q", got, tt.wantObservations)
+			}
+		})
+	}
+}
+
+func TestParseCSVErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		station   string
+		wantField string
+		wantErr   string
+	}{
+		{"empty", "", "S1", "content", "file is empty"},
+		{"no time column", "station,temp\nS1,12\n", "", "content", "header has no time column"},
+		{"no station", "date,temp\n2024-05-01,12\n", "", "station", "header has no station column and no station was given"},
+		{"empty station", "station,date,temp\n,2024-05-01,12\n", "", "content", "line 2: station is empty"},
+		{"not a number", "date,temp\n2024-05-01,12\n2024-05-02,warm\n", "S1", "content", `line 3: temperature "warm" is not a number`},
+		{"not finite", "date,temp\n2024-05-01,NaN\n", "S1", "content", `line 2: temperature "NaN" is not a number`},
+		{"bad time", "date,temp\n01/05/2024,12\n", "S1", "content", `line 2: time "01/05/2024" is neither a date nor a date and time`},
+		{"bad location", "date,lat,lon,temp\n2024-05-01,95,10,12\n", "S1", "content", "line 2: station S1 has no valid location"},
+		{"ragged row", "date,temp\n2024-05-01,12,3\n", "S1", "content", "wrong number of fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.content), tt.station)
+			if repoerr.KindOf(err) != repoerr.KindInvalidArgument || repoerr.FieldOf(err) != tt.wantField ||
+				!strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseCSV() error = %v, want invalid %s: %s", err, tt.wantField, tt.wantErr)
+			}
+		})
+	}
+}
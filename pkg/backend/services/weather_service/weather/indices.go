@@ -0,0 +1,114 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	api "github.com/aburifat/go-agro/apis/agro"
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+)
+
+// Default temperatures in °C bounding the development of a crop for growing
+// degree days, those of maize.
+const (
+	DefaultBaseTemperature  = 10.0
+	DefaultUpperTemperature = 30.0
+)
+
+// DegreeDays returns the growing degree days of a day with the given
+// minimum and maximum temperature: the mean of both above base, after
+// capping them at upper and raising them to base (McMaster and Wilhelm's
+// second method).
+func DegreeDays(tMin, tMax, base, upper float64) float64 {
+	if tMax < tMin {
+		tMin, tMax = tMax, tMin
+	}
+	tMax = math.Max(math.Min(tMax, upper), base)
+	tMin = math.Max(math.Min(tMin, upper), base)
+	return (tMin+tMax)/2 - base
+}
+
+// IndexDay holds the indices of a day and their sums since the first day.
+type IndexDay struct {
+	Day time.Time
+	// DegreeDays is 0 on days without temperatures.
+	DegreeDays           float64
+	ChillHours           float64
+	Rainfall             float64
+	CumulativeDegreeDays float64
+	CumulativeChillHours float64
+	CumulativeRainfall   float64
+	// Missing is set on days without temperatures.
+	Missing bool
+}
+
+// Indices are the agronomic indices of a period.
+type Indices struct {
+	StationID        string
+	From, To         time.Time
+	BaseTemperature  float64
+	UpperTemperature float64
+	DegreeDays       float64
+	ChillHours       float64
+	Rainfall         float64
+	// MissingDays is the number of days without temperatures; their degree
+	// days are not counted.
+	MissingDays int
+	Days        []IndexDay
+}
+
+// Accumulate computes the indices of the days from from to to, exclusive,
+// from the summaries of a station.
+func Accumulate(summaries []*DaySummary, from, to time.Time, base, upper float64) *Indices {
+	byDay := make(map[time.Time]*DaySummary, len(summaries))
+	for _, s := range summaries {
+		byDay[s.Day] = s
+	}
+	indices := &Indices{From: from, To: to, BaseTemperature: base, UpperTemperature: upper}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		d := IndexDay{Day: day}
+		s, ok := byDay[day]
+		if ok && s.TMin != nil && s.TMax != nil {
+			d.DegreeDays = DegreeDays(*s.TMin, *s.TMax, base, upper)
+		} else {
+			d.Missing = true
+			indices.MissingDays++
+		}
+		if ok {
+			d.ChillHours = s.ChillHours
+			if s.Rainfall != nil {
+				d.Rainfall = *s.Rainfall
+			}
+		}
+		indices.DegreeDays += d.DegreeDays
+		indices.ChillHours += d.ChillHours
+		indices.Rainfall += d.Rainfall
+		d.CumulativeDegreeDays = indices.DegreeDays
+		d.CumulativeChillHours = indices.ChillHours
+		d.CumulativeRainfall = indices.Rainfall
+		indices.Days = append(indices.Days, d)
+	}
+	return indices
+}
+
+// FieldIndices computes the indices of field for the days from from to to,
+// exclusive, from the weather at the closest station linked to its farm.
+func (s *Store) FieldIndices(ctx context.Context, field *api.Field, from, to time.Time, base, upper float64) (*Indices, error) {
+	boundary, err := geo.Parse(field.Boundary)
+	if err != nil {
+		return nil, fmt.Errorf("field %s has an invalid boundary: %w", field.ID, err)
+	}
+	station, err := s.StationFor(ctx, field.FarmID, boundary.Center())
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := s.Days(ctx, station.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	indices := Accumulate(summaries, from, to, base, upper)
+	indices.StationID = station.ID
+	return indices, nil
+}
@@ -0,0 +1,63 @@
+package weather
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDegreeDays(t *testing.T) {
+	tests := []struct {
+		name       string
+		tMin, tMax float64
+		want       float64
+	}{
+		{"within the range", 12, 24, 8},
+		{"swapped", 24, 12, 8},
+		{"cold night raised to base", 4, 20, 5},
+		{"cold day", -2, 8, 0},
+		{"hot day capped", 22, 36, 16},
+		{"above upper", 32, 40, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DegreeDays(tt.tMin, tt.tMax, DefaultBaseTemperature, DefaultUpperTemperature); got != tt.want {
+				t.Errorf("DegreeDays(%v, %v) = %v, want %v", tt.tMin, tt.tMax, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	day := func(i int) time.Time { return time.Date(2024, 5, 1+i, 0, 0, 0, 0, time.UTC) }
+	f := func(v float64) *float64 { return &v }
+	summaries := []*DaySummary{
+		{Day: day(0), TMin: f(12), TMax: f(24), Rainfall: f(2), ChillHours: 1},
+		// no temperatures
+		{Day: day(1), Rainfall: f(5)},
+		{Day: day(2), TMin: f(10), TMax: f(20), ChillHours: 3},
+		// outside the period
+		{Day: day(5), TMin: f(20), TMax: f(30)},
+	}
+
+	indices := Accumulate(summaries, day(0), day(4), DefaultBaseTemperature, DefaultUpperTemperature)
+
+	want := []IndexDay{
+		{Day: day(0), DegreeDays: 8, ChillHours: 1, Rainfall: 2, CumulativeDegreeDays: 8, CumulativeChillHours: 1, CumulativeRainfall: 2},
+		{Day: day(1), Rainfall: 5, CumulativeDegreeDays: 8, CumulativeChillHours: 1, CumulativeRainfall: 7, Missing: true},
+		{Day: day(2), DegreeDays: 5, ChillHours: 3, CumulativeDegreeDays: 13, CumulativeChillHours: 4, CumulativeRainfall: 7},
+		{Day: day(3), CumulativeDegreeDays: 13, CumulativeChillHours: 4, CumulativeRainfall: 7, Missing: true},
+	}
+	if len(indices.Days) != len(want) {
+		t.Fatalf("Accumulate() has %d days, want %d", len(indices.Days), len(want))
+	}
+	for i, d := range indices.Days {
+		if d != want[i] {
+			t.Errorf("day %d = %+v, want %+v", i, d, want[i])
+		}
+	}
+	if indices.DegreeDays != 13 || indices.ChillHours != 4 || math.Abs(indices.Rainfall-7) > 1e-9 || indices.MissingDays != 2 {
+		t.Errorf("Accumulate() totals = %v degree days, %v chill hours, %v mm, %d missing days",
+			indices.DegreeDays, indices.ChillHours, indices.Rainfall, indices.MissingDays)
+	}
+}
@@ -0,0 +1,382 @@
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+)
+
+// ncVariable is a variable of a NetCDF JSON dump. xarray's to_dict writes
+// dims and attrs, CF-JSON shape and attributes.
+type ncVariable struct {
+	Dims       []string        `json:"dims"`
+	Shape      []string        `json:"shape"`
+	Attrs      map[string]any  `json:"attrs"`
+	Attributes map[string]any  `json:"attributes"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func (v *ncVariable) dims() []string {
+	if v.Dims != nil {
+		return v.Dims
+	}
+	return v.Shape
+}
+
+func (v *ncVariable) attr(name string) any {
+	if a, ok := v.Attrs[name]; ok {
+		return a
+	}
+	return v.Attributes[name]
+}
+
+type ncDataset struct {
+	Attrs      map[string]any         `json:"attrs"`
+	Attributes map[string]any         `json:"attributes"`
+	Coords     map[string]*ncVariable `json:"coords"`
+	DataVars   map[string]*ncVariable `json:"data_vars"`
+	Variables  map[string]*ncVariable `json:"variables"`
+}
+
+func (d *ncDataset) attr(names ...string) any {
+	for _, name := range names {
+		if a, ok := d.Attrs[name]; ok {
+			return a
+		}
+		if a, ok := d.Attributes[name]; ok {
+			return a
+		}
+	}
+	return nil
+}
+
+func (d *ncDataset) variable(names ...string) (string, *ncVariable) {
+	for _, name := range names {
+		for _, vars := range []map[string]*ncVariable{d.Coords, d.DataVars, d.Variables} {
+			if v, ok := vars[name]; ok {
+				return name, v
+			}
+		}
+	}
+	return "", nil
+}
+
+// Variable names recognised per quantity, CF standard names first.
+var (
+	temperatureNames = []string{"air_temperature", "tas", "temperature", "t2m", "temp"}
+	tMinNames        = []string{"air_temperature_min", "tasmin", "tmin", "mn2t"}
+	tMaxNames        = []string{"air_temperature_max", "tasmax", "tmax", "mx2t"}
+	rainfallNames    = []string{"precipitation_amount", "precipitation_flux", "pr", "precipitation", "rainfall", "precip", "tp"}
+)
+
+// ParseNetCDFJSON parses a JSON dump of a NetCDF file holding the time
+// series of one station, as written by xarray's Dataset.to_dict or in
+// CF-JSON. Times are strings or numbers in CF units such as "hours since
+// 2024-01-01 00:00:00"; series at midnight a day apart are daily, all others
+// hourly. Temperatures in K, °C or °F and precipitation amounts or fluxes
+// are converted. Missing values are null or equal to the variable's
+// _FillValue or missing_value. The station is identified by the station_id
+// attribute, or station if the file has none, and located by the latitude
+// and longitude attributes or scalar variables.
+func ParseNetCDFJSON(r io.Reader, station string) (*Dataset, error) {
+	var d ncDataset
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, repoerr.InvalidArgument("content", "invalid JSON: %v", err)
+	}
+
+	id := attrString(d.attr("station_id", "station", "id"))
+	if id == "" {
+		id = station
+	}
+	if id == "" {
+		return nil, repoerr.InvalidArgument("station", "file has no station_id attribute and no station was given")
+	}
+	b := newBuilder()
+	s := b.station(id)
+	s.Name = attrString(d.attr("station_name", "name"))
+	lat, err := d.scalar([]string{"latitude", "lat"})
+	if err != nil {
+		return nil, err
+	}
+	lon, err := d.scalar([]string{"longitude", "lon"})
+	if err != nil {
+		return nil, err
+	}
+	if lat != nil && lon != nil {
+		if err := locate(s, *lat, *lon); err != nil {
+			return nil, repoerr.InvalidArgument("content", "%v", err)
+		}
+	}
+	if s.ElevationM, err = d.scalar([]string{"elevation", "altitude"}); err != nil {
+		return nil, err
+	}
+
+	_, timeVar := d.variable("time")
+	if timeVar == nil {
+		return nil, repoerr.InvalidArgument("content", "file has no time variable")
+	}
+	times, err := parseTimes(timeVar)
+	if err != nil {
+		return nil, err
+	}
+	resolution := resolutionOf(times)
+	step := time.Hour
+	if resolution == Daily {
+		step = 24 * time.Hour
+	}
+
+	series := map[string][]*float64{}
+	for _, q := range []struct {
+		key   string
+		names []string
+	}{
+		{"temperature", temperatureNames},
+		{"tmin", tMinNames},
+		{"tmax", tMaxNames},
+		{"rainfall", rainfallNames},
+	} {
+		name, v := d.variable(q.names...)
+		if v == nil {
+			continue
+		}
+		if dims := v.dims(); len(dims) != 1 || dims[0] != "time" {
+			return nil, repoerr.InvalidArgument("content", "variable %s must have the single dimension time", name)
+		}
+		values, err := parseValues(name, v, len(times))
+		if err != nil {
+			return nil, err
+		}
+		units := attrString(v.attr("units"))
+		var convert func(float64) float64
+		if q.key == "rainfall" {
+			convert, err = rainfallConversion(units, step)
+		} else {
+			convert, err = temperatureConversion(units)
+		}
+		if err != nil {
+			return nil, repoerr.InvalidArgument("content", "variable %s: %v", name, err)
+		}
+		for _, value := range values {
+			if value != nil {
+				*value = convert(*value)
+			}
+		}
+		series[q.key] = values
+	}
+	if len(series) == 0 {
+		return nil, repoerr.InvalidArgument("content", "file has no temperature or precipitation variable")
+	}
+
+	for i, t := range times {
+		at := func(key string) *float64 {
+			if values, ok := series[key]; ok {
+				return values[i]
+			}
+			return nil
+		}
+		b.add(&Observation{
+			StationID:   id,
+			Resolution:  resolution,
+			Time:        t,
+			Temperature: at("temperature"),
+			TMin:        at("tmin"),
+			TMax:        at("tmax"),
+			Rainfall:    at("rainfall"),
+		})
+	}
+	return b.dataset(), nil
+}
+
+// scalar returns a number from the attribute or the scalar variable with
+// one of names.
+func (d *ncDataset) scalar(names []string) (*float64, error) {
+	if a := d.attr(names...); a != nil {
+		if v, ok := attrNumber(a); ok {
+			return &v, nil
+		}
+		return nil, repoerr.InvalidArgument("content", "attribute %s is not a number", names[0])
+	}
+	name, v := d.variable(names...)
+	if v == nil {
+		return nil, nil
+	}
+	var value float64
+	if err := json.Unmarshal(v.Data, &value); err == nil {
+		return &value, nil
+	}
+	var values []float64
+	if err := json.Unmarshal(v.Data, &values); err != nil || len(values) != 1 {
+		return nil, repoerr.InvalidArgument("content", "variable %s must hold a single number", name)
+	}
+	return &values[0], nil
+}
+
+func attrString(a any) string {
+	switch v := a.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func attrNumber(a any) (float64, bool) {
+	switch v := a.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	case []any:
+		// NetCDF attributes are arrays, dumps keep one element ones as such
+		if len(v) == 1 {
+			return attrNumber(v[0])
+		}
+	}
+	return 0, false
+}
+
+// timeLayouts are the accepted times and reference times of CF time units,
+// in UTC unless they carry an offset.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func parseNCTime(raw string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(raw)); err == nil {
+			return t.UTC().Truncate(time.Second), true
+		}
+	}
+	return time.Time{}, false
+}
+
+var timeUnits = map[string]time.Duration{
+	"second": time.Second, "seconds": time.Second, "s": time.Second,
+	"minute": time.Minute, "minutes": time.Minute, "min": time.Minute,
+	"hour": time.Hour, "hours": time.Hour, "h": time.Hour,
+	"day": 24 * time.Hour, "days": 24 * time.Hour, "d": 24 * time.Hour,
+}
+
+func parseTimes(v *ncVariable) ([]time.Time, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(v.Data, &raw); err != nil {
+		return nil, repoerr.InvalidArgument("content", "time must be an array")
+	}
+	times := make([]time.Time, len(raw))
+
+	var strs []string
+	if err := json.Unmarshal(v.Data, &strs); err == nil {
+		for i, s := range strs {
+			t, ok := parseNCTime(s)
+			if !ok {
+				return nil, repoerr.InvalidArgument("content", "time %d: %q is not a time", i, s)
+			}
+			times[i] = t
+		}
+		return times, nil
+	}
+
+	var offsets []float64
+	if err := json.Unmarshal(v.Data, &offsets); err != nil {
+		return nil, repoerr.InvalidArgument("content", "time must hold strings or numbers")
+	}
+	units := attrString(v.attr("units"))
+	unit, reference, ok := strings.Cut(units, " since ")
+	scale, known := timeUnits[strings.ToLower(strings.TrimSpace(unit))]
+	if !ok || !known {
+		return nil, repoerr.InvalidArgument("content", "time units %q are not of the form \"<unit> since <time>\"", units)
+	}
+	ref, ok := parseNCTime(reference)
+	if !ok {
+		return nil, repoerr.InvalidArgument("content", "time units %q have no valid reference time", units)
+	}
+	for i, offset := range offsets {
+		times[i] = ref.Add(time.Duration(math.Round(offset * float64(scale)))).UTC().Truncate(time.Second)
+	}
+	return times, nil
+}
+
+// resolutionOf tells daily series from hourly ones.
+func resolutionOf(times []time.Time) string {
+	for i, t := range times {
+		if !t.Equal(t.Truncate(24 * time.Hour)) {
+			return Hourly
+		}
+		if i > 0 && t.Sub(times[i-1]) < 24*time.Hour {
+			return Hourly
+		}
+	}
+	return Daily
+}
+
+func parseValues(name string, v *ncVariable, n int) ([]*float64, error) {
+	var values []*float64
+	if err := json.Unmarshal(v.Data, &values); err != nil {
+		return nil, repoerr.InvalidArgument("content", "variable %s must hold numbers or null", name)
+	}
+	if len(values) != n {
+		return nil, repoerr.InvalidArgument("content", "variable %s has %d values for %d times", name, len(values), n)
+	}
+	var fills []float64
+	for _, attr := range []string{"_FillValue", "missing_value"} {
+		if fill, ok := attrNumber(v.attr(attr)); ok {
+			fills = append(fills, fill)
+		}
+	}
+	for i, value := range values {
+		for _, fill := range fills {
+			if value != nil && *value == fill {
+				values[i] = nil
+			}
+		}
+	}
+	return values, nil
+}
+
+func temperatureConversion(units string) (func(float64) float64, error) {
+	switch strings.ToLower(strings.TrimSpace(units)) {
+	case "", "c", "degc", "deg_c", "°c", "celsius", "degrees_celsius", "degree_celsius":
+		return func(v float64) float64 { return v }, nil
+	case "k", "kelvin", "degk", "deg_k":
+		return func(v float64) float64 { return v - 273.15 }, nil
+	case "f", "degf", "deg_f", "°f", "fahrenheit", "degrees_fahrenheit":
+		return func(v float64) float64 { return (v - 32) * 5 / 9 }, nil
+	}
+	return nil, fmt.Errorf("unsupported temperature units %q", units)
+}
+
+// rainfallConversion converts precipitation amounts to mm and fluxes to the
+// mm that fell during a step of the series.
+func rainfallConversion(units string, step time.Duration) (func(float64) float64, error) {
+	var factor float64
+	switch strings.ToLower(strings.Join(strings.Fields(units), " ")) {
+	case "", "mm", "kg m-2", "kg/m2", "kg m**-2":
+		factor = 1
+	case "cm":
+		factor = 10
+	case "m":
+		factor = 1000
+	case "kg m-2 s-1", "kg/m2/s", "kg m**-2 s**-1", "mm s-1", "mm/s":
+		factor = step.Seconds()
+	case "mm h-1", "mm/h", "mm/hr":
+		factor = step.Hours()
+	case "mm d-1", "mm/d", "mm/day", "mm day-1":
+		factor = step.Hours() / 24
+	default:
+		return nil, fmt.Errorf("unsupported precipitation units %q", units)
+	}
+	return func(v float64) float64 { return v * factor }, nil
+}
@@ -0,0 +1,162 @@
+package weather
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+)
+
+func TestParseNetCDFJSON(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		station          string
+		wantStations     []string
+		wantObservations []string
+	}{
+		{
+			name: "xarray hourly",
+			content: `{
+				"attrs": {"station_id": "S1", "station_name": "North", "latitude": 38.7, "longitude": [-9.1]},
+				"coords": {"time": {"dims": ["time"], "attrs": {"units": "hours since 2024-05-01 00:00:00"}, "data": [0, 1, 2]}},
+				"data_vars": {
+					"t2m": {"dims": ["time"], "attrs": {"units": "K"}, "data": [288.15, null, 290.65]},
+					"tp": {"dims": ["time"], "attrs": {"units": "m", "_FillValue": -1}, "data": [0.0012, 0, -1]}
+				}
+			}`,
+			wantStations: []string{"S1 North at -9.1,38.7"},
+			wantObservations: []string{
+				"S1 hourly 2024-05-01T00:00:00Z t=15 min=- max=- rain=1.2",
+				"S1 hourly 2024-05-01T01:00:00Z t=- min=- max=- rain=0",
+				"S1 hourly 2024-05-01T02:00:00Z t=17.5 min=- max=- rain=-",
+			},
+		},
+		{
+			name: "CF-JSON daily",
+			content: `{
+				"attributes": {"title": "daily export"},
+				"variables": {
+					"time": {"shape": ["time"], "data": ["2024-05-01", "2024-05-02T00:00:00Z"]},
+					"latitude": {"shape": [], "data": 41.1},
+					"longitude": {"shape": [], "data": [-8.6]},
+					"elevation": {"shape": [], "data": 104},
+					"tasmin": {"shape": ["time"], "attributes": {"units": "degF", "missing_value": -9999}, "data": [50, -9999]},
+					"tasmax": {"shape": ["time"], "attributes": {"units": "degC"}, "data": [21.5, 23]},
+					"pr": {"shape": ["time"], "attributes": {"units": "kg m-2 s-1"}, "data": [0.0001, 0]}
+				}
+			}`,
+			station:      "S2",
+			wantStations: []string{"S2 at -8.6,41.1 104m"},
+			wantObservations: []string{
+				"S2 daily 2024-05-01T00:00:00Z t=- min=10 max=21.5 rain=8.64",
+				"S2 daily 2024-05-02T00:00:00Z t=- min=- max=23 rain=0",
+			},
+		},
+		{
+			name: "days since a reference",
+			content: `{
+				"attrs": {"station_id": "S3"},
+				"coords": {"time": {"dims": ["time"], "attrs": {"units": "days since 2024-01-01"}, "data": [0, 1]}},
+				"data_vars": {"precipitation": {"dims": ["time"], "attrs": {"units": "mm/day"}, "data": [3, 0.5]}}
+			}`,
+			wantStations: []string{"S3"},
+			wantObservations: []string{
+				"S3 daily 2024-01-01T00:00:00Z t=- min=- max=- rain=3",
+				"S3 daily 2024-01-02T00:00:00Z t=- min=- max=- rain=0.5",
+			},
+		},
+		{
+			name: "hourly fluxes",
+			content: `{
+				"attrs": {"station_id": "S4"},
+				"coords": {"time": {"dims": ["time"], "attrs": {"units": "minutes since 2024-01-01T00:00"}, "data": [0, 60]}},
+				"data_vars": {"rainfall": {"dims": ["time"], "attrs": {"units": "mm/h"}, "data": [1.5, 0]}}
+			}`,
+			wantStations: []string{"S4"},
+			wantObservations: []string{
+				"S4 hourly 2024-01-01T00:00:00Z t=- min=- max=- rain=1.5",
+				"S4 hourly 2024-01-01T01:00:00Z t=- min=- max=- rain=0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := ParseNetCDFJSON(strings.NewReader(tt.content), tt.station)
+			if err != nil {
+				t.Fatalf("ParseNetCDFJSON() error = %v", err)
+			}
+			if got := describeStations(data.Stations); !reflect.DeepEqual(got, tt.wantStations) {
+				t.Errorf("stations = %q, want %q", got, tt.wantStations)
+			}
+			if got := describe(data.Observations); !reflect.DeepEqual(got, tt.wantObservations) {
+				t.Errorf("observations = %q, want %q", got, tt.wantObservations)
+			}
+		})
+	}
+}
+
+func TestParseNetCDFJSONErrors(t *testing.T) {
+	const timeVar = `"time": {"dims": ["time"], "attrs": {"units": "hours since 2024-05-01"}, "data": [0, 1]}`
+	tests := []struct {
+		name      string
+		content   string
+		wantField string
+		wantErr   string
+	}{
+		{"not JSON", `{`, "content", "invalid JSON"},
+		{"no station", `{"coords": {` + timeVar + `}}`, "station", "no station_id attribute"},
+		{"no time", `{"attrs": {"station_id": "S1"}}`, "content", "file has no time variable"},
+		{"no values", `{"attrs": {"station_id": "S1"}, "coords": {` + timeVar + `}}`, "content", "no temperature or precipitation variable"},
+		{
+			"time units",
+			`{"attrs": {"station_id": "S1"}, "coords": {"time": {"dims": ["time"], "attrs": {"units": "fortnights"}, "data": [0]}}}`,
+			"content", `time units "fortnights" are not of the form`,
+		},
+		{
+			"bad time",
+			`{"attrs": {"station_id": "S1"}, "coords": {"time": {"dims": ["time"], "data": ["yesterday"]}}}`,
+			"content", `time 0: "yesterday" is not a time`,
+		},
+		{
+			"latitude attribute",
+			`{"attrs": {"station_id": "S1", "latitude": "north", "longitude": 1}, "coords": {` + timeVar + `}}`,
+			"content", "attribute latitude is not a number",
+		},
+		{
+			"latitude variable",
+			`{"attrs": {"station_id": "S1"}, "coords": {"lat": {"dims": ["lat"], "data": [1, 2]}, ` + timeVar + `}}`,
+			"content", "variable lat must hold a single number",
+		},
+		{
+			"dimensions",
+			`{"attrs": {"station_id": "S1"}, "coords": {` + timeVar + `}, "data_vars": {"tas": {"dims": ["time", "lat"], "data": [[1], [2]]}}}`,
+			"content", "variable tas must have the single dimension time",
+		},
+		{
+			"length",
+			`{"attrs": {"station_id": "S1"}, "coords": {` + timeVar + `}, "data_vars": {"tas": {"dims": ["time"], "data": [1]}}}`,
+			"content", "variable tas has 1 values for 2 times",
+		},
+		{
+			"temperature units",
+			`{"attrs": {"station_id": "S1"}, "coords": {` + timeVar + `}, "data_vars": {"tas": {"dims": ["time"], "attrs": {"units": "degR"}, "data": [1, 2]}}}`,
+			"content", `variable tas: unsupported temperature units "degR"`,
+		},
+		{
+			"precipitation units",
+			`{"attrs": {"station_id": "S1"}, "coords": {` + timeVar + `}, "data_vars": {"pr": {"dims": ["time"], "attrs": {"units": "inches"}, "data": [1, 2]}}}`,
+			"content", `variable pr: unsupported precipitation units "inches"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseNetCDFJSON(strings.NewReader(tt.content), "")
+			if repoerr.KindOf(err) != repoerr.KindInvalidArgument || repoerr.FieldOf(err) != tt.wantField ||
+				!strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseNetCDFJSON() error = %v, want invalid %s: %s", err, tt.wantField, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,455 @@
+// Package weather stores the observations of weather stations in MongoDB,
+// links stations to the farms around them and derives agronomic indices
+// from their daily summaries.
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/common/geo"
+	"github.com/aburifat/go-agro/pkg/backend/common/repoerr"
+	"github.com/aburifat/go-agro/pkg/backend/services/farm_service/spatial"
+	"github.com/aburifat/go-agro/pkg/backend/services/rbac_service/rbac"
+	"github.com/aburifat/go-agro/pkg/backend/services/user_service/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	StationsCollection     = "weather_stations"
+	ObservationsCollection = "weather_observations"
+)
+
+// Resolutions of observations.
+const (
+	Hourly = "hourly"
+	Daily  = "daily"
+)
+
+const (
+	// DefaultLinkRadius is the distance in metres up to which a station is
+	// linked to a farm.
+	DefaultLinkRadius = 25000
+	// MaxLinkRadius bounds the link radius of a station.
+	MaxLinkRadius = 200000
+	// MaxListLimit bounds the observations returned at once.
+	MaxListLimit = 1000
+	// MaxSummaryDays bounds the days summarised at once.
+	MaxSummaryDays = 400
+
+	writeBatchSize = 1000
+)
+
+// Store is the weather of all stations.
+type Store struct {
+	stations     *mongo.Collection
+	observations *mongo.Collection
+	index        *spatial.Index
+}
+
+// NewStore returns the weather store. index finds the farms a station is
+// linked to.
+func NewStore(store *storage.Storage, index *spatial.Index) *Store {
+	s := Store{
+		stations:     store.GetCollection(StationsCollection),
+		observations: store.GetCollection(ObservationsCollection),
+		index:        index,
+	}
+	return &s
+}
+
+// Point is a GeoJSON point.
+type Point struct {
+	Type        string       `bson:"type"`
+	Coordinates geo.Position `bson:"coordinates"`
+}
+
+// Station is a weather station. FarmIDs are the farms whose boundary lay
+// within LinkRadiusM metres of the station's location when it was imported;
+// StationFor also finds stations for farms created since.
+type Station struct {
+	ID          string    `bson:"_id"`
+	Name        string    `bson:"name,omitempty"`
+	Location    *Point    `bson:"location,omitempty"`
+	ElevationM  *float64  `bson:"elevationM,omitempty"`
+	LinkRadiusM float64   `bson:"linkRadiusM,omitempty"`
+	FarmIDs     []string  `bson:"farmIds"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// Observation is what a station measured at an hour or on a day. Daily
+// observations are stamped with midnight UTC. Temperatures are in °C and
+// rainfall in mm; values a station did not report are nil.
+type Observation struct {
+	StationID  string    `bson:"stationId"`
+	Resolution string    `bson:"resolution"`
+	Time       time.Time `bson:"time"`
+	// Temperature is the air temperature of an hourly observation or the
+	// daily mean.
+	Temperature *float64 `bson:"temperature,omitempty"`
+	TMin        *float64 `bson:"tMin,omitempty"`
+	TMax        *float64 `bson:"tMax,omitempty"`
+	Rainfall    *float64 `bson:"rainfall,omitempty"`
+}
+
+// Dataset is the content of an import file.
+type Dataset struct {
+	Stations     []*Station
+	Observations []*Observation
+}
+
+// EnsureIndexes creates the indexes imports and queries rely on.
+func (s *Store) EnsureIndexes(ctx context.Context) error {
+	_, err := s.observations.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "stationId", Value: 1}, {Key: "resolution", Value: 1}, {Key: "time", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create observation indexes: %w", err)
+	}
+	_, err = s.stations.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "farmIds", Value: 1}}},
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create station indexes: %w", err)
+	}
+	return nil
+}
+
+// ImportResult summarises an Import.
+type ImportResult struct {
+	Stations []string
+	// Inserted and Updated count observations; re-imported observations
+	// replace the stored ones.
+	Inserted int
+	Updated  int
+	// LinkedFarms is the number of farm links of the imported stations.
+	LinkedFarms int
+}
+
+// Import stores the stations and observations of data. Stations with a
+// location are linked to the farms within linkRadius metres of it.
+func (s *Store) Import(ctx context.Context, data *Dataset, linkRadius float64) (*ImportResult, error) {
+	result := &ImportResult{}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	for _, station := range data.Stations {
+		set := bson.D{{Key: "updatedAt", Value: now}}
+		if station.Name != "" {
+			set = append(set, bson.E{Key: "name", Value: station.Name})
+		}
+		if station.ElevationM != nil {
+			set = append(set, bson.E{Key: "elevationM", Value: *station.ElevationM})
+		}
+		setOnInsert := bson.D{}
+		if station.Location != nil {
+			farmIDs, err := s.nearbyFarms(ctx, station.Location.Coordinates, linkRadius)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, bson.E{Key: "location", Value: station.Location}, bson.E{Key: "linkRadiusM", Value: linkRadius},
+				bson.E{Key: "farmIds", Value: farmIDs})
+			result.LinkedFarms += len(farmIDs)
+		} else {
+			setOnInsert = append(setOnInsert, bson.E{Key: "farmIds", Value: []string{}})
+		}
+		update := bson.D{{Key: "$set", Value: set}}
+		if len(setOnInsert) > 0 {
+			update = append(update, bson.E{Key: "$setOnInsert", Value: setOnInsert})
+		}
+		_, err := s.stations.UpdateOne(ctx, bson.D{{Key: "_id", Value: station.ID}}, update, options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, fmt.Errorf("failed to store station %s: %w", station.ID, err)
+		}
+		result.Stations = append(result.Stations, station.ID)
+	}
+
+	for start := 0; start < len(data.Observations); start += writeBatchSize {
+		batch := data.Observations[start:min(start+writeBatchSize, len(data.Observations))]
+		models := make([]mongo.WriteModel, 0, len(batch))
+		for _, o := range batch {
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.D{
+					{Key: "stationId", Value: o.StationID},
+					{Key: "resolution", Value: o.Resolution},
+					{Key: "time", Value: o.Time},
+				}).
+				SetReplacement(o).
+				SetUpsert(true))
+		}
+		res, err := s.observations.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return nil, fmt.Errorf("failed to store observations: %w", err)
+		}
+		result.Inserted += int(res.UpsertedCount)
+		result.Updated += int(res.MatchedCount)
+	}
+	return result, nil
+}
+
+func (s *Store) nearbyFarms(ctx context.Context, point geo.Position, radius float64) ([]string, error) {
+	farmIDs := []string{}
+	if s.index == nil || radius <= 0 {
+		return farmIDs, nil
+	}
+	ids, err := s.index.NearbyIDs(ctx, spatial.Query{
+		Layer:  spatial.LayerFarms,
+		Grants: rbac.Grants{All: true},
+	}, point, radius)
+	if err != nil {
+		return nil, err
+	}
+	return append(farmIDs, ids...), nil
+}
+
+// GetStation returns the station with the given id.
+func (s *Store) GetStation(ctx context.Context, id string) (*Station, error) {
+	var station Station
+	err := s.stations.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&station)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, repoerr.NotFound("id", "weather station %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch weather station: %w", err)
+	}
+	return &station, nil
+}
+
+// ListStations returns the stations linked to farmID, all stations when
+// farmID is empty, ordered by id.
+func (s *Store) ListStations(ctx context.Context, farmID string) ([]*Station, error) {
+	filter := bson.D{}
+	if farmID != "" {
+		filter = bson.D{{Key: "farmIds", Value: farmID}}
+	}
+	cursor, err := s.stations.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weather stations: %w", err)
+	}
+	var stations []*Station
+	if err := cursor.All(ctx, &stations); err != nil {
+		return nil, fmt.Errorf("failed to decode weather stations: %w", err)
+	}
+	return stations, nil
+}
+
+// StationFor returns the station closest to point among those linked to
+// farmID and those within their link radius of point, which covers farms
+// created after the station was imported.
+func (s *Store) StationFor(ctx context.Context, farmID string, point geo.Position) (*Station, error) {
+	stations, err := s.ListStations(ctx, farmID)
+	if err != nil {
+		return nil, err
+	}
+	near, err := s.stationNear(ctx, point)
+	if err != nil {
+		return nil, err
+	}
+	if near != nil {
+		stations = append(stations, near)
+	}
+	var nearest *Station
+	distance := math.Inf(1)
+	for _, station := range stations {
+		if station.Location == nil {
+			continue
+		}
+		if d := geo.Distance(point, station.Location.Coordinates); d < distance {
+			nearest, distance = station, d
+		}
+	}
+	if nearest == nil {
+		return nil, repoerr.FailedPrecondition("stationId", "no weather station is linked to farm %s", farmID)
+	}
+	return nearest, nil
+}
+
+// stationNear returns the station closest to point whose link radius reaches
+// it, or nil.
+func (s *Store) stationNear(ctx context.Context, point geo.Position) (*Station, error) {
+	cursor, err := s.stations.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.D{
+			{Key: "near", Value: Point{Type: "Point", Coordinates: point}},
+			{Key: "key", Value: "location"},
+			{Key: "distanceField", Value: "distance"},
+			{Key: "spherical", Value: true},
+			{Key: "maxDistance", Value: MaxLinkRadius},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{
+			{Key: "$lte", Value: bson.A{"$distance", "$linkRadiusM"}},
+		}}}}},
+		{{Key: "$limit", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search weather stations: %w", err)
+	}
+	var stations []*Station
+	if err := cursor.All(ctx, &stations); err != nil {
+		return nil, fmt.Errorf("failed to decode weather stations: %w", err)
+	}
+	if len(stations) == 0 {
+		return nil, nil
+	}
+	return stations[0], nil
+}
+
+// ObservationQuery selects observations of a station.
+type ObservationQuery struct {
+	StationID  string
+	Resolution string
+	// From is inclusive, To exclusive; zero times are unbounded.
+	From, To time.Time
+	// After continues a listing after the last observation returned.
+	After time.Time
+	Limit int
+}
+
+// ListObservations returns observations ordered by time.
+func (s *Store) ListObservations(ctx context.Context, q ObservationQuery) ([]*Observation, error) {
+	filter := bson.D{{Key: "stationId", Value: q.StationID}, {Key: "resolution", Value: q.Resolution}}
+	bounds := bson.D{}
+	if !q.From.IsZero() {
+		bounds = append(bounds, bson.E{Key: "$gte", Value: q.From})
+	}
+	if !q.After.IsZero() {
+		bounds = append(bounds, bson.E{Key: "$gt", Value: q.After})
+	}
+	if !q.To.IsZero() {
+		bounds = append(bounds, bson.E{Key: "$lt", Value: q.To})
+	}
+	if len(bounds) > 0 {
+		filter = append(filter, bson.E{Key: "time", Value: bounds})
+	}
+	limit := q.Limit
+	if limit <= 0 || limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+	cursor, err := s.observations.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "time", Value: 1}}).
+		SetLimit(int64(limit)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list observations: %w", err)
+	}
+	var observations []*Observation
+	if err := cursor.All(ctx, &observations); err != nil {
+		return nil, fmt.Errorf("failed to decode observations: %w", err)
+	}
+	return observations, nil
+}
+
+// DaySummary is the weather of a station on a day. Daily observations take
+// precedence, missing values are derived from the hourly ones.
+type DaySummary struct {
+	Day      time.Time
+	TMin     *float64
+	TMax     *float64
+	Rainfall *float64
+	// ChillHours counts the hourly observations between 0 and 7.2 °C.
+	ChillHours float64
+	// Hours is the number of hourly observations of the day.
+	Hours int
+}
+
+// Chill hours are counted in the classic range of Weinberger.
+const (
+	chillMin = 0.0
+	chillMax = 7.2
+)
+
+// Days returns the summaries of the days from from to to that have any
+// observation, ordered by day.
+func (s *Store) Days(ctx context.Context, stationID string, from, to time.Time) ([]*DaySummary, error) {
+	if to.Sub(from) > MaxSummaryDays*24*time.Hour {
+		return nil, repoerr.InvalidArgument("to", "weather can be summarised for at most %d days", MaxSummaryDays)
+	}
+	days := map[time.Time]*DaySummary{}
+	day := func(t time.Time) *DaySummary {
+		d, ok := days[t]
+		if !ok {
+			d = &DaySummary{Day: t}
+			days[t] = d
+		}
+		return d
+	}
+
+	cursor, err := s.observations.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "stationId", Value: stationID},
+			{Key: "resolution", Value: Hourly},
+			{Key: "time", Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$dateTrunc", Value: bson.D{{Key: "date", Value: "$time"}, {Key: "unit", Value: "day"}}}}},
+			{Key: "tMin", Value: bson.D{{Key: "$min", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$tMin", "$temperature"}}}}}},
+			{Key: "tMax", Value: bson.D{{Key: "$max", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$tMax", "$temperature"}}}}}},
+			{Key: "rainfall", Value: bson.D{{Key: "$sum", Value: "$rainfall"}}},
+			{Key: "rainfallHours", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$type", Value: "$rainfall"}}, "double"}}}, 1, 0,
+			}}}}}},
+			{Key: "chillHours", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$and", Value: bson.A{
+					bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$type", Value: "$temperature"}}, "double"}}},
+					bson.D{{Key: "$gte", Value: bson.A{"$temperature", chillMin}}},
+					bson.D{{Key: "$lte", Value: bson.A{"$temperature", chillMax}}},
+				}}}, 1, 0,
+			}}}}}},
+			{Key: "hours", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarise hourly observations: %w", err)
+	}
+	var hourly []struct {
+		Day           time.Time `bson:"_id"`
+		TMin          *float64  `bson:"tMin"`
+		TMax          *float64  `bson:"tMax"`
+		Rainfall      float64   `bson:"rainfall"`
+		RainfallHours int       `bson:"rainfallHours"`
+		ChillHours    float64   `bson:"chillHours"`
+		Hours         int       `bson:"hours"`
+	}
+	if err := cursor.All(ctx, &hourly); err != nil {
+		return nil, fmt.Errorf("failed to decode hourly summaries: %w", err)
+	}
+	for _, h := range hourly {
+		d := day(h.Day.UTC())
+		d.TMin, d.TMax = h.TMin, h.TMax
+		if h.RainfallHours > 0 {
+			rainfall := h.Rainfall
+			d.Rainfall = &rainfall
+		}
+		d.ChillHours = h.ChillHours
+		d.Hours = h.Hours
+	}
+
+	daily, err := s.ListObservations(ctx, ObservationQuery{StationID: stationID, Resolution: Daily, From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range daily {
+		d := day(o.Time.UTC())
+		if o.TMin != nil {
+			d.TMin = o.TMin
+		}
+		if o.TMax != nil {
+			d.TMax = o.TMax
+		}
+		if o.Rainfall != nil {
+			d.Rainfall = o.Rainfall
+		}
+	}
+
+	summaries := make([]*DaySummary, 0, len(days))
+	for _, d := range days {
+		summaries = append(summaries, d)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Day.Before(summaries[j].Day) })
+	return summaries, nil
+}
@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+// InstrumentGorm records a client span for every statement run through db,
+// as a child of the span in the statement's context. Handlers should pass
+// db.WithContext(ctx) to the repositories. Statements are recorded with
+// placeholders only.
+func InstrumentGorm(db *gorm.DB) error {
+	tracer := otel.Tracer(instrumentationName)
+	callback := db.Callback()
+	processors := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+	for _, p := range processors {
+		if err := p.before(fmt.Sprintf("tracing:before_%s", p.operation), startSpan(tracer)); err != nil {
+			return err
+		}
+		if err := p.after(fmt.Sprintf("tracing:after_%s", p.operation), endSpan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startSpan(tracer trace.Tracer) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement.Context == nil {
+			return
+		}
+		_, span := tracer.Start(db.Statement.Context, "gorm", trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL))
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	v, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := v.(trace.Span)
+	defer span.End()
+
+	query := db.Statement.SQL.String()
+	operation, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	operation = strings.ToUpper(operation)
+	name := operation
+	if table := db.Statement.Table; table != "" {
+		name = fmt.Sprintf("%s %s", operation, table)
+		span.SetAttributes(semconv.DBCollectionName(table))
+	}
+	if name != "" {
+		span.SetName(name)
+	}
+	span.SetAttributes(
+		semconv.DBOperationName(operation),
+		semconv.DBQueryText(query),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,129 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aburifat/go-agro/pkg/backend/config"
+
+	"go.opentelemetry.io/otel"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// collector is an in-process OTLP trace collector recording the services
+// and names of the spans it receives.
+type collector struct {
+	collectorpb.UnimplementedTraceServiceServer
+	mu    sync.Mutex
+	spans []string
+}
+
+func (c *collector) Export(ctx context.Context, req *collectorpb.ExportTraceServiceRequest) (*collectorpb.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		var service string
+		for _, attr := range rs.GetResource().GetAttributes() {
+			if attr.GetKey() == "service.name" {
+				service = attr.GetValue().GetStringValue()
+			}
+		}
+		for _, ss := range rs.GetScopeSpans() {
+			for _, span := range ss.GetSpans() {
+				c.spans = append(c.spans, service+" "+span.GetName())
+			}
+		}
+	}
+	return &collectorpb.ExportTraceServiceResponse{}, nil
+}
+
+func (c *collector) received() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.spans...)
+}
+
+func startCollector(t *testing.T) (*collector, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &collector{}
+	server := grpc.NewServer()
+	collectorpb.RegisterTraceServiceServer(server, c)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return c, lis.Addr().String()
+}
+
+func TestSetupOTLP(t *testing.T) {
+	tests := []struct {
+		name        string
+		sampleRatio float64
+		want        []string
+	}{
+		{"sampled", 1, []string{"go-agro-test ListFarms", "go-agro-test GetFarm"}},
+		{"not sampled", 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, endpoint := startCollector(t)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			shutdown, err := Setup(ctx, config.TracingConfig{
+				Exporter:    "otlp",
+				Endpoint:    endpoint,
+				Insecure:    true,
+				SampleRatio: tt.sampleRatio,
+				ServiceName: "go-agro-test",
+			})
+			if err != nil {
+				t.Fatalf("Setup() error = %v", err)
+			}
+			tracer := otel.Tracer("test")
+			for _, name := range []string{"ListFarms", "GetFarm"} {
+				_, span := tracer.Start(ctx, name)
+				span.End()
+			}
+			// shutting down flushes the batch to the collector
+			if err := shutdown(ctx); err != nil {
+				t.Fatalf("shutdown error = %v", err)
+			}
+
+			if got := c.received(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("collector received %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetupExporters(t *testing.T) {
+	tests := []struct {
+		exporter string
+		wantErr  bool
+	}{
+		{"none", false},
+		{"stdout", false},
+		{"jaeger", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.exporter, func(t *testing.T) {
+			shutdown, err := Setup(context.Background(), config.TracingConfig{Exporter: tt.exporter, SampleRatio: 1})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Setup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if err := shutdown(context.Background()); err != nil {
+					t.Errorf("shutdown error = %v", err)
+				}
+			}
+		})
+	}
+}